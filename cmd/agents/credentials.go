@@ -0,0 +1,189 @@
+package agents
+
+import (
+	"context"
+	"time"
+
+	"github.com/compliance-framework/api/internal/config"
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func newCredentialsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "credentials",
+		Short: "Manage agent credentials",
+		Long:  "This command allows you to issue, list and revoke the API keys agents use to send evidence and heartbeats.",
+	}
+
+	cmd.AddCommand(newCredentialCreateCmd())
+	cmd.AddCommand(newCredentialListCmd())
+	cmd.AddCommand(newCredentialRevokeCmd())
+
+	return cmd
+}
+
+func newCredentialCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Issue a new agent credential",
+		Long:  "This command issues a new API key for an agent. The key is only displayed once, and cannot be retrieved afterwards.",
+		Run:   createCredential,
+	}
+
+	cmd.Flags().StringP("name", "n", "", "Name of the agent or deployment using the credential (required)")
+	cmd.MarkFlagRequired("name")
+
+	cmd.Flags().StringP("scope", "s", string(relational.AgentCredentialScopeIngest), "Scope of the credential, one of ingest or read")
+	cmd.Flags().Duration("expires-in", 0, "Duration after which the credential expires, e.g. 720h. Credentials do not expire by default")
+
+	return cmd
+}
+
+func newCredentialListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List agent credentials",
+		Run:   listCredentials,
+	}
+}
+
+func newCredentialRevokeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Revoke an agent credential",
+		Long:  "This command revokes an agent credential. Requests using the credential are rejected immediately.",
+		Run:   revokeCredential,
+	}
+
+	cmd.Flags().String("id", "", "ID of the credential to revoke (required)")
+	cmd.MarkFlagRequired("id")
+
+	return cmd
+}
+
+func connect(sugar *zap.SugaredLogger) (*gorm.DB, error) {
+	config := config.NewConfig(sugar)
+	return service.ConnectSQLDb(context.Background(), config, sugar)
+}
+
+func createCredential(cmd *cobra.Command, args []string) {
+	logger, err := zap.NewProduction()
+	cobra.CheckErr(err)
+	defer logger.Sync() // flushes buffer, if any
+	sugar := logger.Sugar()
+
+	db, err := connect(sugar)
+	if err != nil {
+		sugar.Errorw("Failed to connect to database", "error", err)
+		return
+	}
+
+	name, err := cmd.Flags().GetString("name")
+	if err != nil || name == "" {
+		sugar.Error("Name is required")
+		return
+	}
+	scope, _ := cmd.Flags().GetString("scope")
+	expiresIn, _ := cmd.Flags().GetDuration("expires-in")
+
+	var expiresAt *time.Time
+	if expiresIn > 0 {
+		expiry := time.Now().Add(expiresIn)
+		expiresAt = &expiry
+	}
+
+	credential, key, err := relational.NewAgentCredential(name, relational.AgentCredentialScope(scope), expiresAt)
+	if err != nil {
+		sugar.Errorw("Failed to generate credential", "error", err)
+		return
+	}
+
+	if err = db.Create(credential).Error; err != nil {
+		sugar.Errorw("Failed to create credential", "error", err)
+		return
+	}
+	sugar.Infow("Credential created successfully. Store the key safely, it will not be displayed again",
+		"id", credential.ID,
+		"name", credential.Name,
+		"scope", credential.Scope,
+		"expiresAt", credential.ExpiresAt,
+		"key", key,
+	)
+}
+
+func listCredentials(cmd *cobra.Command, args []string) {
+	logger, err := zap.NewProduction()
+	cobra.CheckErr(err)
+	defer logger.Sync() // flushes buffer, if any
+	sugar := logger.Sugar()
+
+	db, err := connect(sugar)
+	if err != nil {
+		sugar.Errorw("Failed to connect to database", "error", err)
+		return
+	}
+
+	var credentials []relational.AgentCredential
+	if err = db.Order("created_at").Find(&credentials).Error; err != nil {
+		sugar.Errorw("Failed to list credentials", "error", err)
+		return
+	}
+
+	for _, credential := range credentials {
+		sugar.Infow("Credential",
+			"id", credential.ID,
+			"name", credential.Name,
+			"prefix", credential.Prefix,
+			"scope", credential.Scope,
+			"active", credential.IsActive(time.Now()),
+			"expiresAt", credential.ExpiresAt,
+			"lastUsedAt", credential.LastUsedAt,
+			"revokedAt", credential.RevokedAt,
+		)
+	}
+}
+
+func revokeCredential(cmd *cobra.Command, args []string) {
+	logger, err := zap.NewProduction()
+	cobra.CheckErr(err)
+	defer logger.Sync() // flushes buffer, if any
+	sugar := logger.Sugar()
+
+	db, err := connect(sugar)
+	if err != nil {
+		sugar.Errorw("Failed to connect to database", "error", err)
+		return
+	}
+
+	idParam, _ := cmd.Flags().GetString("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		sugar.Errorw("Invalid credential ID", "id", idParam, "error", err)
+		return
+	}
+
+	var credential relational.AgentCredential
+	if err = db.First(&credential, "id = ?", id).Error; err != nil {
+		sugar.Errorw("Credential not found", "id", id, "error", err)
+		return
+	}
+
+	if credential.RevokedAt == nil {
+		now := time.Now()
+		credential.RevokedAt = &now
+		if err = db.Save(&credential).Error; err != nil {
+			sugar.Errorw("Failed to revoke credential", "error", err)
+			return
+		}
+	}
+	sugar.Infow("Credential revoked successfully",
+		"id", credential.ID,
+		"name", credential.Name,
+		"revokedAt", credential.RevokedAt,
+	)
+}
@@ -0,0 +1,188 @@
+package agents
+
+import (
+	"encoding/base64"
+	"os"
+	"time"
+
+	"github.com/compliance-framework/api/internal/provenance"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func newKeysCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keys",
+		Short: "Manage agent signing keys",
+		Long:  "This command allows you to register, list and revoke the ed25519 public keys agents sign their evidence with.",
+	}
+
+	cmd.AddCommand(newKeyAddCmd())
+	cmd.AddCommand(newKeyListCmd())
+	cmd.AddCommand(newKeyRevokeCmd())
+
+	return cmd
+}
+
+func newKeyAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Register an agent signing key",
+		Long:  "This command registers an ed25519 public key for an agent. Evidence signed with the key is verified when the agent is among the actors in its origins.",
+		Run:   addKey,
+	}
+
+	cmd.Flags().StringP("name", "n", "", "Name of the key (required)")
+	cmd.MarkFlagRequired("name")
+
+	cmd.Flags().String("agent", "", "UUID the agent uses as an actor in the origins of its evidence (required)")
+	cmd.MarkFlagRequired("agent")
+
+	cmd.Flags().String("public-key", "", "Path to a PEM encoded public key, as written by `openssl pkey -pubout` (required)")
+	cmd.MarkFlagRequired("public-key")
+
+	return cmd
+}
+
+func newKeyListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List agent signing keys",
+		Run:   listKeys,
+	}
+}
+
+func newKeyRevokeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Revoke an agent signing key",
+		Long:  "This command revokes an agent signing key. Evidence signed with the key afterwards is not verified.",
+		Run:   revokeKey,
+	}
+
+	cmd.Flags().String("id", "", "ID of the key to revoke (required)")
+	cmd.MarkFlagRequired("id")
+
+	return cmd
+}
+
+func addKey(cmd *cobra.Command, args []string) {
+	logger, err := zap.NewProduction()
+	cobra.CheckErr(err)
+	defer logger.Sync() // flushes buffer, if any
+	sugar := logger.Sugar()
+
+	name, _ := cmd.Flags().GetString("name")
+	agentParam, _ := cmd.Flags().GetString("agent")
+	agent, err := uuid.Parse(agentParam)
+	if err != nil {
+		sugar.Errorw("Invalid agent UUID", "agent", agentParam, "error", err)
+		return
+	}
+
+	path, _ := cmd.Flags().GetString("public-key")
+	encoded, err := os.ReadFile(path)
+	if err != nil {
+		sugar.Errorw("Failed to read public key", "path", path, "error", err)
+		return
+	}
+	publicKey, err := provenance.ParsePublicKey(string(encoded))
+	if err != nil {
+		sugar.Errorw("Invalid public key", "path", path, "error", err)
+		return
+	}
+
+	db, err := connect(sugar)
+	if err != nil {
+		sugar.Errorw("Failed to connect to database", "error", err)
+		return
+	}
+
+	key := &relational.AgentSigningKey{
+		Name:      name,
+		AgentUUID: agent,
+		Algorithm: provenance.AlgorithmEd25519,
+		PublicKey: base64.StdEncoding.EncodeToString(publicKey),
+	}
+	if err = db.Create(key).Error; err != nil {
+		sugar.Errorw("Failed to register key", "error", err)
+		return
+	}
+	sugar.Infow("Key registered successfully. Agents sign evidence by sending its ID as the key-id of their signature",
+		"id", key.ID,
+		"name", key.Name,
+		"agent", key.AgentUUID,
+	)
+}
+
+func listKeys(cmd *cobra.Command, args []string) {
+	logger, err := zap.NewProduction()
+	cobra.CheckErr(err)
+	defer logger.Sync() // flushes buffer, if any
+	sugar := logger.Sugar()
+
+	db, err := connect(sugar)
+	if err != nil {
+		sugar.Errorw("Failed to connect to database", "error", err)
+		return
+	}
+
+	var keys []relational.AgentSigningKey
+	if err = db.Order("created_at").Find(&keys).Error; err != nil {
+		sugar.Errorw("Failed to list keys", "error", err)
+		return
+	}
+
+	for _, key := range keys {
+		sugar.Infow("Key",
+			"id", key.ID,
+			"name", key.Name,
+			"agent", key.AgentUUID,
+			"algorithm", key.Algorithm,
+			"active", key.IsActive(time.Now()),
+			"revokedAt", key.RevokedAt,
+		)
+	}
+}
+
+func revokeKey(cmd *cobra.Command, args []string) {
+	logger, err := zap.NewProduction()
+	cobra.CheckErr(err)
+	defer logger.Sync() // flushes buffer, if any
+	sugar := logger.Sugar()
+
+	db, err := connect(sugar)
+	if err != nil {
+		sugar.Errorw("Failed to connect to database", "error", err)
+		return
+	}
+
+	idParam, _ := cmd.Flags().GetString("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		sugar.Errorw("Invalid key ID", "id", idParam, "error", err)
+		return
+	}
+
+	var key relational.AgentSigningKey
+	if err = db.First(&key, "id = ?", id).Error; err != nil {
+		sugar.Errorw("Key not found", "id", id, "error", err)
+		return
+	}
+
+	if key.RevokedAt == nil {
+		now := time.Now()
+		key.RevokedAt = &now
+		if err = db.Save(&key).Error; err != nil {
+			sugar.Errorw("Failed to revoke key", "error", err)
+			return
+		}
+	}
+	sugar.Infow("Key revoked successfully",
+		"id", key.ID,
+		"name", key.Name,
+		"revokedAt", key.RevokedAt,
+	)
+}
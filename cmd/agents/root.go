@@ -0,0 +1,16 @@
+package agents
+
+import "github.com/spf13/cobra"
+
+var (
+	RootCmd = &cobra.Command{
+		Use:   "agents",
+		Short: "Manage agents in the system",
+		Long:  "This command allows you to manage agents in the system, including the credentials they use to authenticate with the API and the keys they sign evidence with.",
+	}
+)
+
+func init() {
+	RootCmd.AddCommand(newCredentialsCmd())
+	RootCmd.AddCommand(newKeysCmd())
+}
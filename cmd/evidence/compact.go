@@ -0,0 +1,97 @@
+package evidence
+
+import (
+	"context"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/compliance-framework/api/internal/config"
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func newCompactCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compact",
+		Short: "Compact evidence according to the retention policy",
+		Long:  "This command removes evidence which falls outside of the retention policy configured in CCF_EVIDENCE_RETENTION_POLICY. The latest evidence in each stream is always kept. Attachment content which no evidence references any more is removed as well.",
+		Run:   compactEvidence,
+	}
+
+	cmd.Flags().Bool("dry-run", false, "List the evidence which would be removed, without removing it")
+	cmd.Flags().String("policy", "", "Retention policy to apply instead of the configured one, such as 7d=all,90d=1h,*=24h")
+
+	return cmd
+}
+
+func compactEvidence(cmd *cobra.Command, args []string) {
+	ctx := context.Background()
+	logger, err := zap.NewProduction()
+	cobra.CheckErr(err)
+	defer logger.Sync() // flushes buffer, if any
+	sugar := logger.Sugar()
+
+	config := config.NewConfig(sugar)
+	db, err := service.ConnectSQLDb(ctx, config, sugar)
+	if err != nil {
+		sugar.Errorw("Failed to connect to database", "error", err)
+		return
+	}
+
+	policyString := config.EvidenceRetentionPolicy
+	if cmd.Flags().Changed("policy") {
+		policyString, _ = cmd.Flags().GetString("policy")
+	}
+	policy, err := service.ParseRetentionPolicy(policyString)
+	if err != nil {
+		sugar.Errorw("Invalid retention policy", "error", err)
+		return
+	}
+	attachmentStore, err := service.NewBlobStore(config.EvidenceAttachmentStore, config.EvidenceAttachmentPath, db)
+	if err != nil {
+		sugar.Errorw("Failed to create evidence attachment store", "error", err)
+		return
+	}
+
+	compactor := service.NewEvidenceCompactor(db, sugar, policy, attachmentStore)
+	now := time.Now()
+
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	if dryRun {
+		plan, err := compactor.Plan(ctx, now)
+		if err != nil {
+			sugar.Errorw("Failed to plan compaction", "error", err)
+			return
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tSTREAM\tEND\tTITLE\tREASON")
+		for _, item := range plan.Evidence {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", item.ID, item.UUID, item.End.Format(time.RFC3339), item.Title, item.Reason)
+		}
+		w.Flush()
+
+		sugar.Infow("Dry run complete, no evidence was removed", "policy", policy.String(), "evidence", len(plan.Evidence), "sightings", plan.Sightings)
+		return
+	}
+
+	if len(policy) == 0 {
+		sugar.Info("No retention policy is configured, all evidence is kept")
+	} else {
+		evidence, sightings, err := compactor.Compact(ctx, now)
+		if err != nil {
+			sugar.Errorw("Failed to compact evidence", "error", err, "evidence", evidence)
+			return
+		}
+		sugar.Infow("Evidence compacted successfully", "policy", policy.String(), "evidence", evidence, "sightings", sightings)
+	}
+
+	blobs, err := compactor.SweepBlobs(ctx, now)
+	if err != nil {
+		sugar.Errorw("Failed to sweep evidence attachments", "error", err, "blobs", blobs)
+		return
+	}
+	sugar.Infow("Evidence attachments swept successfully", "blobs", blobs)
+}
@@ -0,0 +1,15 @@
+package evidence
+
+import "github.com/spf13/cobra"
+
+var (
+	RootCmd = &cobra.Command{
+		Use:   "evidence",
+		Short: "Manage evidence in the system",
+		Long:  "This command allows you to manage evidence stored in the system, including compacting old evidence according to the retention policy.",
+	}
+)
+
+func init() {
+	RootCmd.AddCommand(newCompactCmd())
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/compliance-framework/api/cmd/agents"
+	"github.com/compliance-framework/api/cmd/evidence"
 	"github.com/compliance-framework/api/cmd/oscal"
 	"github.com/compliance-framework/api/cmd/seed"
 	"github.com/compliance-framework/api/cmd/users"
@@ -23,6 +25,29 @@ var (
 func configSetDefaults() {
 	viper.SetDefault("app_port", ":8080")
 	viper.SetDefault("db_debug", "false")
+	viper.SetDefault("access_token_ttl", "15m")
+	viper.SetDefault("refresh_token_ttl", "720h")
+	viper.SetDefault("login_max_failures", 5)
+	viper.SetDefault("login_lockout_duration", "15m")
+	viper.SetDefault("login_ip_rate_limit", 20)
+	viper.SetDefault("login_account_rate_limit", 10)
+	viper.SetDefault("login_rate_limit_window", "1m")
+	viper.SetDefault("evidence_batch_max_items", 1000)
+	viper.SetDefault("evidence_compaction_interval", "1h")
+	viper.SetDefault("evidence_staleness_window", "0")
+	viper.SetDefault("evidence_attachment_store", "database")
+	viper.SetDefault("evidence_signature_policy", "flag")
+	viper.SetDefault("evidence_label_cardinality_limit", 1000)
+	viper.SetDefault("agent_offline_after", "5m")
+	viper.SetDefault("heartbeat_retention_policy", "1d=all,30d=10m,365d=1h")
+	viper.SetDefault("heartbeat_compaction_interval", "10m")
+	viper.SetDefault("alert_evaluation_interval", "1m")
+	viper.SetDefault("webhook_dispatch_interval", "10s")
+	viper.SetDefault("webhook_max_attempts", 10)
+	viper.SetDefault("oidc_scopes", "profile,email")
+	viper.SetDefault("oidc_groups_claim", "groups")
+	viper.SetDefault("oidc_login_redirect_url", "/")
+	viper.SetDefault("oidc_link_existing_users", "false")
 }
 
 func configEnvKeys() {
@@ -34,7 +59,45 @@ func configEnvKeys() {
 	viper.BindEnv("jwt_secret")
 	viper.BindEnv("jwt_private_key")
 	viper.BindEnv("jwt_public_key")
+	viper.BindEnv("access_token_ttl")
+	viper.BindEnv("refresh_token_ttl")
+	viper.BindEnv("login_max_failures")
+	viper.BindEnv("login_lockout_duration")
+	viper.BindEnv("login_ip_rate_limit")
+	viper.BindEnv("login_account_rate_limit")
+	viper.BindEnv("login_rate_limit_window")
 	viper.BindEnv("api_allowed_origins")
+	viper.BindEnv("trusted_proxies")
+	viper.BindEnv("evidence_batch_max_items")
+	viper.BindEnv("evidence_retention_policy")
+	viper.BindEnv("evidence_compaction_interval")
+	viper.BindEnv("evidence_staleness_window")
+	viper.BindEnv("evidence_attachment_store")
+	viper.BindEnv("evidence_attachment_path")
+	viper.BindEnv("evidence_signature_policy")
+	viper.BindEnv("evidence_label_cardinality_limit")
+	viper.BindEnv("agent_offline_after")
+	viper.BindEnv("heartbeat_retention_policy")
+	viper.BindEnv("heartbeat_compaction_interval")
+	viper.BindEnv("alert_evaluation_interval")
+	viper.BindEnv("alert_webhook_url")
+	viper.BindEnv("alert_smtp_addr")
+	viper.BindEnv("alert_smtp_username")
+	viper.BindEnv("alert_smtp_password")
+	viper.BindEnv("alert_smtp_from")
+	viper.BindEnv("alert_smtp_to")
+	viper.BindEnv("webhook_dispatch_interval")
+	viper.BindEnv("webhook_max_attempts")
+	viper.BindEnv("oidc_issuer")
+	viper.BindEnv("oidc_client_id")
+	viper.BindEnv("oidc_client_secret")
+	viper.BindEnv("oidc_redirect_url")
+	viper.BindEnv("oidc_scopes")
+	viper.BindEnv("oidc_groups_claim")
+	viper.BindEnv("oidc_role_mapping")
+	viper.BindEnv("oidc_default_role")
+	viper.BindEnv("oidc_login_redirect_url")
+	viper.BindEnv("oidc_link_existing_users")
 }
 
 func init() {
@@ -55,6 +118,8 @@ func init() {
 	rootCmd.AddCommand(RunCmd)
 	rootCmd.AddCommand(oscal.RootCmd)
 	rootCmd.AddCommand(users.RootCmd)
+	rootCmd.AddCommand(agents.RootCmd)
+	rootCmd.AddCommand(evidence.RootCmd)
 	rootCmd.AddCommand(seed.RootCmd)
 	rootCmd.AddCommand(newMigrateCMD())
 }
@@ -44,6 +44,30 @@ func RunServer(cmd *cobra.Command, args []string) {
 		sugar.Fatal("Failed to migrate database", "err", err)
 	}
 
+	retentionPolicy, err := service.ParseRetentionPolicy(config.EvidenceRetentionPolicy)
+	if err != nil {
+		sugar.Fatalw("Invalid evidence retention policy", "error", err)
+	}
+	attachmentStore, err := service.NewBlobStore(config.EvidenceAttachmentStore, config.EvidenceAttachmentPath, db)
+	if err != nil {
+		sugar.Fatalw("Failed to create evidence attachment store", "error", err)
+	}
+	go service.NewEvidenceCompactor(db, sugar, retentionPolicy, attachmentStore).Run(ctx, config.EvidenceCompactionInterval)
+
+	heartbeatPolicy, err := service.ParseHeartbeatRetentionPolicy(config.HeartbeatRetentionPolicy)
+	if err != nil {
+		sugar.Fatalw("Invalid heartbeat retention policy", "error", err)
+	}
+	go service.NewHeartbeatCompactor(db, sugar, heartbeatPolicy).Run(ctx, config.HeartbeatCompactionInterval)
+
+	notifiers := service.NewAlertNotifiers(config, sugar)
+	go service.NewAlertEvaluator(db, sugar, notifiers, config.EvidenceStalenessWindow).Run(ctx, config.AlertEvaluationInterval)
+
+	go service.NewWebhookDispatcher(db, sugar, config.WebhookMaxAttempts).Run(ctx, config.WebhookDispatchInterval)
+
+	// Revocations only need to be kept until the access tokens they revoke expire.
+	go service.NewSessionPruner(db, sugar).Run(ctx, config.AccessTokenTTL)
+
 	server := api.NewServer(ctx, sugar, config)
 
 	handler.RegisterHandlers(server, sugar, db, config)
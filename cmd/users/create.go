@@ -4,12 +4,15 @@ import (
 	"context"
 	"crypto/rand"
 	"errors"
+	"fmt"
 	"github.com/compliance-framework/api/internal/config"
 	"github.com/compliance-framework/api/internal/service"
 	"github.com/compliance-framework/api/internal/service/relational"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 	"math/big"
+	"slices"
 )
 
 func newUserAddCmd() *cobra.Command {
@@ -31,6 +34,8 @@ func newUserAddCmd() *cobra.Command {
 
 	cmd.Flags().StringP("password", "p", "", "Password of the user")
 
+	cmd.Flags().StringSliceP("role", "r", []string{relational.RoleReadOnly}, "Role of the user, which may be repeated")
+
 	return cmd
 }
 
@@ -80,14 +85,22 @@ func addUser(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	roleNames, _ := cmd.Flags().GetStringSlice("role")
+	roles, err := findRoles(db, roleNames)
+	if err != nil {
+		sugar.Errorw("Failed to find roles", "error", err)
+		return
+	}
+
 	newUser := relational.User{
 		Email:     email,
 		FirstName: firstName,
 		LastName:  lastName,
+		Roles:     roles,
 	}
 
 	newUser.SetPassword(password)
-	if err = db.Create(&newUser).Error; err != nil {
+	if err = db.Omit("Roles.*").Create(&newUser).Error; err != nil {
 		sugar.Errorw("Failed to create user", "error", err)
 		return
 	}
@@ -96,10 +109,25 @@ func addUser(cmd *cobra.Command, args []string) {
 		"email", newUser.Email,
 		"firstName", newUser.FirstName,
 		"lastName", newUser.LastName,
+		"roles", roleNames,
 		"password", password,
 	)
 }
 
+// findRoles loads the roles with the given names, failing if any of them does not exist.
+func findRoles(db *gorm.DB, names []string) ([]relational.AccessRole, error) {
+	roles := []relational.AccessRole{}
+	if err := db.Where("name IN ?", names).Find(&roles).Error; err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if !slices.ContainsFunc(roles, func(role relational.AccessRole) bool { return role.Name == name }) {
+			return nil, fmt.Errorf("unknown role %q", name)
+		}
+	}
+	return roles, nil
+}
+
 func generatePassword(length int) (string, error) {
 	const passwordCharset = "abcdefghijklmnopqrstuvwxyz" +
 		"ABCDEFGHIJKLMNOPQRSTUVWXYZ" +
@@ -13,4 +13,5 @@ var (
 func init() {
 	RootCmd.AddCommand(newUserAddCmd())
 	RootCmd.AddCommand(updateUserCmd())
+	RootCmd.AddCommand(unlockUserCmd())
 }
@@ -0,0 +1,64 @@
+package users
+
+import (
+	"context"
+
+	"github.com/compliance-framework/api/internal/config"
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func unlockUserCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock",
+		Short: "Unlock a user given an email address",
+		Long:  "This command unlocks a user who was locked after too many failed logins, and resets their failed logins. The unlock is recorded as an audit event.",
+		Run:   unlockUser,
+	}
+
+	cmd.Flags().StringP("email", "e", "", "Email of the user (required)")
+	cmd.MarkFlagRequired("email")
+
+	return cmd
+}
+
+func unlockUser(cmd *cobra.Command, args []string) {
+	ctx := context.Background()
+	logger, err := zap.NewProduction()
+	cobra.CheckErr(err)
+	defer logger.Sync() // flushes buffer, if any
+	sugar := logger.Sugar()
+
+	config := config.NewConfig(sugar)
+	db, err := service.ConnectSQLDb(ctx, config, sugar)
+	if err != nil {
+		sugar.Errorw("Failed to connect to database", "error", err)
+		return
+	}
+
+	email, err := cmd.Flags().GetString("email")
+	if err != nil || email == "" {
+		sugar.Error("Email is required")
+		return
+	}
+
+	var user relational.User
+	if err = db.Where("lower(email) = lower(?)", email).First(&user).Error; err != nil {
+		sugar.Errorw("User not found", "email", email, "error", err)
+		return
+	}
+	wasLocked := user.IsLocked
+
+	if err = service.NewAccountLockout(db, config).Unlock(&user, "cli"); err != nil {
+		sugar.Errorw("Failed to unlock user", "error", err)
+		return
+	}
+
+	if !wasLocked {
+		sugar.Infow("User was not locked, failed logins reset", "id", user.ID, "email", user.Email)
+		return
+	}
+	sugar.Infow("User unlocked successfully", "id", user.ID, "email", user.Email)
+}
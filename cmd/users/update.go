@@ -27,8 +27,12 @@ func updateUserCmd() *cobra.Command {
 	cmd.Flags().StringP("password", "p", "", "Password of the user (mutually exclusive with --generate-password)")
 	cmd.Flags().Bool("generate-password", false, "Generate a random password for the user (mutually exclusive with --password)")
 
+	cmd.Flags().StringSliceP("role", "r", nil, "Role of the user, which may be repeated. Replaces the roles of the user")
+
+	cmd.Flags().Bool("active", true, "Whether the user may log in. Deactivating a user logs them out of every session")
+
 	cmd.MarkFlagsMutuallyExclusive("password", "generate-password")
-	cmd.MarkFlagsOneRequired("first-name", "last-name", "password", "generate-password")
+	cmd.MarkFlagsOneRequired("first-name", "last-name", "password", "generate-password", "role", "active")
 
 	return cmd
 }
@@ -83,15 +87,44 @@ func updateUser(cmd *cobra.Command, args []string) {
 		user.SetPassword(password)
 	}
 
+	active, _ := cmd.Flags().GetBool("active")
+	deactivated := cmd.Flags().Changed("active") && user.IsActive && !active
+	if cmd.Flags().Changed("active") {
+		user.IsActive = active
+	}
+
 	if err = db.Save(&user).Error; err != nil {
 		sugar.Errorw("Failed to update user", "error", err)
 		return
 	}
+
+	// Sessions started with the previous password, or by a user who may no longer log in, must not outlive the change.
+	if password != "" || deactivated {
+		if err = service.NewSessionManager(db, config).RevokeUserSessions(*user.ID); err != nil {
+			sugar.Errorw("Failed to revoke user sessions", "error", err)
+			return
+		}
+	}
+
+	roleNames, _ := cmd.Flags().GetStringSlice("role")
+	if cmd.Flags().Changed("role") {
+		roles, err := findRoles(db, roleNames)
+		if err != nil {
+			sugar.Errorw("Failed to find roles", "error", err)
+			return
+		}
+		if err = db.Model(&user).Omit("Roles.*").Association("Roles").Replace(roles); err != nil {
+			sugar.Errorw("Failed to update user roles", "error", err)
+			return
+		}
+	}
 	sugar.Infow("User updated successfully",
 		"id", user.ID,
 		"email", user.Email,
 		"firstName", user.FirstName,
 		"lastName", user.LastName,
+		"roles", roleNames,
+		"active", user.IsActive,
 		"password", password,
 	)
 }
@@ -23,7 +23,12 @@ const docTemplate = `{
     "paths": {
         "/agent/heartbeat": {
             "post": {
-                "description": "Creates a new heartbeat record for monitoring.",
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Creates a new heartbeat record for monitoring, and registers the agent and the details it reports in the agent registry.",
                 "consumes": [
                     "application/json"
                 ],
@@ -66,7 +71,12 @@ const docTemplate = `{
         },
         "/agent/heartbeat/over-time": {
             "get": {
-                "description": "Retrieves heartbeat counts aggregated by 2-minute intervals.",
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves the number of agents sending heartbeats within each bucket of a time range, oldest first. Buckets without heartbeats are left out.\nBuckets are aligned to midnight UTC on 1 January 2000. Once raw heartbeats are pruned, older buckets are counted from the rollups whose resolution the bucket size is a multiple of.",
                 "produces": [
                     "application/json"
                 ],
@@ -74,11 +84,37 @@ const docTemplate = `{
                     "Heartbeat"
                 ],
                 "summary": "Get Heartbeat Metrics Over Time",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bucket size, such as 5m, 1h or 1d. Defaults to 2m",
+                        "name": "bucket",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start of the time range, as an RFC3339 timestamp or a duration before now such as 7d. Defaults to 24 hours before to",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the time range, as an RFC3339 timestamp or a duration before now such as 1h. Defaults to now",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-handler_OverTime_HeartbeatInterval"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-service_HeartbeatInterval"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
                         }
                     },
                     "500": {
@@ -90,35 +126,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/login": {
-            "post": {
-                "description": "Login user and returns a JWT token and sets a cookie with the token",
-                "consumes": [
-                    "application/json"
+        "/agents": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
                 ],
+                "description": "Lists the agents known from their heartbeats, most recently seen first, with whether they are online and the number of evidence streams they collect.\nAgents are online when they sent a heartbeat within the configured offline threshold.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Agents"
                 ],
-                "summary": "Login user",
+                "summary": "List agents",
                 "parameters": [
                     {
-                        "description": "Login Data",
-                        "name": "loginRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/auth.AuthHandler"
-                        }
+                        "enum": [
+                            "online",
+                            "offline"
+                        ],
+                        "type": "string",
+                        "description": "Only list agents which are online or offline",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-auth_AuthHandler"
+                            "$ref": "#/definitions/service.ListResponse-handler_AgentResponse"
                         }
                     },
                     "400": {
@@ -127,12 +178,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-auth_AuthHandler"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -142,24 +187,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/publickey": {
+        "/agents/{uuid}": {
             "get": {
-                "description": "Get JSON Web Key (JWK) representation of the JWT public key",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
                 ],
+                "description": "Retrieves an agent known from its heartbeats, with whether it is online and the evidence streams whose origins include it.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Agents"
+                ],
+                "summary": "Get an agent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Agent UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get JWK",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/authn.JWK"
+                            "$ref": "#/definitions/handler.GenericDataResponse-handler_AgentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
                         }
                     },
                     "500": {
@@ -171,40 +239,56 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/token": {
-            "post": {
-                "description": "Get OAuth2 token using username and password",
-                "consumes": [
-                    "application/x-www-form-urlencoded"
+        "/alerts": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
                 ],
+                "description": "Lists alerts fired by alert rules, most recently fired first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Alerts"
                 ],
-                "summary": "Get OAuth2 token",
+                "summary": "List alerts",
                 "parameters": [
                     {
+                        "enum": [
+                            "firing",
+                            "resolved"
+                        ],
                         "type": "string",
-                        "description": "Username (email)",
-                        "name": "username",
-                        "in": "formData",
-                        "required": true
+                        "description": "Only list alerts which are firing or resolved",
+                        "name": "state",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Password",
-                        "name": "password",
-                        "in": "formData",
-                        "required": true
+                        "description": "Only list alerts fired by this rule",
+                        "name": "rule",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/auth.AuthHandler"
+                            "$ref": "#/definitions/service.ListResponse-relational_Alert"
                         }
                     },
                     "400": {
@@ -213,11 +297,36 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
+                    }
+                }
+            }
+        },
+        "/alerts/rules": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Lists the alert rules, which are evaluated periodically.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Alerts"
+                ],
+                "summary": "List alert rules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataListResponse-relational_AlertRule"
+                        }
                     },
                     "500": {
                         "description": "Internal Server Error",
@@ -226,16 +335,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/evidence": {
+            },
             "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates a new Evidence record including activities, inventory items, components, and subjects.",
+                "description": "Creates an alert rule. agent-offline rules fire for agents without a heartbeat within the window, evidence-gap rules for streams without evidence within the window, and compliance-below rules when the percentage of satisfied evidence for a control drops below the threshold.",
                 "consumes": [
                     "application/json"
                 ],
@@ -243,23 +350,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Evidence"
+                    "Alerts"
                 ],
-                "summary": "Create new Evidence",
+                "summary": "Create an alert rule",
                 "parameters": [
                     {
-                        "description": "Evidence create request",
-                        "name": "evidence",
+                        "description": "Alert rule",
+                        "name": "rule",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.EvidenceCreateRequest"
+                            "$ref": "#/definitions/handler.AlertRuleRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Created"
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-relational_AlertRule"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -276,20 +386,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/evidence/compliance-by-control/{id}": {
+        "/alerts/rules/{id}": {
             "get": {
-                "description": "Retrieves the count of evidence statuses for filters associated with a specific Control ID.",
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves an alert rule by its ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Evidence"
+                    "Alerts"
                 ],
-                "summary": "Get compliance counts by control",
+                "summary": "Get an alert rule",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Control ID",
+                        "description": "Alert rule ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -299,7 +414,19 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-handler_ComplianceByControl_StatusCount"
+                            "$ref": "#/definitions/handler.GenericDataResponse-relational_AlertRule"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
                         }
                     },
                     "500": {
@@ -309,32 +436,47 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/evidence/for-control/{id}": {
-            "get": {
-                "description": "Retrieves Evidence records associated with a specific Control ID, including related activities, inventory items, components, subjects, and labels.",
+            },
+            "put": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Replaces an alert rule. Alerts it already fired are resolved at its next evaluation once their condition no longer holds, or when it is disabled.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Evidence"
+                    "Alerts"
                 ],
-                "summary": "List Evidence for a Control",
+                "summary": "Update an alert rule",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Control ID",
+                        "description": "Alert rule ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Alert rule",
+                        "name": "rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.AlertRuleRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.ForControl.EvidenceDataListResponse"
+                            "$ref": "#/definitions/handler.GenericDataResponse-relational_AlertRule"
                         }
                     },
                     "400": {
@@ -356,33 +498,30 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/evidence/history/{id}": {
-            "get": {
-                "description": "Retrieves a the history for a Evidence record by its UUID, including associated activities, inventory items, components, subjects, and labels.",
-                "produces": [
-                    "application/json"
+            },
+            "delete": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
                 ],
+                "description": "Deletes an alert rule, along with the alerts it fired.",
                 "tags": [
-                    "Evidence"
+                    "Alerts"
                 ],
-                "summary": "Get Evidence history by UUID",
+                "summary": "Delete an alert rule",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Evidence ID",
+                        "description": "Alert rule ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-handler_OscalLikeEvidence"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -405,45 +544,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/evidence/search": {
-            "post": {
-                "description": "Searches Evidence records by label filters.",
-                "consumes": [
-                    "application/json"
+        "/alerts/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
                 ],
+                "description": "Retrieves an alert, along with the rule which fired it.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Evidence"
+                    "Alerts"
                 ],
-                "summary": "Search Evidence",
+                "summary": "Get an alert",
                 "parameters": [
                     {
-                        "description": "Label filter",
-                        "name": "filter",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/labelfilter.Filter"
-                        }
+                        "type": "string",
+                        "description": "Alert ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-relational_Evidence"
+                            "$ref": "#/definitions/handler.GenericDataResponse-relational_Alert"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -451,33 +596,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/evidence/status-over-time": {
-            "post": {
-                "description": "Retrieves counts of evidence statuses at various time intervals based on a label filter.",
-                "consumes": [
-                    "application/json"
+        "/audit-events": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
                 ],
+                "description": "Lists audit events, such as users being locked after too many failed logins and unlocked, most recent first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Evidence"
+                    "Audit Events"
                 ],
-                "summary": "Evidence status metrics over intervals",
+                "summary": "List audit events",
                 "parameters": [
                     {
-                        "description": "Label filter",
-                        "name": "filter",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/labelfilter.Filter"
-                        }
+                        "enum": [
+                            "user.locked",
+                            "user.unlocked"
+                        ],
+                        "type": "string",
+                        "description": "Only list events of this type",
+                        "name": "type",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Comma-separated list of duration intervals (e.g., '10m,1h,24h')",
-                        "name": "intervals",
+                        "description": "Only list events concerning this user",
+                        "name": "userId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page",
+                        "name": "limit",
                         "in": "query"
                     }
                 ],
@@ -485,7 +645,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-handler_StatusInterval"
+                            "$ref": "#/definitions/service.ListResponse-relational_AuditEvent"
                         }
                     },
                     "400": {
@@ -494,12 +654,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -509,36 +663,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/evidence/status-over-time/{id}": {
-            "get": {
-                "description": "Retrieves counts of evidence statuses at various time intervals for a specific evidence stream identified by UUID.",
+        "/auth/login": {
+            "post": {
+                "description": "Login user and returns a JWT token and a refresh token, and sets cookies with both",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Evidence"
+                    "Auth"
                 ],
-                "summary": "Evidence status metrics over intervals by UUID",
+                "summary": "Login user",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Evidence UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Comma-separated list of duration intervals (e.g., '10m,1h,24h')",
-                        "name": "intervals",
-                        "in": "query"
+                        "description": "Login Data",
+                        "name": "loginRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/auth.AuthHandler"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-handler_StatusInterval"
+                            "$ref": "#/definitions/handler.GenericDataResponse-auth_AuthHandler"
                         }
                     },
                     "400": {
@@ -547,8 +700,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-auth_AuthHandler"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -562,31 +721,71 @@ const docTemplate = `{
                 }
             }
         },
-        "/evidence/{id}": {
-            "get": {
-                "description": "Retrieves a single Evidence record by its unique ID, including associated activities, inventory items, components, subjects, and labels.",
-                "produces": [
+        "/auth/logout": {
+            "post": {
+                "description": "Revokes the session of the refresh token, sent in the body or in the ccf_refresh_token cookie, and of the JWT token the request is authenticated with, and clears the auth cookies. Tokens which are invalid or already revoked are ignored.",
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "Evidence"
+                    "Auth"
                 ],
-                "summary": "Get Evidence by ID",
+                "summary": "Logout",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Evidence ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Refresh token, unless sent as a cookie",
+                        "name": "logoutRequest",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/auth.AuthHandler"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-handler_OscalLikeEvidence"
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
                         }
+                    }
+                }
+            }
+        },
+        "/auth/oidc/callback": {
+            "get": {
+                "description": "Completes a login with the OpenID Connect provider, creating the user on their first login and giving them the roles their groups are mapped to. Sets the auth cookies and redirects to CCF_OIDC_LOGIN_REDIRECT_URL.",
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "OpenID Connect callback",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Authorization code",
+                        "name": "code",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "State",
+                        "name": "state",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Redirect once logged in"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -594,8 +793,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -609,21 +820,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/filters": {
+        "/auth/oidc/login": {
             "get": {
-                "description": "Retrieves all filters.",
+                "description": "Redirects the browser to the OpenID Connect provider to log in. Only available when CCF_OIDC_ISSUER is set.",
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Login with OpenID Connect",
+                "responses": {
+                    "302": {
+                        "description": "Redirect to the provider"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/publickey": {
+            "get": {
+                "description": "Get JSON Web Key (JWK) representation of the JWT public key",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Filters"
+                    "Auth"
                 ],
-                "summary": "List filters",
+                "summary": "Get JWK",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-handler_FilterWithControlsResponse"
+                            "$ref": "#/definitions/authn.JWK"
                         }
                     },
                     "500": {
@@ -633,9 +867,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/auth/refresh": {
             "post": {
-                "description": "Creates a new filter.",
+                "description": "Exchanges a refresh token, sent in the body or in the ccf_refresh_token cookie, for a new JWT token and a new refresh token, and sets cookies with both. The refresh token is replaced, and using it again revokes the session.",
                 "consumes": [
                     "application/json"
                 ],
@@ -643,25 +879,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Filters"
+                    "Auth"
                 ],
-                "summary": "Create a new filter",
+                "summary": "Refresh tokens",
                 "parameters": [
                     {
-                        "description": "Filter to add",
-                        "name": "filter",
+                        "description": "Refresh token, unless sent as a cookie",
+                        "name": "refreshRequest",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.createFilterRequest"
+                            "$ref": "#/definitions/auth.AuthHandler"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-relational_Filter"
+                            "$ref": "#/definitions/handler.GenericDataResponse-auth_AuthHandler"
                         }
                     },
                     "400": {
@@ -670,8 +905,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -685,22 +920,32 @@ const docTemplate = `{
                 }
             }
         },
-        "/filters/{id}": {
-            "get": {
-                "description": "Retrieves a single filter by its unique ID.",
+        "/auth/token": {
+            "post": {
+                "description": "Get OAuth2 token using username and password",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Filters"
+                    "Auth"
                 ],
-                "summary": "Get a filter",
+                "summary": "Get OAuth2 token",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter ID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "Username (email)",
+                        "name": "username",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Password",
+                        "name": "password",
+                        "in": "formData",
                         "required": true
                     }
                 ],
@@ -708,7 +953,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-handler_FilterWithControlsResponse"
+                            "$ref": "#/definitions/auth.AuthHandler"
                         }
                     },
                     "400": {
@@ -717,8 +962,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -730,9 +981,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Updates an existing filter.",
+            }
+        },
+        "/evidence": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Creates a new Evidence record including activities, inventory items, components, and subjects.\nThe signature of the evidence is verified, and evidence which is unsigned or invalid is rejected when signatures are required.",
                 "consumes": [
                     "application/json"
                 ],
@@ -740,72 +998,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Filters"
+                    "Evidence"
                 ],
-                "summary": "Update a filter",
+                "summary": "Create new Evidence",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Filter ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Filter to update",
-                        "name": "filter",
+                        "description": "Evidence create request",
+                        "name": "evidence",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.createFilterRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-relational_Filter"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
+                            "$ref": "#/definitions/handler.EvidenceCreateRequest"
                         }
                     }
-                }
-            },
-            "delete": {
-                "description": "Deletes a filter.",
-                "tags": [
-                    "Filters"
-                ],
-                "summary": "Delete a filter",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Filter ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -813,12 +1022,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -828,14 +1031,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/activities": {
+        "/evidence/batch": {
             "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates a new activity for us in other resources.",
+                "description": "Creates many Evidence records in a single request. Items are written in one transaction, or in transactions of ` + "`" + `chunk` + "`" + ` items when set, and a status is returned for each item. Batches of more items than configured are rejected.",
                 "consumes": [
                     "application/json"
                 ],
@@ -843,41 +1046,50 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Activities"
+                    "Evidence"
                 ],
-                "summary": "Create an Activity",
+                "summary": "Create a batch of Evidence",
                 "parameters": [
                     {
-                        "description": "Activity object",
-                        "name": "activity",
+                        "description": "Evidence create requests",
+                        "name": "evidence",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Activity"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.EvidenceCreateRequest"
+                            }
                         }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items written per transaction. Defaults to the whole batch.",
+                        "name": "chunk",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Activity"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-handler_EvidenceBatchItemResult"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "207": {
+                        "description": "Multi-Status",
                         "schema": {
-                            "$ref": "#/definitions/api.Error"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-handler_EvidenceBatchItemResult"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "413": {
+                        "description": "Request Entity Too Large",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -885,28 +1097,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/activities/{id}": {
+        "/evidence/compliance-by-control/{id}": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves an Activity by its unique ID.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves the count of evidence statuses for filters associated with a specific Control ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Activities"
+                    "Evidence"
                 ],
-                "summary": "Retrieve an Activity",
+                "summary": "Get compliance counts by control",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Activity ID",
+                        "description": "Control ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -916,17 +1125,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Activity"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-handler_StatusCount"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -938,47 +1141,67 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/evidence/for-control/{id}": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates properties of an existing Activity by its ID.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves Evidence records associated with a specific Control ID, including related activities, inventory items, components, subjects, and labels.\nEvidence is returned one page at a time, as for searches.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Activities"
+                    "Evidence"
                 ],
-                "summary": "Update an Activity",
+                "summary": "List Evidence for a Control",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Activity ID",
+                        "description": "Control ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Activity object",
-                        "name": "activity",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Activity"
-                        }
+                        "type": "integer",
+                        "description": "Page number, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as nextCursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by end, status or title, prefixed with - for descending order. Defaults to -end",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated list of fields to return for each item",
+                        "name": "fields",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Activity"
+                            "$ref": "#/definitions/handler.ForControl.EvidenceDataListResponse"
                         }
                     },
                     "400": {
@@ -993,6 +1216,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1000,30 +1229,68 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/evidence/history/{id}": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Deletes an activity",
+                "description": "Retrieves a the history for a Evidence record by its UUID, including associated activities, inventory items, components, subjects, and labels.\nEach sighting of the evidence is a separate entry, and entries are returned one page at a time.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Activities"
+                    "Evidence"
                 ],
-                "summary": "Delete Activity",
+                "summary": "Get Evidence history by UUID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Activity ID",
+                        "description": "Evidence ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as nextCursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by end, status or title, prefixed with - for descending order. Defaults to -end",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated list of fields to return for each item",
+                        "name": "fields",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/service.ListResponse-handler_OscalLikeEvidence"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1046,30 +1313,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-plans": {
+        "/evidence/labels": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all Assessment Plans.",
+                "description": "Lists the label names used by the latest evidence of each stream, with the number of distinct values and streams for each.\nLabels with more distinct values than the configured cardinality limit are flagged, as they are rarely useful to filter on.\nNames are grouped regardless of case, as filters match them, and are listed in lower case.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Plans"
+                    "Evidence"
+                ],
+                "summary": "List Evidence label names",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Label filter query, selecting the streams whose labels are listed",
+                        "name": "q",
+                        "in": "query"
+                    }
                 ],
-                "summary": "List Assessment Plans",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_AssessmentPlan"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-relational_EvidenceLabelName"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -1081,56 +1356,77 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/evidence/labels/{name}/values": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates a new OSCAL Assessment Plan with comprehensive validation.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Lists the values of a label used by the latest evidence of each stream, with the number of streams using each, most used first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Plans"
+                    "Evidence"
                 ],
-                "summary": "Create an Assessment Plan",
+                "summary": "List Evidence label values",
                 "parameters": [
                     {
-                        "description": "Assessment Plan object with required fields: UUID, metadata (title, version), import-ssp",
-                        "name": "plan",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.AssessmentPlan"
-                        }
+                        "type": "string",
+                        "description": "Label name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only list values starting with this prefix, regardless of case",
+                        "name": "prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Label filter query, selecting the streams whose label values are listed",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Successfully created assessment plan",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentPlan"
+                            "$ref": "#/definitions/service.ListResponse-relational_EvidenceLabelValue"
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation errors or malformed input",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized - invalid or missing JWT token",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -1138,35 +1434,76 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-plans/{id}": {
-            "get": {
+        "/evidence/search": {
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves a single Assessment Plan by its unique ID.",
+                "description": "Searches the latest Evidence of each stream by label filters, one page at a time.\nPages are selected by page number, or by the cursor returned with the previous page when sorting by end.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Plans"
+                    "Evidence"
                 ],
-                "summary": "Get an Assessment Plan",
+                "summary": "Search Evidence",
                 "parameters": [
+                    {
+                        "description": "Label filter",
+                        "name": "filter",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/labelfilter.Filter"
+                        }
+                    },
                     {
                         "type": "string",
-                        "description": "Assessment Plan ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Label filter query, such as 'provider=aws AND NOT env=dev', replacing the filter in the body",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as nextCursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by end, status or title, prefixed with - for descending order. Defaults to -end",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated list of fields to return for each item",
+                        "name": "fields",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentPlan"
+                            "$ref": "#/definitions/service.ListResponse-relational_Evidence"
                         }
                     },
                     "400": {
@@ -1175,66 +1512,49 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/evidence/stale": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates an existing Assessment Plan.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Lists evidence streams whose latest evidence has expired, or has not been seen within the configured staleness window, grouped by the value of a label. Streams without the label are grouped under an empty value.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Plans"
+                    "Evidence"
                 ],
-                "summary": "Update an Assessment Plan",
+                "summary": "List stale Evidence streams",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Plan ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Assessment Plan object",
-                        "name": "plan",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.AssessmentPlan"
-                        }
+                        "description": "Label to group streams by",
+                        "name": "label",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentPlan"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-handler_StaleEvidenceGroup"
                         }
                     },
                     "500": {
@@ -1244,30 +1564,55 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/evidence/status-over-time": {
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Deletes an Assessment Plan by its unique ID.",
+                "description": "Retrieves counts of evidence statuses at various time intervals based on a label filter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Assessment Plans"
+                    "Evidence"
                 ],
-                "summary": "Delete an Assessment Plan",
+                "summary": "Evidence status metrics over intervals",
                 "parameters": [
+                    {
+                        "description": "Label filter",
+                        "name": "filter",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/labelfilter.Filter"
+                        }
+                    },
                     {
                         "type": "string",
-                        "description": "Assessment Plan ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Comma-separated list of duration intervals (e.g., '10m,1h,24h')",
+                        "name": "intervals",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Label filter query, such as 'provider=aws AND NOT env=dev', replacing the filter in the body",
+                        "name": "q",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataListResponse-handler_StatusInterval"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1275,8 +1620,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -1290,35 +1635,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-plans/{id}/assessment-assets": {
+        "/evidence/status-over-time/{id}": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all assessment assets for an Assessment Plan.",
+                "description": "Retrieves counts of evidence statuses at various time intervals for a specific evidence stream identified by UUID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Plans"
+                    "Evidence"
                 ],
-                "summary": "Get Assessment Plan Assets",
+                "summary": "Evidence status metrics over intervals by UUID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Plan ID",
+                        "description": "Evidence UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated list of duration intervals (e.g., '10m,1h,24h')",
+                        "name": "intervals",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-array_oscalTypes_1_1_3_AssessmentAssets"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-handler_StatusInterval"
                         }
                     },
                     "400": {
@@ -1327,8 +1678,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -1340,47 +1691,42 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/evidence/stream": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates a new assessment asset for an Assessment Plan.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Streams newly created evidence, and changes of state in evidence streams, as server-sent events.\nEvents are named evidence.created and evidence.transitioned, and carry a service.EvidenceEvent as data.\nSubscribers which fall behind miss events, and receive a dropped event with the number missed.",
                 "produces": [
-                    "application/json"
+                    "text/event-stream"
                 ],
                 "tags": [
-                    "Assessment Plans"
+                    "Evidence"
                 ],
-                "summary": "Create Assessment Plan Asset",
+                "summary": "Stream Evidence events",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Plan ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Label filter query selecting the evidence to stream, such as 'provider=aws AND @status=not-satisfied'",
+                        "name": "q",
+                        "in": "query"
                     },
                     {
-                        "description": "Assessment Asset object",
-                        "name": "asset",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.AssessmentAssets"
-                        }
+                        "type": "string",
+                        "description": "Comma-separated event types to stream: created, transitioned. Defaults to all",
+                        "name": "types",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentAssets"
+                            "$ref": "#/definitions/service.EvidenceEvent"
                         }
                     },
                     "400": {
@@ -1389,14 +1735,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -1404,54 +1744,64 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-plans/{id}/assessment-assets/{assetId}": {
-            "put": {
+        "/evidence/transitions": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates an existing assessment asset for an Assessment Plan.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Lists changes in the state of evidence streams, newest first. The first evidence of a stream is listed as a transition from an empty state.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Plans"
+                    "Evidence"
                 ],
-                "summary": "Update Assessment Plan Asset",
+                "summary": "List Evidence transitions",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Plan ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Label filter query, selecting transitions to evidence matching the filter",
+                        "name": "q",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Assessment Asset ID",
-                        "name": "assetId",
-                        "in": "path",
-                        "required": true
+                        "description": "Evidence stream UUID",
+                        "name": "uuid",
+                        "in": "query"
                     },
                     {
-                        "description": "Assessment Asset object",
-                        "name": "asset",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.AssessmentAssets"
-                        }
+                        "type": "string",
+                        "description": "Earliest transition, as an RFC3339 timestamp or a duration before now such as 7d",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest transition, as an RFC3339 timestamp or a duration before now such as 24h",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentAssets"
+                            "$ref": "#/definitions/service.ListResponse-relational_EvidenceTransition"
                         }
                     },
                     "400": {
@@ -1460,8 +1810,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -1473,37 +1823,55 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/evidence/transitions/mttr": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Deletes an assessment asset from an Assessment Plan.",
+                "description": "Counts how often each evidence stream failed and recovered between from and to, and the mean time to recover in seconds.\nAny state other than satisfied is a failure. Recoveries are timed from the failure preceding them, even when it happened before from.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Assessment Plans"
+                    "Evidence"
                 ],
-                "summary": "Delete Assessment Plan Asset",
+                "summary": "Evidence recovery statistics",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Plan ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Label filter query, selecting streams whose latest evidence matches the filter",
+                        "name": "q",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Assessment Asset ID",
-                        "name": "assetId",
-                        "in": "path",
-                        "required": true
+                        "description": "Evidence stream UUID",
+                        "name": "uuid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start of the period, as an RFC3339 timestamp or a duration before now such as 30d",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the period, as an RFC3339 timestamp or a duration before now such as 24h",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataListResponse-relational_EvidenceRecovery"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1511,8 +1879,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -1526,25 +1894,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-plans/{id}/assessment-subjects": {
+        "/evidence/{id}": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all assessment subjects for an Assessment Plan.",
+                "description": "Retrieves a single Evidence record by its unique ID, including associated activities, inventory items, components, subjects, and labels.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Plans"
+                    "Evidence"
                 ],
-                "summary": "Get Assessment Plan Subjects",
+                "summary": "Get Evidence by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Plan ID",
+                        "description": "Evidence ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1554,7 +1922,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-array_oscalTypes_1_1_3_AssessmentSubject"
+                            "$ref": "#/definitions/handler.GenericDataResponse-handler_OscalLikeEvidence"
                         }
                     },
                     "400": {
@@ -1576,47 +1944,44 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/evidence/{id}/attachments/{name}": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates a new assessment subject for an Assessment Plan.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Downloads the raw content of an attachment of an Evidence record. The ETag is the SHA-256 hash of the content.",
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "Assessment Plans"
+                    "Evidence"
                 ],
-                "summary": "Create Assessment Plan Subject",
+                "summary": "Download an Evidence attachment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Plan ID",
+                        "description": "Evidence ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Assessment Subject object",
-                        "name": "subject",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.AssessmentSubject"
-                        }
+                        "type": "string",
+                        "description": "Attachment name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentSubject"
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -1640,54 +2005,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-plans/{id}/assessment-subjects/{subjectId}": {
-            "put": {
+        "/evidence/{id}/diff": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates an existing assessment subject for an Assessment Plan.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Compares the status, labels, props, links, subjects, components, inventory items and activity steps of two Evidence records of the same stream.\nWithout to, the latest evidence of the stream is compared. Without from, the evidence preceding to is compared.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Plans"
+                    "Evidence"
                 ],
-                "summary": "Update Assessment Plan Subject",
+                "summary": "Compare two Evidence records of a stream",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Plan ID",
+                        "description": "Evidence stream UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Assessment Subject ID",
-                        "name": "subjectId",
-                        "in": "path",
-                        "required": true
+                        "description": "ID of the earlier evidence",
+                        "name": "from",
+                        "in": "query"
                     },
                     {
-                        "description": "Assessment Subject object",
-                        "name": "subject",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.AssessmentSubject"
-                        }
+                        "type": "string",
+                        "description": "ID of the later evidence",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentSubject"
+                            "$ref": "#/definitions/handler.GenericDataResponse-relational_EvidenceDiff"
                         }
                     },
                     "400": {
@@ -1709,37 +2067,78 @@ const docTemplate = `{
                         }
                     }
                 }
+            }
+        },
+        "/filters": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves all filters.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Filters"
+                ],
+                "summary": "List filters",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataListResponse-handler_FilterWithControlsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
             },
-            "delete": {
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Deletes an assessment subject from an Assessment Plan.",
+                "description": "Creates a new filter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Assessment Plans"
+                    "Filters"
                 ],
-                "summary": "Delete Assessment Plan Subject",
+                "summary": "Create a new filter",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Assessment Plan ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Filter to add",
+                        "name": "filter",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.createFilterRequest"
+                        }
                     },
                     {
                         "type": "string",
-                        "description": "Assessment Subject ID",
-                        "name": "subjectId",
-                        "in": "path",
-                        "required": true
+                        "description": "Label filter query, such as 'provider=aws AND NOT env=dev', replacing the filter in the body",
+                        "name": "q",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-relational_Filter"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1747,8 +2146,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -1762,25 +2161,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-plans/{id}/back-matter": {
+        "/filters/{id}": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves back matter for an Assessment Plan.",
+                "description": "Retrieves a single filter by its unique ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Plans"
+                    "Filters"
                 ],
-                "summary": "Get Assessment Plan Back Matter",
+                "summary": "Get a filter",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Plan ID",
+                        "description": "Filter ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1790,7 +2189,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
+                            "$ref": "#/definitions/handler.GenericDataResponse-handler_FilterWithControlsResponse"
                         }
                     },
                     "400": {
@@ -1812,37 +2211,53 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/assessment-plans/{id}/full": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves a single Assessment Plan by its unique ID with all related data preloaded.",
+                "description": "Updates an existing filter.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Plans"
+                    "Filters"
                 ],
-                "summary": "Get a full Assessment Plan",
+                "summary": "Update a filter",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Plan ID",
+                        "description": "Filter ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Filter to update",
+                        "name": "filter",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.createFilterRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Label filter query, such as 'provider=aws AND NOT env=dev', replacing the filter in the body",
+                        "name": "q",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentPlan"
+                            "$ref": "#/definitions/handler.GenericDataResponse-relational_Filter"
                         }
                     },
                     "400": {
@@ -1857,6 +2272,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1864,38 +2285,30 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/assessment-plans/{id}/import-ssp": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves import SSP information for an Assessment Plan.",
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Deletes a filter.",
                 "tags": [
-                    "Assessment Plans"
+                    "Filters"
                 ],
-                "summary": "Get Assessment Plan Import SSP",
+                "summary": "Delete a filter",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Plan ID",
+                        "description": "Filter ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ImportSsp"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1918,35 +2331,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-plans/{id}/local-definitions": {
-            "get": {
+        "/oscal/activities": {
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves local definitions for an Assessment Plan.",
+                "description": "Creates a new activity for us in other resources.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Plans"
+                    "Activities"
                 ],
-                "summary": "Get Assessment Plan Local Definitions",
+                "summary": "Create an Activity",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Assessment Plan ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Activity object",
+                        "name": "activity",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Activity"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_LocalDefinitions"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Activity"
                         }
                     },
                     "400": {
@@ -1970,25 +2388,28 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-plans/{id}/metadata": {
+        "/oscal/activities/{id}": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves metadata for an Assessment Plan.",
+                "description": "Retrieves an Activity by its unique ID.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Plans"
+                    "Activities"
                 ],
-                "summary": "Get Assessment Plan Metadata",
+                "summary": "Retrieve an Activity",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Plan ID",
+                        "description": "Activity ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1998,7 +2419,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Metadata"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Activity"
                         }
                     },
                     "400": {
@@ -2020,37 +2441,47 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/assessment-plans/{id}/tasks": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all tasks for an Assessment Plan.",
+                "description": "Updates properties of an existing Activity by its ID.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Plans"
+                    "Activities"
                 ],
-                "summary": "Get Assessment Plan Tasks",
+                "summary": "Update an Activity",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Plan ID",
+                        "description": "Activity ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Activity object",
+                        "name": "activity",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Activity"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-array_oscalTypes_1_1_3_Task"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Activity"
                         }
                     },
                     "400": {
@@ -2073,47 +2504,29 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "delete": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates a new task for an Assessment Plan.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Deletes an activity",
                 "tags": [
-                    "Assessment Plans"
+                    "Activities"
                 ],
-                "summary": "Create Assessment Plan Task",
+                "summary": "Delete Activity",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Plan ID",
+                        "description": "Activity ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Task object",
-                        "name": "task",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Task"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Task"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2136,54 +2549,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-plans/{id}/tasks/{taskId}": {
-            "put": {
+        "/oscal/assessment-plans": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates an existing task for an Assessment Plan.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves all Assessment Plans.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Plans"
                 ],
-                "summary": "Update Assessment Plan Task",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Assessment Plan ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Task ID",
-                        "name": "taskId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Task object",
-                        "name": "task",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Task"
-                        }
-                    }
-                ],
+                "summary": "List Assessment Plans",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Task"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_AssessmentPlan"
                         }
                     },
                     "400": {
@@ -2192,12 +2577,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2206,51 +2585,55 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Deletes a task from an Assessment Plan.",
+                "description": "Creates a new OSCAL Assessment Plan with comprehensive validation.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "Assessment Plans"
                 ],
-                "summary": "Delete Assessment Plan Task",
+                "summary": "Create an Assessment Plan",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Assessment Plan ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Task ID",
-                        "name": "taskId",
-                        "in": "path",
-                        "required": true
+                        "description": "Assessment Plan object with required fields: UUID, metadata (title, version), import-ssp",
+                        "name": "plan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.AssessmentPlan"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Successfully created assessment plan",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentPlan"
+                        }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Bad request - validation errors or malformed input",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized - invalid or missing JWT token",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -2258,21 +2641,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-plans/{id}/tasks/{taskId}/associated-activities": {
+        "/oscal/assessment-plans/{id}": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all Activities associated with a specific Task in an Assessment Plan.",
+                "description": "Retrieves a single Assessment Plan by its unique ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Plans"
                 ],
-                "summary": "List Associated Activities for a Task",
+                "summary": "Get an Assessment Plan",
                 "parameters": [
                     {
                         "type": "string",
@@ -2280,20 +2663,13 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Task ID",
-                        "name": "taskId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_AssociatedActivity"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentPlan"
                         }
                     },
                     "400": {
@@ -2307,31 +2683,26 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
                     }
                 }
-            }
-        },
-        "/oscal/assessment-plans/{id}/tasks/{taskId}/associated-activities/{activityId}": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Associates an existing Activity to a Task within an Assessment Plan.",
+                "description": "Updates an existing Assessment Plan.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Plans"
                 ],
-                "summary": "Associate an Activity with a Task",
+                "summary": "Update an Assessment Plan",
                 "parameters": [
                     {
                         "type": "string",
@@ -2341,23 +2712,21 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Task ID",
-                        "name": "taskId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Activity ID",
-                        "name": "activityId",
-                        "in": "path",
-                        "required": true
+                        "description": "Assessment Plan object",
+                        "name": "plan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.AssessmentPlan"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "No Content"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentPlan"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2385,11 +2754,11 @@ const docTemplate = `{
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Removes an association of an Activity from a Task within an Assessment Plan.",
+                "description": "Deletes an Assessment Plan by its unique ID.",
                 "tags": [
                     "Assessment Plans"
                 ],
-                "summary": "Disassociate an Activity from a Task",
+                "summary": "Delete an Assessment Plan",
                 "parameters": [
                     {
                         "type": "string",
@@ -2397,20 +2766,6 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Task ID",
-                        "name": "taskId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Activity ID",
-                        "name": "activityId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -2438,21 +2793,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-plans/{id}/terms-and-conditions": {
+        "/oscal/assessment-plans/{id}/assessment-assets": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves terms and conditions for an Assessment Plan.",
+                "description": "Retrieves all assessment assets for an Assessment Plan.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Plans"
                 ],
-                "summary": "Get Assessment Plan Terms and Conditions",
+                "summary": "Get Assessment Plan Assets",
                 "parameters": [
                     {
                         "type": "string",
@@ -2466,7 +2821,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentPlanTermsAndConditions"
+                            "$ref": "#/definitions/handler.GenericDataResponse-array_oscalTypes_1_1_3_AssessmentAssets"
                         }
                     },
                     "400": {
@@ -2488,43 +2843,6 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/assessment-results": {
-            "get": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Retrieves all Assessment Results.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Assessment Results"
-                ],
-                "summary": "List Assessment Results",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_AssessmentResults"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    }
-                }
             },
             "post": {
                 "security": [
@@ -2532,7 +2850,7 @@ const docTemplate = `{
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates an Assessment Results from input.",
+                "description": "Creates a new assessment asset for an Assessment Plan.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2540,17 +2858,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Results"
+                    "Assessment Plans"
                 ],
-                "summary": "Create an Assessment Results",
+                "summary": "Create Assessment Plan Asset",
                 "parameters": [
                     {
-                        "description": "Assessment Results data",
-                        "name": "ar",
+                        "type": "string",
+                        "description": "Assessment Plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Assessment Asset object",
+                        "name": "asset",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.AssessmentResults"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.AssessmentAssets"
                         }
                     }
                 ],
@@ -2558,53 +2883,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentResults"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    }
-                }
-            }
-        },
-        "/oscal/assessment-results/{id}": {
-            "get": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Retrieves a single Assessment Results by its unique ID.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Assessment Results"
-                ],
-                "summary": "Get an Assessment Results",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Assessment Results ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentResults"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentAssets"
                         }
                     },
                     "400": {
@@ -2626,14 +2905,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/oscal/assessment-plans/{id}/assessment-assets/{assetId}": {
             "put": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates an existing Assessment Results.",
+                "description": "Updates an existing assessment asset for an Assessment Plan.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2641,24 +2922,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Results"
+                    "Assessment Plans"
                 ],
-                "summary": "Update an Assessment Results",
+                "summary": "Update Assessment Plan Asset",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Results ID",
+                        "description": "Assessment Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated Assessment Results object",
-                        "name": "ar",
+                        "type": "string",
+                        "description": "Assessment Asset ID",
+                        "name": "assetId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Assessment Asset object",
+                        "name": "asset",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.AssessmentResults"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.AssessmentAssets"
                         }
                     }
                 ],
@@ -2666,7 +2954,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentResults"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentAssets"
                         }
                     },
                     "400": {
@@ -2695,18 +2983,25 @@ const docTemplate = `{
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Deletes an Assessment Results by its ID.",
+                "description": "Deletes an assessment asset from an Assessment Plan.",
                 "tags": [
-                    "Assessment Results"
+                    "Assessment Plans"
                 ],
-                "summary": "Delete an Assessment Results",
+                "summary": "Delete Assessment Plan Asset",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Results ID",
+                        "description": "Assessment Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Assessment Asset ID",
+                        "name": "assetId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -2734,25 +3029,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-results/{id}/available-controls": {
+        "/oscal/assessment-plans/{id}/assessment-subjects": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves controls that can be referenced in findings",
+                "description": "Retrieves all assessment subjects for an Assessment Plan.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Results"
+                    "Assessment Plans"
                 ],
-                "summary": "Get available controls for findings",
+                "summary": "Get Assessment Plan Subjects",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Results ID",
+                        "description": "Assessment Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2762,7 +3057,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-array_oscalTypes_1_1_3_Control"
+                            "$ref": "#/definitions/handler.GenericDataResponse-array_oscalTypes_1_1_3_AssessmentSubject"
                         }
                     },
                     "400": {
@@ -2784,37 +3079,47 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/assessment-results/{id}/back-matter": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves the back matter for an Assessment Results.",
+                "description": "Creates a new assessment subject for an Assessment Plan.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Results"
+                    "Assessment Plans"
                 ],
-                "summary": "Get back matter",
+                "summary": "Create Assessment Plan Subject",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Results ID",
+                        "description": "Assessment Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Assessment Subject object",
+                        "name": "subject",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.AssessmentSubject"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentSubject"
                         }
                     },
                     "400": {
@@ -2836,14 +3141,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/oscal/assessment-plans/{id}/assessment-subjects/{subjectId}": {
             "put": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates the back matter for an Assessment Results.",
+                "description": "Updates an existing assessment subject for an Assessment Plan.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2851,24 +3158,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Results"
+                    "Assessment Plans"
                 ],
-                "summary": "Update back matter",
+                "summary": "Update Assessment Plan Subject",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Results ID",
+                        "description": "Assessment Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Back Matter",
-                        "name": "backMatter",
+                        "type": "string",
+                        "description": "Assessment Subject ID",
+                        "name": "subjectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Assessment Subject object",
+                        "name": "subject",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.BackMatter"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.AssessmentSubject"
                         }
                     }
                 ],
@@ -2876,7 +3190,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentSubject"
                         }
                     },
                     "400": {
@@ -2899,47 +3213,36 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "delete": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates or replaces the back matter for an Assessment Results.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Deletes an assessment subject from an Assessment Plan.",
                 "tags": [
-                    "Assessment Results"
+                    "Assessment Plans"
                 ],
-                "summary": "Create back matter",
+                "summary": "Delete Assessment Plan Subject",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Results ID",
+                        "description": "Assessment Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Back Matter",
-                        "name": "backMatter",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.BackMatter"
-                        }
+                        "type": "string",
+                        "description": "Assessment Subject ID",
+                        "name": "subjectId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2960,30 +3263,38 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/oscal/assessment-plans/{id}/back-matter": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Deletes the back matter for an Assessment Results.",
+                "description": "Retrieves back matter for an Assessment Plan.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Assessment Results"
+                    "Assessment Plans"
                 ],
-                "summary": "Delete back matter",
+                "summary": "Get Assessment Plan Back Matter",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Results ID",
+                        "description": "Assessment Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -3006,25 +3317,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-results/{id}/back-matter/resources": {
+        "/oscal/assessment-plans/{id}/full": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all resources from the back matter for an Assessment Results.",
+                "description": "Retrieves a single Assessment Plan by its unique ID with all related data preloaded.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Results"
+                    "Assessment Plans"
                 ],
-                "summary": "Get back matter resources",
+                "summary": "Get a full Assessment Plan",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Results ID",
+                        "description": "Assessment Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3034,7 +3345,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Resource"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentPlan"
                         }
                     },
                     "400": {
@@ -3056,47 +3367,37 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/oscal/assessment-plans/{id}/import-ssp": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates a new resource in the back matter for an Assessment Results.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves import SSP information for an Assessment Plan.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Results"
+                    "Assessment Plans"
                 ],
-                "summary": "Create back matter resource",
+                "summary": "Get Assessment Plan Import SSP",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Results ID",
+                        "description": "Assessment Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Resource",
-                        "name": "resource",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Resource"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Resource"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ImportSsp"
                         }
                     },
                     "400": {
@@ -3120,54 +3421,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-results/{id}/back-matter/resources/{resourceId}": {
-            "put": {
+        "/oscal/assessment-plans/{id}/local-definitions": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates a specific resource in the back matter for an Assessment Results.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves local definitions for an Assessment Plan.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Results"
+                    "Assessment Plans"
                 ],
-                "summary": "Update back matter resource",
+                "summary": "Get Assessment Plan Local Definitions",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Results ID",
+                        "description": "Assessment Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Resource ID",
-                        "name": "resourceId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Resource",
-                        "name": "resource",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Resource"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Resource"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_LocalDefinitions"
                         }
                     },
                     "400": {
@@ -3189,37 +3471,38 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/oscal/assessment-plans/{id}/metadata": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Deletes a specific resource from the back matter for an Assessment Results.",
+                "description": "Retrieves metadata for an Assessment Plan.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Assessment Results"
+                    "Assessment Plans"
                 ],
-                "summary": "Delete back matter resource",
+                "summary": "Get Assessment Plan Metadata",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Results ID",
+                        "description": "Assessment Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Resource ID",
-                        "name": "resourceId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Metadata"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -3242,42 +3525,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-results/{id}/control/{controlId}": {
+        "/oscal/assessment-plans/{id}/tasks": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves a control with all its parts for reference in findings",
+                "description": "Retrieves all tasks for an Assessment Plan.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Results"
+                    "Assessment Plans"
                 ],
-                "summary": "Get control details with statements and objectives",
+                "summary": "Get Assessment Plan Tasks",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Results ID",
+                        "description": "Assessment Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Control ID",
-                        "name": "controlId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Control"
+                            "$ref": "#/definitions/handler.GenericDataResponse-array_oscalTypes_1_1_3_Task"
                         }
                     },
                     "400": {
@@ -3299,37 +3575,47 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/assessment-results/{id}/findings": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all findings in the system that can be associated with results.",
+                "description": "Creates a new task for an Assessment Plan.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Results"
+                    "Assessment Plans"
                 ],
-                "summary": "List all findings available for association",
+                "summary": "Create Assessment Plan Task",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Results ID",
+                        "description": "Assessment Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Task object",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Task"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Finding"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Task"
                         }
                     },
                     "400": {
@@ -3353,35 +3639,54 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-results/{id}/full": {
-            "get": {
+        "/oscal/assessment-plans/{id}/tasks/{taskId}": {
+            "put": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves a complete Assessment Results by its ID, including all metadata and related objects.",
+                "description": "Updates an existing task for an Assessment Plan.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Results"
+                    "Assessment Plans"
                 ],
-                "summary": "Get a complete Assessment Results",
+                "summary": "Update Assessment Plan Task",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Results ID",
+                        "description": "Assessment Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Task object",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Task"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentResults"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Task"
                         }
                     },
                     "400": {
@@ -3403,38 +3708,37 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/assessment-results/{id}/import-ap": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves import-ap for a given Assessment Results.",
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Deletes a task from an Assessment Plan.",
                 "tags": [
-                    "Assessment Results"
+                    "Assessment Plans"
                 ],
-                "summary": "Get Assessment Results import-ap",
+                "summary": "Delete Assessment Plan Task",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Results ID",
+                        "description": "Assessment Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ImportAp"
-                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -3455,47 +3759,44 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/oscal/assessment-plans/{id}/tasks/{taskId}/associated-activities": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates import-ap for a given Assessment Results.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves all Activities associated with a specific Task in an Assessment Plan.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Results"
+                    "Assessment Plans"
                 ],
-                "summary": "Update Assessment Results import-ap",
+                "summary": "List Associated Activities for a Task",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Results ID",
+                        "description": "Assessment Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Import AP data",
-                        "name": "importAp",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.ImportAp"
-                        }
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ImportAp"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_AssociatedActivity"
                         }
                     },
                     "400": {
@@ -3519,36 +3820,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-results/{id}/local-definitions": {
-            "get": {
+        "/oscal/assessment-plans/{id}/tasks/{taskId}/associated-activities/{activityId}": {
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves local-definitions for a given Assessment Results.",
+                "description": "Associates an existing Activity to a Task within an Assessment Plan.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Results"
+                    "Assessment Plans"
                 ],
-                "summary": "Get Assessment Results local-definitions",
+                "summary": "Associate an Activity with a Task",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Results ID",
+                        "description": "Assessment Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Activity ID",
+                        "name": "activityId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_LocalDefinitions"
-                        }
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -3570,47 +3882,43 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "delete": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates local-definitions for a given Assessment Results.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Removes an association of an Activity from a Task within an Assessment Plan.",
                 "tags": [
-                    "Assessment Results"
+                    "Assessment Plans"
                 ],
-                "summary": "Update Assessment Results local-definitions",
+                "summary": "Disassociate an Activity from a Task",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Results ID",
+                        "description": "Assessment Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Local definitions data",
-                        "name": "localDefinitions",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.LocalDefinitions"
-                        }
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Activity ID",
+                        "name": "activityId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_LocalDefinitions"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -3633,25 +3941,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-results/{id}/metadata": {
+        "/oscal/assessment-plans/{id}/terms-and-conditions": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves metadata for a given Assessment Results.",
+                "description": "Retrieves terms and conditions for an Assessment Plan.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Assessment Results"
+                    "Assessment Plans"
                 ],
-                "summary": "Get Assessment Results metadata",
+                "summary": "Get Assessment Plan Terms and Conditions",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Assessment Results ID",
+                        "description": "Assessment Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3661,7 +3969,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Metadata"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentPlanTermsAndConditions"
                         }
                     },
                     "400": {
@@ -3683,47 +3991,28 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/oscal/assessment-results": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates metadata for a given Assessment Results.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves all Assessment Results.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Update Assessment Results metadata",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Assessment Results ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Metadata data",
-                        "name": "metadata",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Metadata"
-                        }
-                    }
-                ],
+                "summary": "List Assessment Results",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Metadata"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_AssessmentResults"
                         }
                     },
                     "400": {
@@ -3732,12 +4021,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3745,37 +4028,40 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/assessment-results/{id}/observations": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all observations in the system that can be associated with results.",
+                "description": "Creates an Assessment Results from input.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "List all observations available for association",
+                "summary": "Create an Assessment Results",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Assessment Results ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Assessment Results data",
+                        "name": "ar",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.AssessmentResults"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Observation"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentResults"
                         }
                     },
                     "400": {
@@ -3784,12 +4070,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3799,21 +4079,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-results/{id}/results": {
+        "/oscal/assessment-results/{id}": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all results for a given Assessment Results.",
+                "description": "Retrieves a single Assessment Results by its unique ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Get results for an Assessment Results",
+                "summary": "Get an Assessment Results",
                 "parameters": [
                     {
                         "type": "string",
@@ -3827,7 +4107,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Result"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentResults"
                         }
                     },
                     "400": {
@@ -3850,13 +4130,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates a new result for a given Assessment Results.",
+                "description": "Updates an existing Assessment Results.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3866,7 +4146,7 @@ const docTemplate = `{
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Create a result for an Assessment Results",
+                "summary": "Update an Assessment Results",
                 "parameters": [
                     {
                         "type": "string",
@@ -3876,20 +4156,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Result data",
-                        "name": "result",
+                        "description": "Updated Assessment Results object",
+                        "name": "ar",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Result"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.AssessmentResults"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Result"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentResults"
                         }
                     },
                     "400": {
@@ -3911,23 +4191,18 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/assessment-results/{id}/results/{resultId}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves a specific result from an Assessment Results.",
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Deletes an Assessment Results by its ID.",
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Get a specific result",
+                "summary": "Delete an Assessment Results",
                 "parameters": [
                     {
                         "type": "string",
@@ -3935,21 +4210,11 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Result"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -3970,24 +4235,23 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/oscal/assessment-results/{id}/available-controls": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates a specific result in an Assessment Results.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves controls that can be referenced in findings",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Update a result",
+                "summary": "Get available controls for findings",
                 "parameters": [
                     {
                         "type": "string",
@@ -3995,29 +4259,13 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Result data",
-                        "name": "result",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Result"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Result"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-array_oscalTypes_1_1_3_Control"
                         }
                     },
                     "400": {
@@ -4039,18 +4287,23 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/oscal/assessment-results/{id}/back-matter": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Deletes a specific result from an Assessment Results.",
+                "description": "Retrieves the back matter for an Assessment Results.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Delete a result",
+                "summary": "Get back matter",
                 "parameters": [
                     {
                         "type": "string",
@@ -4058,18 +4311,14 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -4090,23 +4339,24 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/assessment-results/{id}/results/{resultId}/associated-findings": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all Findings associated with a specific Result in an Assessment Results.",
+                "description": "Updates the back matter for an Assessment Results.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "List Associated Findings for a Result",
+                "summary": "Update back matter",
                 "parameters": [
                     {
                         "type": "string",
@@ -4116,18 +4366,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
-                        "in": "path",
-                        "required": true
+                        "description": "Back Matter",
+                        "name": "backMatter",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.BackMatter"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Finding"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
                         }
                     },
                     "400": {
@@ -4149,23 +4401,24 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/assessment-results/{id}/results/{resultId}/associated-findings/{findingId}": {
+            },
             "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Associates an existing Finding to a Result within an Assessment Results.",
+                "description": "Creates or replaces the back matter for an Assessment Results.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Associate a Finding with a Result",
+                "summary": "Create back matter",
                 "parameters": [
                     {
                         "type": "string",
@@ -4175,23 +4428,21 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Finding ID",
-                        "name": "findingId",
-                        "in": "path",
-                        "required": true
+                        "description": "Back Matter",
+                        "name": "backMatter",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.BackMatter"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -4219,11 +4470,11 @@ const docTemplate = `{
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Removes an association of a Finding from a Result within an Assessment Results.",
+                "description": "Deletes the back matter for an Assessment Results.",
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Disassociate a Finding from a Result",
+                "summary": "Delete back matter",
                 "parameters": [
                     {
                         "type": "string",
@@ -4231,20 +4482,6 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Finding ID",
-                        "name": "findingId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -4272,21 +4509,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-results/{id}/results/{resultId}/associated-observations": {
+        "/oscal/assessment-results/{id}/back-matter/resources": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all Observations associated with a specific Result in an Assessment Results.",
+                "description": "Retrieves all resources from the back matter for an Assessment Results.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "List Associated Observations for a Result",
+                "summary": "Get back matter resources",
                 "parameters": [
                     {
                         "type": "string",
@@ -4294,20 +4531,13 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Observation"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Resource"
                         }
                     },
                     "400": {
@@ -4329,23 +4559,24 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/assessment-results/{id}/results/{resultId}/associated-observations/{observationId}": {
+            },
             "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Associates an existing Observation to a Result within an Assessment Results.",
+                "description": "Creates a new resource in the back matter for an Assessment Results.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Associate an Observation with a Result",
+                "summary": "Create back matter resource",
                 "parameters": [
                     {
                         "type": "string",
@@ -4355,23 +4586,21 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Observation ID",
-                        "name": "observationId",
-                        "in": "path",
-                        "required": true
+                        "description": "Resource",
+                        "name": "resource",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Resource"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Resource"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -4392,18 +4621,26 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/oscal/assessment-results/{id}/back-matter/resources/{resourceId}": {
+            "put": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Removes an association of an Observation from a Result within an Assessment Results.",
+                "description": "Updates a specific resource in the back matter for an Assessment Results.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Disassociate an Observation from a Result",
+                "summary": "Update back matter resource",
                 "parameters": [
                     {
                         "type": "string",
@@ -4414,22 +4651,27 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
+                        "description": "Resource ID",
+                        "name": "resourceId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Observation ID",
-                        "name": "observationId",
-                        "in": "path",
-                        "required": true
+                        "description": "Resource",
+                        "name": "resource",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Resource"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Resource"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -4450,23 +4692,18 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/assessment-results/{id}/results/{resultId}/associated-risks": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all Risks associated with a specific Result in an Assessment Results.",
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Deletes a specific resource from the back matter for an Assessment Results.",
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "List Associated Risks for a Result",
+                "summary": "Delete back matter resource",
                 "parameters": [
                     {
                         "type": "string",
@@ -4477,18 +4714,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
+                        "description": "Resource ID",
+                        "name": "resourceId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Risk"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -4511,21 +4745,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-results/{id}/results/{resultId}/associated-risks/{riskId}": {
-            "post": {
+        "/oscal/assessment-results/{id}/control/{controlId}": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Associates an existing Risk to a Result within an Assessment Results.",
+                "description": "Retrieves a control with all its parts for reference in findings",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Associate a Risk with a Result",
+                "summary": "Get control details with statements and objectives",
                 "parameters": [
                     {
                         "type": "string",
@@ -4536,22 +4770,18 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Risk ID",
-                        "name": "riskId",
+                        "description": "Control ID",
+                        "name": "controlId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "No Content"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Control"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -4572,18 +4802,23 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/oscal/assessment-results/{id}/findings": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Removes an association of a Risk from a Result within an Assessment Results.",
+                "description": "Retrieves all findings in the system that can be associated with results.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Disassociate a Risk from a Result",
+                "summary": "List all findings available for association",
                 "parameters": [
                     {
                         "type": "string",
@@ -4591,25 +4826,14 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Risk ID",
-                        "name": "riskId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Finding"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -4632,21 +4856,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-results/{id}/results/{resultId}/attestations": {
+        "/oscal/assessment-results/{id}/full": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all attestations for a given result.",
+                "description": "Retrieves a complete Assessment Results by its ID, including all metadata and related objects.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Get attestations for a result",
+                "summary": "Get a complete Assessment Results",
                 "parameters": [
                     {
                         "type": "string",
@@ -4654,20 +4878,13 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_AttestationStatements"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AssessmentResults"
                         }
                     },
                     "400": {
@@ -4689,24 +4906,23 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/oscal/assessment-results/{id}/import-ap": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates a new attestation for a given result.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves import-ap for a given Assessment Results.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Create an attestation for a result",
+                "summary": "Get Assessment Results import-ap",
                 "parameters": [
                     {
                         "type": "string",
@@ -4714,29 +4930,13 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Attestation data",
-                        "name": "attestation",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.AttestationStatements"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AttestationStatements"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ImportAp"
                         }
                     },
                     "400": {
@@ -4758,16 +4958,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/assessment-results/{id}/results/{resultId}/attestations/{attestationId}": {
+            },
             "put": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates a specific attestation in a result.",
+                "description": "Updates import-ap for a given Assessment Results.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4777,7 +4975,7 @@ const docTemplate = `{
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Update an attestation",
+                "summary": "Update Assessment Results import-ap",
                 "parameters": [
                     {
                         "type": "string",
@@ -4787,26 +4985,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Attestation ID",
-                        "name": "attestationId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Attestation data",
-                        "name": "attestation",
+                        "description": "Import AP data",
+                        "name": "importAp",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.AttestationStatements"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.ImportAp"
                         }
                     }
                 ],
@@ -4814,7 +4998,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AttestationStatements"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ImportAp"
                         }
                     },
                     "400": {
@@ -4836,18 +5020,23 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/oscal/assessment-results/{id}/local-definitions": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Deletes a specific attestation from a result.",
+                "description": "Retrieves local-definitions for a given Assessment Results.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Delete an attestation",
+                "summary": "Get Assessment Results local-definitions",
                 "parameters": [
                     {
                         "type": "string",
@@ -4855,25 +5044,14 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Attestation ID",
-                        "name": "attestationId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_LocalDefinitions"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -4894,23 +5072,24 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/assessment-results/{id}/results/{resultId}/findings": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all findings for a given result.",
+                "description": "Updates local-definitions for a given Assessment Results.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Get findings for a result",
+                "summary": "Update Assessment Results local-definitions",
                 "parameters": [
                     {
                         "type": "string",
@@ -4920,18 +5099,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
-                        "in": "path",
-                        "required": true
+                        "description": "Local definitions data",
+                        "name": "localDefinitions",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.LocalDefinitions"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Finding"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_LocalDefinitions"
                         }
                     },
                     "400": {
@@ -4953,24 +5134,23 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/oscal/assessment-results/{id}/metadata": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates a new finding for a given result.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves metadata for a given Assessment Results.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Create a finding for a result",
+                "summary": "Get Assessment Results metadata",
                 "parameters": [
                     {
                         "type": "string",
@@ -4978,29 +5158,13 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Finding data",
-                        "name": "finding",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Finding"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Finding"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Metadata"
                         }
                     },
                     "400": {
@@ -5022,16 +5186,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/assessment-results/{id}/results/{resultId}/findings/{findingId}": {
+            },
             "put": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates a specific finding in a result.",
+                "description": "Updates metadata for a given Assessment Results.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5041,7 +5203,7 @@ const docTemplate = `{
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Update a finding",
+                "summary": "Update Assessment Results metadata",
                 "parameters": [
                     {
                         "type": "string",
@@ -5051,26 +5213,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Finding ID",
-                        "name": "findingId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Finding data",
-                        "name": "finding",
+                        "description": "Metadata data",
+                        "name": "metadata",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Finding"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Metadata"
                         }
                     }
                 ],
@@ -5078,7 +5226,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Finding"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Metadata"
                         }
                     },
                     "400": {
@@ -5100,18 +5248,23 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/oscal/assessment-results/{id}/observations": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Deletes a specific finding from a result.",
+                "description": "Retrieves all observations in the system that can be associated with results.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Delete a finding",
+                "summary": "List all observations available for association",
                 "parameters": [
                     {
                         "type": "string",
@@ -5119,25 +5272,14 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Finding ID",
-                        "name": "findingId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Observation"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5160,21 +5302,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-results/{id}/results/{resultId}/observations": {
+        "/oscal/assessment-results/{id}/results": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all observations for a given result.",
+                "description": "Retrieves all results for a given Assessment Results.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Get observations for a result",
+                "summary": "Get results for an Assessment Results",
                 "parameters": [
                     {
                         "type": "string",
@@ -5182,20 +5324,13 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Observation"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Result"
                         }
                     },
                     "400": {
@@ -5224,7 +5359,7 @@ const docTemplate = `{
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates a new observation for a given result.",
+                "description": "Creates a new result for a given Assessment Results.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5234,7 +5369,7 @@ const docTemplate = `{
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Create an observation for a result",
+                "summary": "Create a result for an Assessment Results",
                 "parameters": [
                     {
                         "type": "string",
@@ -5244,19 +5379,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Result ID",
-                        "name": "resultId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Observation data",
-                        "name": "observation",
+                        "description": "Result data",
+                        "name": "result",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Observation"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Result"
                         }
                     }
                 ],
@@ -5264,7 +5392,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Observation"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Result"
                         }
                     },
                     "400": {
@@ -5288,24 +5416,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-results/{id}/results/{resultId}/observations/{obsId}": {
-            "put": {
+        "/oscal/assessment-results/{id}/results/{resultId}": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates a specific observation in a result.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves a specific result from an Assessment Results.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Update an observation",
+                "summary": "Get a specific result",
                 "parameters": [
                     {
                         "type": "string",
@@ -5320,29 +5445,13 @@ const docTemplate = `{
                         "name": "resultId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Observation ID",
-                        "name": "obsId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Observation data",
-                        "name": "observation",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Observation"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Observation"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Result"
                         }
                     },
                     "400": {
@@ -5365,17 +5474,23 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "put": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Deletes a specific observation from a result.",
+                "description": "Updates a specific result in an Assessment Results.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Delete an observation",
+                "summary": "Update a result",
                 "parameters": [
                     {
                         "type": "string",
@@ -5392,16 +5507,21 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Observation ID",
-                        "name": "obsId",
-                        "in": "path",
-                        "required": true
+                        "description": "Result data",
+                        "name": "result",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Result"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Result"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5422,23 +5542,18 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/assessment-results/{id}/results/{resultId}/risks": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all risks for a given result.",
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Deletes a specific result from an Assessment Results.",
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Get risks for a result",
+                "summary": "Delete a result",
                 "parameters": [
                     {
                         "type": "string",
@@ -5456,11 +5571,8 @@ const docTemplate = `{
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Risk"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5481,24 +5593,23 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/oscal/assessment-results/{id}/results/{resultId}/associated-findings": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates a new risk for a given result.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves all Findings associated with a specific Result in an Assessment Results.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Create a risk for a result",
+                "summary": "List Associated Findings for a Result",
                 "parameters": [
                     {
                         "type": "string",
@@ -5513,22 +5624,13 @@ const docTemplate = `{
                         "name": "resultId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Risk data",
-                        "name": "risk",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Risk"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Risk"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Finding"
                         }
                     },
                     "400": {
@@ -5552,24 +5654,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-results/{id}/results/{resultId}/risks/{riskId}": {
-            "put": {
+        "/oscal/assessment-results/{id}/results/{resultId}/associated-findings/{findingId}": {
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates a specific risk in a result.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Associates an existing Finding to a Result within an Assessment Results.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Update a risk",
+                "summary": "Associate a Finding with a Result",
                 "parameters": [
                     {
                         "type": "string",
@@ -5587,27 +5686,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Risk ID",
-                        "name": "riskId",
+                        "description": "Finding ID",
+                        "name": "findingId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Risk data",
-                        "name": "risk",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Risk"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Risk"
-                        }
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5635,11 +5722,11 @@ const docTemplate = `{
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Deletes a specific risk from a result.",
+                "description": "Removes an association of a Finding from a Result within an Assessment Results.",
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "Delete a risk",
+                "summary": "Disassociate a Finding from a Result",
                 "parameters": [
                     {
                         "type": "string",
@@ -5657,8 +5744,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Risk ID",
-                        "name": "riskId",
+                        "description": "Finding ID",
+                        "name": "findingId",
                         "in": "path",
                         "required": true
                     }
@@ -5688,21 +5775,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/assessment-results/{id}/risks": {
+        "/oscal/assessment-results/{id}/results/{resultId}/associated-observations": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all risks in the system that can be associated with results.",
+                "description": "Retrieves all Observations associated with a specific Result in an Assessment Results.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Assessment Results"
                 ],
-                "summary": "List all risks available for association",
+                "summary": "List Associated Observations for a Result",
                 "parameters": [
                     {
                         "type": "string",
@@ -5710,13 +5797,20 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Result ID",
+                        "name": "resultId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Risk"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Observation"
                         }
                     },
                     "400": {
@@ -5740,34 +5834,60 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/catalogs": {
-            "get": {
+        "/oscal/assessment-results/{id}/results/{resultId}/associated-observations/{observationId}": {
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all catalogs.",
+                "description": "Associates an existing Observation to a Result within an Assessment Results.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Catalog"
+                    "Assessment Results"
                 ],
-                "summary": "List catalogs",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscal_List_responseCatalog"
-                        }
-                    },
+                "summary": "Associate an Observation with a Result",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Assessment Results ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Result ID",
+                        "name": "resultId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Observation ID",
+                        "name": "observationId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "No Content"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5776,40 +5896,43 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "delete": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates a new OSCAL Catalog.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Removes an association of an Observation from a Result within an Assessment Results.",
                 "tags": [
-                    "Catalog"
+                    "Assessment Results"
                 ],
-                "summary": "Create a new Catalog",
+                "summary": "Disassociate an Observation from a Result",
                 "parameters": [
                     {
-                        "description": "Catalog object",
-                        "name": "catalog",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Catalog"
-                        }
+                        "type": "string",
+                        "description": "Assessment Results ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Result ID",
+                        "name": "resultId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Observation ID",
+                        "name": "observationId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Catalog"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5817,6 +5940,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5826,35 +5955,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/catalogs/{id}": {
+        "/oscal/assessment-results/{id}/results/{resultId}/associated-risks": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves a single Catalog by its unique ID.",
+                "description": "Retrieves all Risks associated with a specific Result in an Assessment Results.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Catalog"
+                    "Assessment Results"
                 ],
-                "summary": "Get a Catalog",
+                "summary": "List Associated Risks for a Result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Catalog ID",
+                        "description": "Assessment Results ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Result ID",
+                        "name": "resultId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscal_Get_responseCatalog"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Risk"
                         }
                     },
                     "400": {
@@ -5863,12 +5999,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -5882,48 +6012,49 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/oscal/assessment-results/{id}/results/{resultId}/associated-risks/{riskId}": {
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates an existing OSCAL Catalog.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Associates an existing Risk to a Result within an Assessment Results.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Catalog"
+                    "Assessment Results"
                 ],
-                "summary": "Update a Catalog",
+                "summary": "Associate a Risk with a Result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Catalog ID",
+                        "description": "Assessment Results ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated Catalog object",
-                        "name": "catalog",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Catalog"
-                        }
+                        "type": "string",
+                        "description": "Result ID",
+                        "name": "resultId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Risk ID",
+                        "name": "riskId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Catalog"
-                        }
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5944,38 +6075,44 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/catalogs/{id}/back-matter": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves the back-matter for a given Catalog.",
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Removes an association of a Risk from a Result within an Assessment Results.",
                 "tags": [
-                    "Catalog"
+                    "Assessment Results"
                 ],
-                "summary": "Get back-matter for a Catalog",
+                "summary": "Disassociate a Risk from a Result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Catalog ID",
+                        "description": "Assessment Results ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Result ID",
+                        "name": "resultId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Risk ID",
+                        "name": "riskId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -5983,12 +6120,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -6004,35 +6135,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/catalogs/{id}/controls": {
+        "/oscal/assessment-results/{id}/results/{resultId}/attestations": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves the top-level controls for a given Catalog.",
+                "description": "Retrieves all attestations for a given result.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Catalog"
+                    "Assessment Results"
                 ],
-                "summary": "List controls for a Catalog",
+                "summary": "Get attestations for a result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Catalog ID",
+                        "description": "Assessment Results ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Result ID",
+                        "name": "resultId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Control"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_AttestationStatements"
                         }
                     },
                     "400": {
@@ -6041,12 +6179,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -6067,7 +6199,7 @@ const docTemplate = `{
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Adds a top-level control under the specified Catalog.",
+                "description": "Creates a new attestation for a given result.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6075,24 +6207,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Catalog"
+                    "Assessment Results"
                 ],
-                "summary": "Create a new Control for a Catalog",
+                "summary": "Create an attestation for a result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Catalog ID",
+                        "description": "Assessment Results ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Control object",
-                        "name": "control",
+                        "type": "string",
+                        "description": "Result ID",
+                        "name": "resultId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Attestation data",
+                        "name": "attestation",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Control"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.AttestationStatements"
                         }
                     }
                 ],
@@ -6100,7 +6239,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Control"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AttestationStatements"
                         }
                     },
                     "400": {
@@ -6109,6 +6248,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -6118,42 +6263,61 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/catalogs/{id}/controls/{control}": {
-            "get": {
+        "/oscal/assessment-results/{id}/results/{resultId}/attestations/{attestationId}": {
+            "put": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves a single Control by its ID for a given Catalog.",
+                "description": "Updates a specific attestation in a result.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Catalog"
+                    "Assessment Results"
                 ],
-                "summary": "Get a specific Control within a Catalog",
+                "summary": "Update an attestation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Catalog ID",
+                        "description": "Assessment Results ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Control ID",
-                        "name": "control",
+                        "description": "Result ID",
+                        "name": "resultId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Attestation ID",
+                        "name": "attestationId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Attestation data",
+                        "name": "attestation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.AttestationStatements"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Control"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AttestationStatements"
                         }
                     },
                     "400": {
@@ -6176,54 +6340,43 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "delete": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates the properties of an existing Control under the specified Catalog.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Deletes a specific attestation from a result.",
                 "tags": [
-                    "Catalog"
+                    "Assessment Results"
                 ],
-                "summary": "Update a Control within a Catalog",
+                "summary": "Delete an attestation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Catalog ID",
+                        "description": "Assessment Results ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Control ID",
-                        "name": "control",
+                        "description": "Result ID",
+                        "name": "resultId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated Control object",
-                        "name": "control",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Control"
-                        }
+                        "type": "string",
+                        "description": "Attestation ID",
+                        "name": "attestationId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Control"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -6246,33 +6399,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/catalogs/{id}/controls/{control}/controls": {
+        "/oscal/assessment-results/{id}/results/{resultId}/findings": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves the controls directly under a specific Control in a given Catalog.",
+                "description": "Retrieves all findings for a given result.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Catalog"
+                    "Assessment Results"
                 ],
-                "summary": "List child controls for a Control within a Catalog",
+                "summary": "Get findings for a result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Catalog ID",
+                        "description": "Assessment Results ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Control ID",
-                        "name": "control",
+                        "description": "Result ID",
+                        "name": "resultId",
                         "in": "path",
                         "required": true
                     }
@@ -6281,7 +6434,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Control"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Finding"
                         }
                     },
                     "400": {
@@ -6310,7 +6463,7 @@ const docTemplate = `{
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Adds a child control under the specified Catalog Control.",
+                "description": "Creates a new finding for a given result.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6318,31 +6471,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Catalog"
+                    "Assessment Results"
                 ],
-                "summary": "Create a new Sub-Control for a Control within a Catalog",
+                "summary": "Create a finding for a result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Catalog ID",
+                        "description": "Assessment Results ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Parent Control ID",
-                        "name": "control",
+                        "description": "Result ID",
+                        "name": "resultId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Control object",
-                        "name": "control",
+                        "description": "Finding data",
+                        "name": "finding",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Control"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Finding"
                         }
                     }
                 ],
@@ -6350,7 +6503,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Control"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Finding"
                         }
                     },
                     "400": {
@@ -6359,6 +6512,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -6368,35 +6527,61 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/catalogs/{id}/groups": {
-            "get": {
+        "/oscal/assessment-results/{id}/results/{resultId}/findings/{findingId}": {
+            "put": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves the top-level groups for a given Catalog.",
+                "description": "Updates a specific finding in a result.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Catalog"
+                    "Assessment Results"
                 ],
-                "summary": "List groups for a Catalog",
+                "summary": "Update a finding",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Catalog ID",
+                        "description": "Assessment Results ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Result ID",
+                        "name": "resultId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Finding ID",
+                        "name": "findingId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Finding data",
+                        "name": "finding",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Finding"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Group"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Finding"
                         }
                     },
                     "400": {
@@ -6405,12 +6590,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -6425,47 +6604,43 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "delete": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Adds a top-level group under the specified Catalog.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Deletes a specific finding from a result.",
                 "tags": [
-                    "Catalog"
+                    "Assessment Results"
                 ],
-                "summary": "Create a new Group for a Catalog",
+                "summary": "Delete a finding",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Catalog ID",
+                        "description": "Assessment Results ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Group object",
-                        "name": "group",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Group"
-                        }
+                        "type": "string",
+                        "description": "Result ID",
+                        "name": "resultId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Finding ID",
+                        "name": "findingId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Group"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -6473,6 +6648,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -6482,33 +6663,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/catalogs/{id}/groups/{group}": {
+        "/oscal/assessment-results/{id}/results/{resultId}/observations": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves a single Group by its ID for a given Catalog.",
+                "description": "Retrieves all observations for a given result.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Catalog"
+                    "Assessment Results"
                 ],
-                "summary": "Get a specific Group within a Catalog",
+                "summary": "Get observations for a result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Catalog ID",
+                        "description": "Assessment Results ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "group",
+                        "description": "Result ID",
+                        "name": "resultId",
                         "in": "path",
                         "required": true
                     }
@@ -6517,7 +6698,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Group"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Observation"
                         }
                     },
                     "400": {
@@ -6540,13 +6721,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates the properties of an existing Group under the specified Catalog.",
+                "description": "Creates a new observation for a given result.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6554,39 +6735,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Catalog"
+                    "Assessment Results"
                 ],
-                "summary": "Update a Group within a Catalog",
+                "summary": "Create an observation for a result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Catalog ID",
+                        "description": "Assessment Results ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "group",
+                        "description": "Result ID",
+                        "name": "resultId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated Group object",
-                        "name": "group",
+                        "description": "Observation data",
+                        "name": "observation",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Group"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Observation"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Group"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Observation"
                         }
                     },
                     "400": {
@@ -6610,42 +6791,61 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/catalogs/{id}/groups/{group}/controls": {
-            "get": {
+        "/oscal/assessment-results/{id}/results/{resultId}/observations/{obsId}": {
+            "put": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves the controls directly under a specific Group in a given Catalog.",
-                "produces": [
-                    "application/json"
+                "description": "Updates a specific observation in a result.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
                 ],
                 "tags": [
-                    "Catalog"
+                    "Assessment Results"
                 ],
-                "summary": "List controls for a Group within a Catalog",
+                "summary": "Update an observation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Catalog ID",
+                        "description": "Assessment Results ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "group",
+                        "description": "Result ID",
+                        "name": "resultId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Observation ID",
+                        "name": "obsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Observation data",
+                        "name": "observation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Observation"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Control"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Observation"
                         }
                     },
                     "400": {
@@ -6668,54 +6868,43 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "delete": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Adds a control under the specified Catalog and Group.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Deletes a specific observation from a result.",
                 "tags": [
-                    "Catalog"
+                    "Assessment Results"
                 ],
-                "summary": "Create a new Control for a Catalog Group",
+                "summary": "Delete an observation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Catalog ID",
+                        "description": "Assessment Results ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Parent Group ID",
-                        "name": "group",
+                        "description": "Result ID",
+                        "name": "resultId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Control object",
-                        "name": "control",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Control"
-                        }
+                        "type": "string",
+                        "description": "Observation ID",
+                        "name": "obsId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Control"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -6723,6 +6912,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -6732,33 +6927,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/catalogs/{id}/groups/{group}/groups": {
+        "/oscal/assessment-results/{id}/results/{resultId}/risks": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves the sub-groups of a specific Group in a given Catalog.",
+                "description": "Retrieves all risks for a given result.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Catalog"
+                    "Assessment Results"
                 ],
-                "summary": "List sub-groups for a Group within a Catalog",
+                "summary": "Get risks for a result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Catalog ID",
+                        "description": "Assessment Results ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "group",
+                        "description": "Result ID",
+                        "name": "resultId",
                         "in": "path",
                         "required": true
                     }
@@ -6767,7 +6962,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Group"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Risk"
                         }
                     },
                     "400": {
@@ -6796,7 +6991,7 @@ const docTemplate = `{
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Adds a sub-group under the specified Catalog and Group.",
+                "description": "Creates a new risk for a given result.",
                 "consumes": [
                     "application/json"
                 ],
@@ -6804,31 +6999,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Catalog"
+                    "Assessment Results"
                 ],
-                "summary": "Create a new Sub-Group for a Catalog Group",
+                "summary": "Create a risk for a result",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Catalog ID",
+                        "description": "Assessment Results ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Parent Group ID",
-                        "name": "group",
+                        "description": "Result ID",
+                        "name": "resultId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Group object",
-                        "name": "group",
+                        "description": "Risk data",
+                        "name": "risk",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Group"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Risk"
                         }
                     }
                 ],
@@ -6836,7 +7031,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Group"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Risk"
                         }
                     },
                     "400": {
@@ -6845,6 +7040,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -6854,26 +7055,61 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/component-definitions": {
-            "get": {
+        "/oscal/assessment-results/{id}/results/{resultId}/risks/{riskId}": {
+            "put": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all component definitions.",
+                "description": "Updates a specific risk in a result.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Component Definitions"
+                    "Assessment Results"
+                ],
+                "summary": "Update a risk",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Assessment Results ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Result ID",
+                        "name": "resultId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Risk ID",
+                        "name": "riskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Risk data",
+                        "name": "risk",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Risk"
+                        }
+                    }
                 ],
-                "summary": "List component definitions",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscal_List_responseComponentDefinition"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Risk"
                         }
                     },
                     "400": {
@@ -6882,8 +7118,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -6896,40 +7132,43 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "delete": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates a new component definition.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Deletes a specific risk from a result.",
                 "tags": [
-                    "Component Definitions"
+                    "Assessment Results"
                 ],
-                "summary": "Create a component definition",
+                "summary": "Delete a risk",
                 "parameters": [
                     {
-                        "description": "Component Definition",
-                        "name": "componentDefinition",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.ComponentDefinition"
-                        }
+                        "type": "string",
+                        "description": "Assessment Results ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Result ID",
+                        "name": "resultId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Risk ID",
+                        "name": "riskId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ComponentDefinition"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -6937,8 +7176,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -6952,25 +7191,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/component-definitions/{id}": {
+        "/oscal/assessment-results/{id}/risks": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves a single component definition by its unique ID.",
+                "description": "Retrieves all risks in the system that can be associated with results.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Component Definitions"
+                    "Assessment Results"
                 ],
-                "summary": "Get a component definition",
+                "summary": "List all risks available for association",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Component Definition ID",
+                        "description": "Assessment Results ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -6980,7 +7219,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscal_Get_responseComponentDefinition"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Risk"
                         }
                     },
                     "400": {
@@ -6989,12 +7228,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -7008,47 +7241,28 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/oscal/catalogs": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates an existing component definition.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves all catalogs.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Component Definitions"
-                ],
-                "summary": "Update a component definition",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Component Definition ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Updated Component Definition object",
-                        "name": "componentDefinition",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.ComponentDefinition"
-                        }
-                    }
+                    "Catalog"
                 ],
+                "summary": "List catalogs",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ComponentDefinition"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscal_List_responseCatalog"
                         }
                     },
                     "400": {
@@ -7057,14 +7271,51 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Creates a new OSCAL Catalog.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Catalog"
+                ],
+                "summary": "Create a new Catalog",
+                "parameters": [
+                    {
+                        "description": "Catalog object",
+                        "name": "catalog",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Catalog"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Catalog"
+                        }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -7078,25 +7329,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/component-definitions/{id}/back-matter": {
+        "/oscal/catalogs/{id}": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves the back-matter for a given Component Definition.",
+                "description": "Retrieves a single Catalog by its unique ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Component Definitions"
+                    "Catalog"
                 ],
-                "summary": "Get back-matter for a Component Definition",
+                "summary": "Get a Catalog",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Component Definition ID",
+                        "description": "Catalog ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -7106,7 +7357,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscal_Get_responseCatalog"
                         }
                     },
                     "400": {
@@ -7135,13 +7386,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates new back-matter for a given component definition.",
+                "description": "Updates an existing OSCAL Catalog.",
                 "consumes": [
                     "application/json"
                 ],
@@ -7149,24 +7400,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Component Definitions"
+                    "Catalog"
                 ],
-                "summary": "Create back-matter for a component definition",
+                "summary": "Update a Catalog",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Component Definition ID",
+                        "description": "Catalog ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Back Matter",
-                        "name": "back-matter",
+                        "description": "Updated Catalog object",
+                        "name": "catalog",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.BackMatter"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Catalog"
                         }
                     }
                 ],
@@ -7174,7 +7425,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Catalog"
                         }
                     },
                     "400": {
@@ -7183,12 +7434,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -7204,25 +7449,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/component-definitions/{id}/capabilities": {
+        "/oscal/catalogs/{id}/back-matter": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all capabilities for a given component definition.",
+                "description": "Retrieves the back-matter for a given Catalog.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Component Definitions"
+                    "Catalog"
                 ],
-                "summary": "Get capabilities for a component definition",
+                "summary": "Get back-matter for a Catalog",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Component Definition ID",
+                        "description": "Catalog ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -7232,7 +7477,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Capability"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
                         }
                     },
                     "400": {
@@ -7260,50 +7505,37 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/oscal/catalogs/{id}/controls": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates new capabilities for a given component definition.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves the top-level controls for a given Catalog.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Component Definitions"
+                    "Catalog"
                 ],
-                "summary": "Create capabilities for a component definition",
+                "summary": "List controls for a Catalog",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Component Definition ID",
+                        "description": "Catalog ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Capabilities",
-                        "name": "capabilities",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/oscalTypes_1_1_3.Capability"
-                            }
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Capability"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Control"
                         }
                     },
                     "400": {
@@ -7331,37 +7563,47 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/component-definitions/{id}/capabilities/incorporates-components": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all incorporates components for a given component definition.",
+                "description": "Adds a top-level control under the specified Catalog.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Component Definitions"
+                    "Catalog"
                 ],
-                "summary": "Get incorporates components for a component definition",
+                "summary": "Create a new Control for a Catalog",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Component Definition ID",
+                        "description": "Catalog ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Control object",
+                        "name": "control",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Control"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_IncorporatesComponent"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Control"
                         }
                     },
                     "400": {
@@ -7370,18 +7612,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -7389,50 +7619,44 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/oscal/catalogs/{id}/controls/{control}": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates new incorporates components for a given component definition.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves a single Control by its ID for a given Catalog.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Component Definitions"
+                    "Catalog"
                 ],
-                "summary": "Create incorporates components for a component definition",
+                "summary": "Get a specific Control within a Catalog",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Component Definition ID",
+                        "description": "Catalog ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Incorporates Components",
-                        "name": "incorporates-components",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/oscalTypes_1_1_3.IncorporatesComponent"
-                            }
-                        }
+                        "type": "string",
+                        "description": "Control ID",
+                        "name": "control",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_IncorporatesComponent"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Control"
                         }
                     },
                     "400": {
@@ -7441,12 +7665,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -7460,16 +7678,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/component-definitions/{id}/capabilities/{capability}": {
+            },
             "put": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates a single capability for a given component definition.",
+                "description": "Updates the properties of an existing Control under the specified Catalog.",
                 "consumes": [
                     "application/json"
                 ],
@@ -7477,31 +7693,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Component Definitions"
+                    "Catalog"
                 ],
-                "summary": "Update a capability for a component definition",
+                "summary": "Update a Control within a Catalog",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Component Definition ID",
+                        "description": "Catalog ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Capability ID (UUID)",
-                        "name": "capability",
+                        "description": "Control ID",
+                        "name": "control",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Capability to update",
-                        "name": "capability",
+                        "description": "Updated Control object",
+                        "name": "control",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Capability"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Control"
                         }
                     }
                 ],
@@ -7509,7 +7725,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Capability"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Control"
                         }
                     },
                     "400": {
@@ -7518,14 +7734,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -7539,35 +7749,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/component-definitions/{id}/components": {
+        "/oscal/catalogs/{id}/controls/{control}/controls": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all components for a given component definition.",
+                "description": "Retrieves the controls directly under a specific Control in a given Catalog.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Component Definitions"
+                    "Catalog"
                 ],
-                "summary": "Get components for a component definition",
+                "summary": "List child controls for a Control within a Catalog",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Component Definition ID",
+                        "description": "Catalog ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Control ID",
+                        "name": "control",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_DefinedComponent"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Control"
                         }
                     },
                     "400": {
@@ -7576,12 +7793,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -7596,13 +7807,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates the components for a given component definition.",
+                "description": "Adds a child control under the specified Catalog Control.",
                 "consumes": [
                     "application/json"
                 ],
@@ -7610,35 +7821,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Component Definitions"
+                    "Catalog"
                 ],
-                "summary": "Update components for a component definition",
+                "summary": "Create a new Sub-Control for a Control within a Catalog",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Component Definition ID",
+                        "description": "Catalog ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Components to update",
-                        "name": "components",
+                        "type": "string",
+                        "description": "Parent Control ID",
+                        "name": "control",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Control object",
+                        "name": "control",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/oscalTypes_1_1_3.DefinedComponent"
-                            }
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Control"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_DefinedComponent"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Control"
                         }
                     },
                     "400": {
@@ -7647,18 +7862,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -7666,50 +7869,37 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/oscal/catalogs/{id}/groups": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates new components for a given component definition.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves the top-level groups for a given Catalog.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Component Definitions"
+                    "Catalog"
                 ],
-                "summary": "Create components for a component definition",
+                "summary": "List groups for a Catalog",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Component Definition ID",
+                        "description": "Catalog ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Components to create",
-                        "name": "components",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/oscalTypes_1_1_3.DefinedComponent"
-                            }
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_DefinedComponent"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Group"
                         }
                     },
                     "400": {
@@ -7737,44 +7927,47 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/component-definitions/{id}/components/{defined-component}": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves a defined component for a given component definition.",
+                "description": "Adds a top-level group under the specified Catalog.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Component Definitions"
+                    "Catalog"
                 ],
-                "summary": "Get a defined component for a component definition",
+                "summary": "Create a new Group for a Catalog",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Component Definition ID",
+                        "description": "Catalog ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Defined Component ID",
-                        "name": "defined-component",
-                        "in": "path",
-                        "required": true
+                        "description": "Group object",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Group"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_DefinedComponent"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Group"
                         }
                     },
                     "400": {
@@ -7783,18 +7976,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -7802,54 +7983,44 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/oscal/catalogs/{id}/groups/{group}": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates a defined component for a given component definition.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves a single Group by its ID for a given Catalog.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Component Definitions"
+                    "Catalog"
                 ],
-                "summary": "Update a defined component for a component definition",
+                "summary": "Get a specific Group within a Catalog",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Component Definition ID",
+                        "description": "Catalog ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Defined Component ID",
-                        "name": "defined-component",
+                        "description": "Group ID",
+                        "name": "group",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Defined Component to update",
-                        "name": "defined-component",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.DefinedComponent"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_DefinedComponent"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Group"
                         }
                     },
                     "400": {
@@ -7858,12 +8029,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -7878,13 +8043,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates a new defined component for a given component definition.",
+                "description": "Updates the properties of an existing Group under the specified Catalog.",
                 "consumes": [
                     "application/json"
                 ],
@@ -7892,24 +8057,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Component Definitions"
+                    "Catalog"
                 ],
-                "summary": "Create a defined component for a component definition",
+                "summary": "Update a Group within a Catalog",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Component Definition ID",
+                        "description": "Catalog ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Defined Component to create",
-                        "name": "defined-component",
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated Group object",
+                        "name": "group",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.DefinedComponent"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Group"
                         }
                     }
                 ],
@@ -7917,7 +8089,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_DefinedComponent"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Group"
                         }
                     },
                     "400": {
@@ -7926,12 +8098,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -7947,33 +8113,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/component-definitions/{id}/components/{defined-component}/control-implementations": {
+        "/oscal/catalogs/{id}/groups/{group}/controls": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all control implementations for a given defined component.",
+                "description": "Retrieves the controls directly under a specific Group in a given Catalog.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Component Definitions"
+                    "Catalog"
                 ],
-                "summary": "Get control implementations for a defined component",
+                "summary": "List controls for a Group within a Catalog",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Component Definition ID",
+                        "description": "Catalog ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Defined Component ID",
-                        "name": "defined-component",
+                        "description": "Group ID",
+                        "name": "group",
                         "in": "path",
                         "required": true
                     }
@@ -7982,7 +8148,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_ControlImplementationSet"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Control"
                         }
                     },
                     "400": {
@@ -7991,12 +8157,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -8011,13 +8171,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates control implementations for a given defined component.",
+                "description": "Adds a control under the specified Catalog and Group.",
                 "consumes": [
                     "application/json"
                 ],
@@ -8025,42 +8185,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Component Definitions"
+                    "Catalog"
                 ],
-                "summary": "Update control implementations for a defined component",
+                "summary": "Create a new Control for a Catalog Group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Component Definition ID",
+                        "description": "Catalog ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Defined Component ID",
-                        "name": "defined-component",
+                        "description": "Parent Group ID",
+                        "name": "group",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Control Implementations",
-                        "name": "control-implementations",
+                        "description": "Control object",
+                        "name": "control",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/oscalTypes_1_1_3.ControlImplementationSet"
-                            }
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Control"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_ControlImplementationSet"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Control"
                         }
                     },
                     "400": {
@@ -8069,18 +8226,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -8088,57 +8233,44 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/oscal/catalogs/{id}/groups/{group}/groups": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates new control implementations for a given defined component.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves the sub-groups of a specific Group in a given Catalog.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Component Definitions"
+                    "Catalog"
                 ],
-                "summary": "Create control implementations for a defined component",
+                "summary": "List sub-groups for a Group within a Catalog",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Component Definition ID",
+                        "description": "Catalog ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Defined Component ID",
-                        "name": "defined-component",
+                        "description": "Group ID",
+                        "name": "group",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Control Implementations",
-                        "name": "control-implementations",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/oscalTypes_1_1_3.ControlImplementationSet"
-                            }
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_ControlImplementationSet"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Group"
                         }
                     },
                     "400": {
@@ -8147,12 +8279,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -8166,44 +8292,54 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/component-definitions/{id}/components/{defined-component}/control-implementations/implemented-requirements": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all implemented requirements for a given defined component.",
+                "description": "Adds a sub-group under the specified Catalog and Group.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Component Definitions"
+                    "Catalog"
                 ],
-                "summary": "Get implemented requirements for a defined component",
+                "summary": "Create a new Sub-Group for a Catalog Group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Component Definition ID",
+                        "description": "Catalog ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Defined Component ID",
-                        "name": "defined-component",
+                        "description": "Parent Group ID",
+                        "name": "group",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Group object",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Group"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_ImplementedRequirementControlImplementation"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Group"
                         }
                     },
                     "400": {
@@ -8212,18 +8348,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -8233,42 +8357,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/component-definitions/{id}/components/{defined-component}/control-implementations/implemented-requirements/statements": {
+        "/oscal/component-definitions": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all statements for a given defined component.",
+                "description": "Retrieves all component definitions.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Component Definitions"
                 ],
-                "summary": "Get statements for a defined component",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Component Definition ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Defined Component ID",
-                        "name": "defined-component",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
+                "summary": "List component definitions",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_ControlStatementImplementation"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscal_List_responseComponentDefinition"
                         }
                     },
                     "400": {
@@ -8283,12 +8391,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -8296,16 +8398,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/component-definitions/{id}/components/{defined-component}/control-implementations/{control-implementation}": {
-            "put": {
+            },
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates a specific control implementation for a given defined component.",
+                "description": "Creates a new component definition.",
                 "consumes": [
                     "application/json"
                 ],
@@ -8315,44 +8415,23 @@ const docTemplate = `{
                 "tags": [
                     "Component Definitions"
                 ],
-                "summary": "Update a single control implementation for a defined component",
+                "summary": "Create a component definition",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Component Definition ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Defined Component ID",
-                        "name": "defined-component",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Control Implementation ID",
-                        "name": "control-implementation",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Control Implementation",
-                        "name": "control-implementation",
+                        "description": "Component Definition",
+                        "name": "componentDefinition",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.ControlImplementationSet"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.ComponentDefinition"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ControlImplementationSet"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ComponentDefinition"
                         }
                     },
                     "400": {
@@ -8367,12 +8446,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -8382,21 +8455,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/component-definitions/{id}/full": {
+        "/oscal/component-definitions/{id}": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves a complete Component Definition by its ID, including all metadata and revisions.",
+                "description": "Retrieves a single component definition by its unique ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Component Definitions"
                 ],
-                "summary": "Get a complete Component Definition",
+                "summary": "Get a component definition",
                 "parameters": [
                     {
                         "type": "string",
@@ -8410,7 +8483,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ComponentDefinition"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscal_Get_responseComponentDefinition"
                         }
                     },
                     "400": {
@@ -8438,23 +8511,24 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/component-definitions/{id}/import-component-definitions": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all import component definitions for a given defined component.",
+                "description": "Updates an existing component definition.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Component Definitions"
                 ],
-                "summary": "Get import component definitions for a defined component",
+                "summary": "Update a component definition",
                 "parameters": [
                     {
                         "type": "string",
@@ -8464,18 +8538,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Defined Component ID",
-                        "name": "defined-component",
-                        "in": "path",
-                        "required": true
+                        "description": "Updated Component Definition object",
+                        "name": "componentDefinition",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.ComponentDefinition"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_ImportComponentDefinition"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ComponentDefinition"
                         }
                     },
                     "400": {
@@ -8503,21 +8579,23 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/oscal/component-definitions/{id}/back-matter": {
+            "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates the import component definitions for a given component definition.",
+                "description": "Retrieves the back-matter for a given Component Definition.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Component Definitions"
                 ],
-                "summary": "Update import component definitions for a component definition",
+                "summary": "Get back-matter for a Component Definition",
                 "parameters": [
                     {
                         "type": "string",
@@ -8525,25 +8603,13 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Import Component Definitions",
-                        "name": "import-component-definitions",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/oscalTypes_1_1_3.ImportComponentDefinition"
-                            }
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_ImportComponentDefinition"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
                         }
                     },
                     "400": {
@@ -8578,14 +8644,17 @@ const docTemplate = `{
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Creates new import component definitions for a given component definition.",
+                "description": "Creates new back-matter for a given component definition.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Component Definitions"
                 ],
-                "summary": "Create import component definitions for a component definition",
+                "summary": "Create back-matter for a component definition",
                 "parameters": [
                     {
                         "type": "string",
@@ -8595,15 +8664,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Import Component Definitions",
-                        "name": "import-component-definitions",
+                        "description": "Back Matter",
+                        "name": "back-matter",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/oscalTypes_1_1_3.ImportComponentDefinition"
-                            }
+                            "$ref": "#/definitions/oscalTypes_1_1_3.BackMatter"
                         }
                     }
                 ],
@@ -8611,7 +8677,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_ImportComponentDefinition"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
                         }
                     },
                     "400": {
@@ -8641,26 +8707,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/parties": {
+        "/oscal/component-definitions/{id}/capabilities": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves all parties.",
+                "description": "Retrieves all capabilities for a given component definition.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Oscal"
+                    "Component Definitions"
+                ],
+                "summary": "Get capabilities for a component definition",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Component Definition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "List parties",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Party"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Capability"
                         }
                     },
                     "400": {
@@ -8675,6 +8750,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -8682,37 +8763,50 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/parties/{id}": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves a single Party by its unique ID.",
+                "description": "Creates new capabilities for a given component definition.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Oscal"
+                    "Component Definitions"
                 ],
-                "summary": "Get a Party",
+                "summary": "Create capabilities for a component definition",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Party ID",
+                        "description": "Component Definition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Capabilities",
+                        "name": "capabilities",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/oscalTypes_1_1_3.Capability"
+                            }
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Party"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Capability"
                         }
                     },
                     "400": {
@@ -8742,21 +8836,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/plan-of-action-and-milestones": {
+        "/oscal/component-definitions/{id}/capabilities/incorporates-components": {
             "get": {
-                "description": "Retrieves all Plan of Action and Milestones.",
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves all incorporates components for a given component definition.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Plan Of Action and Milestones"
+                    "Component Definitions"
+                ],
+                "summary": "Get incorporates components for a component definition",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Component Definition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "List POA\u0026Ms",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_PlanOfActionAndMilestones"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_IncorporatesComponent"
                         }
                     },
                     "400": {
@@ -8765,6 +8873,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -8774,7 +8894,12 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Creates a new Plan of Action and Milestones.",
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Creates new incorporates components for a given component definition.",
                 "consumes": [
                     "application/json"
                 ],
@@ -8782,17 +8907,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Plan Of Action and Milestones"
+                    "Component Definitions"
                 ],
-                "summary": "Create a new POA\u0026M",
+                "summary": "Create incorporates components for a component definition",
                 "parameters": [
                     {
-                        "description": "POA\u0026M data",
-                        "name": "poam",
+                        "type": "string",
+                        "description": "Component Definition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Incorporates Components",
+                        "name": "incorporates-components",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.PlanOfActionAndMilestones"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/oscalTypes_1_1_3.IncorporatesComponent"
+                            }
                         }
                     }
                 ],
@@ -8800,7 +8935,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_PlanOfActionAndMilestones"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_IncorporatesComponent"
                         }
                     },
                     "400": {
@@ -8809,43 +8944,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    }
-                }
-            }
-        },
-        "/oscal/plan-of-action-and-milestones/{id}": {
-            "get": {
-                "description": "Retrieves a single Plan of Action and Milestones by its unique ID.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Plan Of Action and Milestones"
-                ],
-                "summary": "Get a POA\u0026M",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "POA\u0026M ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_PlanOfActionAndMilestones"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -8863,9 +8963,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/oscal/component-definitions/{id}/capabilities/{capability}": {
             "put": {
-                "description": "Updates an existing Plan of Action and Milestones.",
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Updates a single capability for a given component definition.",
                 "consumes": [
                     "application/json"
                 ],
@@ -8873,24 +8980,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Plan Of Action and Milestones"
+                    "Component Definitions"
                 ],
-                "summary": "Update a POA\u0026M",
+                "summary": "Update a capability for a component definition",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "POA\u0026M ID",
+                        "description": "Component Definition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "POA\u0026M data",
-                        "name": "poam",
+                        "type": "string",
+                        "description": "Capability ID (UUID)",
+                        "name": "capability",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Capability to update",
+                        "name": "capability",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.PlanOfActionAndMilestones"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Capability"
                         }
                     }
                 ],
@@ -8898,7 +9012,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_PlanOfActionAndMilestones"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Capability"
                         }
                     },
                     "400": {
@@ -8907,6 +9021,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -8920,25 +9040,38 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes an existing Plan of Action and Milestones and all its related data.",
+            }
+        },
+        "/oscal/component-definitions/{id}/components": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves all components for a given component definition.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Plan Of Action and Milestones"
+                    "Component Definitions"
                 ],
-                "summary": "Delete a POA\u0026M",
+                "summary": "Get components for a component definition",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "POA\u0026M ID",
+                        "description": "Component Definition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_DefinedComponent"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -8946,6 +9079,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -8959,32 +9098,50 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/plan-of-action-and-milestones/{id}/back-matter": {
-            "get": {
-                "description": "Retrieves back-matter for a given POA\u0026M.",
+            },
+            "put": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Updates the components for a given component definition.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Plan Of Action and Milestones"
+                    "Component Definitions"
                 ],
-                "summary": "Get POA\u0026M back-matter",
+                "summary": "Update components for a component definition",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "POA\u0026M ID",
+                        "description": "Component Definition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Components to update",
+                        "name": "components",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/oscalTypes_1_1_3.DefinedComponent"
+                            }
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_DefinedComponent"
                         }
                     },
                     "400": {
@@ -8993,6 +9150,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -9006,32 +9169,50 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/plan-of-action-and-milestones/{id}/back-matter/resources": {
-            "get": {
-                "description": "Retrieves all back-matter resources for a given POA\u0026M.",
+            },
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Creates new components for a given component definition.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Plan Of Action and Milestones"
+                    "Component Definitions"
                 ],
-                "summary": "Get back-matter resources for a POA\u0026M",
+                "summary": "Create components for a component definition",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "POA\u0026M ID",
+                        "description": "Component Definition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Components to create",
+                        "name": "components",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/oscalTypes_1_1_3.DefinedComponent"
+                            }
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Resource"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_DefinedComponent"
                         }
                     },
                     "400": {
@@ -9040,6 +9221,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -9053,42 +9240,44 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Creates a new back-matter resource for a given POA\u0026M.",
-                "consumes": [
-                    "application/json"
+            }
+        },
+        "/oscal/component-definitions/{id}/components/{defined-component}": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
                 ],
+                "description": "Retrieves a defined component for a given component definition.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Plan Of Action and Milestones"
+                    "Component Definitions"
                 ],
-                "summary": "Create a new back-matter resource for a POA\u0026M",
+                "summary": "Get a defined component for a component definition",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "POA\u0026M ID",
+                        "description": "Component Definition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Resource data",
-                        "name": "resource",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Resource"
-                        }
+                        "type": "string",
+                        "description": "Defined Component ID",
+                        "name": "defined-component",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Resource"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_DefinedComponent"
                         }
                     },
                     "400": {
@@ -9097,6 +9286,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -9110,11 +9305,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/plan-of-action-and-milestones/{id}/back-matter/resources/{resourceId}": {
+            },
             "put": {
-                "description": "Updates an existing back-matter resource for a given POA\u0026M.",
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Updates a defined component for a given component definition.",
                 "consumes": [
                     "application/json"
                 ],
@@ -9122,31 +9320,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Plan Of Action and Milestones"
+                    "Component Definitions"
                 ],
-                "summary": "Update a back-matter resource for a POA\u0026M",
+                "summary": "Update a defined component for a component definition",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "POA\u0026M ID",
+                        "description": "Component Definition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Resource ID",
-                        "name": "resourceId",
+                        "description": "Defined Component ID",
+                        "name": "defined-component",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Resource data",
-                        "name": "resource",
+                        "description": "Defined Component to update",
+                        "name": "defined-component",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Resource"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.DefinedComponent"
                         }
                     }
                 ],
@@ -9154,7 +9352,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Resource"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_DefinedComponent"
                         }
                     },
                     "400": {
@@ -9163,6 +9361,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -9177,31 +9381,47 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
-                "description": "Deletes an existing back-matter resource for a given POA\u0026M.",
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Creates a new defined component for a given component definition.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Plan Of Action and Milestones"
+                    "Component Definitions"
                 ],
-                "summary": "Delete a back-matter resource from a POA\u0026M",
+                "summary": "Create a defined component for a component definition",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "POA\u0026M ID",
+                        "description": "Component Definition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Resource ID",
-                        "name": "resourceId",
-                        "in": "path",
-                        "required": true
+                        "description": "Defined Component to create",
+                        "name": "defined-component",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.DefinedComponent"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_DefinedComponent"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -9209,6 +9429,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -9224,30 +9450,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/plan-of-action-and-milestones/{id}/findings": {
+        "/oscal/component-definitions/{id}/components/{defined-component}/control-implementations": {
             "get": {
-                "description": "Retrieves all findings for a given POA\u0026M.",
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves all control implementations for a given defined component.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Plan Of Action and Milestones"
+                    "Component Definitions"
                 ],
-                "summary": "Get findings for a POA\u0026M",
+                "summary": "Get control implementations for a defined component",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "POA\u0026M ID",
+                        "description": "Component Definition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Defined Component ID",
+                        "name": "defined-component",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Finding"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_ControlImplementationSet"
                         }
                     },
                     "400": {
@@ -9256,6 +9494,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -9270,8 +9514,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "Creates a new finding for a given POA\u0026M.",
+            "put": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Updates control implementations for a given defined component.",
                 "consumes": [
                     "application/json"
                 ],
@@ -9279,32 +9528,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Plan Of Action and Milestones"
+                    "Component Definitions"
                 ],
-                "summary": "Create a new finding for a POA\u0026M",
+                "summary": "Update control implementations for a defined component",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "POA\u0026M ID",
+                        "description": "Component Definition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Finding data",
-                        "name": "finding",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Finding"
-                        }
+                        "type": "string",
+                        "description": "Defined Component ID",
+                        "name": "defined-component",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Control Implementations",
+                        "name": "control-implementations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/oscalTypes_1_1_3.ControlImplementationSet"
+                            }
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Finding"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_ControlImplementationSet"
                         }
                     },
                     "400": {
@@ -9313,6 +9572,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -9326,11 +9591,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/plan-of-action-and-milestones/{id}/findings/{findingId}": {
-            "put": {
-                "description": "Updates an existing finding for a given POA\u0026M.",
+            },
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Creates new control implementations for a given defined component.",
                 "consumes": [
                     "application/json"
                 ],
@@ -9338,31 +9606,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Plan Of Action and Milestones"
+                    "Component Definitions"
                 ],
-                "summary": "Update a finding for a POA\u0026M",
+                "summary": "Create control implementations for a defined component",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "POA\u0026M ID",
+                        "description": "Component Definition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Finding ID",
-                        "name": "findingId",
+                        "description": "Defined Component ID",
+                        "name": "defined-component",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Finding data",
-                        "name": "finding",
+                        "description": "Control Implementations",
+                        "name": "control-implementations",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Finding"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/oscalTypes_1_1_3.ControlImplementationSet"
+                            }
                         }
                     }
                 ],
@@ -9370,7 +9641,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Finding"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_ControlImplementationSet"
                         }
                     },
                     "400": {
@@ -9379,6 +9650,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -9392,32 +9669,45 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes an existing finding for a given POA\u0026M.",
+            }
+        },
+        "/oscal/component-definitions/{id}/components/{defined-component}/control-implementations/implemented-requirements": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves all implemented requirements for a given defined component.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Plan Of Action and Milestones"
+                    "Component Definitions"
                 ],
-                "summary": "Delete a finding from a POA\u0026M",
+                "summary": "Get implemented requirements for a defined component",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "POA\u0026M ID",
+                        "description": "Component Definition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Finding ID",
-                        "name": "findingId",
+                        "description": "Defined Component ID",
+                        "name": "defined-component",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_ImplementedRequirementControlImplementation"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -9425,6 +9715,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -9440,30 +9736,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/plan-of-action-and-milestones/{id}/full": {
+        "/oscal/component-definitions/{id}/components/{defined-component}/control-implementations/implemented-requirements/statements": {
             "get": {
-                "description": "Retrieves a complete POA\u0026M by its ID, including all metadata and related objects.",
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves all statements for a given defined component.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Plan Of Action and Milestones"
+                    "Component Definitions"
                 ],
-                "summary": "Get a complete POA\u0026M",
+                "summary": "Get statements for a defined component",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "POA\u0026M ID",
+                        "description": "Component Definition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Defined Component ID",
+                        "name": "defined-component",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_PlanOfActionAndMilestones"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_ControlStatementImplementation"
                         }
                     },
                     "400": {
@@ -9472,6 +9780,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -9487,30 +9801,61 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/plan-of-action-and-milestones/{id}/import-ssp": {
-            "get": {
-                "description": "Retrieves import-ssp for a given POA\u0026M.",
+        "/oscal/component-definitions/{id}/components/{defined-component}/control-implementations/{control-implementation}": {
+            "put": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Updates a specific control implementation for a given defined component.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Plan Of Action and Milestones"
+                    "Component Definitions"
                 ],
-                "summary": "Get POA\u0026M import-ssp",
+                "summary": "Update a single control implementation for a defined component",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "POA\u0026M ID",
+                        "description": "Component Definition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Defined Component ID",
+                        "name": "defined-component",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Control Implementation ID",
+                        "name": "control-implementation",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Control Implementation",
+                        "name": "control-implementation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.ControlImplementationSet"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ImportSsp"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ControlImplementationSet"
                         }
                     },
                     "400": {
@@ -9519,6 +9864,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -9532,42 +9883,37 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Updates import-ssp for a given POA\u0026M.",
-                "consumes": [
-                    "application/json"
+            }
+        },
+        "/oscal/component-definitions/{id}/full": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
                 ],
+                "description": "Retrieves a complete Component Definition by its ID, including all metadata and revisions.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Plan Of Action and Milestones"
+                    "Component Definitions"
                 ],
-                "summary": "Update import-ssp for a POA\u0026M",
+                "summary": "Get a complete Component Definition",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "POA\u0026M ID",
+                        "description": "Component Definition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Import SSP data",
-                        "name": "importSsp",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.ImportSsp"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ImportSsp"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ComponentDefinition"
                         }
                     },
                     "400": {
@@ -9576,6 +9922,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -9589,42 +9941,44 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Creates import-ssp for a given POA\u0026M.",
-                "consumes": [
-                    "application/json"
+            }
+        },
+        "/oscal/component-definitions/{id}/import-component-definitions": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
                 ],
+                "description": "Retrieves all import component definitions for a given defined component.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Plan Of Action and Milestones"
+                    "Component Definitions"
                 ],
-                "summary": "Create import-ssp for a POA\u0026M",
+                "summary": "Get import component definitions for a defined component",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "POA\u0026M ID",
+                        "description": "Component Definition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Import SSP data",
-                        "name": "importSsp",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.ImportSsp"
-                        }
+                        "type": "string",
+                        "description": "Defined Component ID",
+                        "name": "defined-component",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ImportSsp"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_ImportComponentDefinition"
                         }
                     },
                     "400": {
@@ -9633,6 +9987,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -9646,32 +10006,47 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/plan-of-action-and-milestones/{id}/local-definitions": {
-            "get": {
-                "description": "Retrieves local definitions for a given POA\u0026M.",
+            },
+            "put": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Updates the import component definitions for a given component definition.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Plan Of Action and Milestones"
+                    "Component Definitions"
                 ],
-                "summary": "Get POA\u0026M local definitions",
+                "summary": "Update import component definitions for a component definition",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "POA\u0026M ID",
+                        "description": "Component Definition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Import Component Definitions",
+                        "name": "import-component-definitions",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/oscalTypes_1_1_3.ImportComponentDefinition"
+                            }
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_PlanOfActionAndMilestonesLocalDefinitions"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_ImportComponentDefinition"
                         }
                     },
                     "400": {
@@ -9680,6 +10055,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -9693,32 +10074,47 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/plan-of-action-and-milestones/{id}/metadata": {
-            "get": {
-                "description": "Retrieves metadata for a given POA\u0026M.",
+            },
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Creates new import component definitions for a given component definition.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Plan Of Action and Milestones"
+                    "Component Definitions"
                 ],
-                "summary": "Get POA\u0026M metadata",
+                "summary": "Create import component definitions for a component definition",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "POA\u0026M ID",
+                        "description": "Component Definition ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Import Component Definitions",
+                        "name": "import-component-definitions",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/oscalTypes_1_1_3.ImportComponentDefinition"
+                            }
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Metadata"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_ImportComponentDefinition"
                         }
                     },
                     "400": {
@@ -9727,6 +10123,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -9740,42 +10142,28 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Updates metadata for a given POA\u0026M.",
-                "consumes": [
-                    "application/json"
+            }
+        },
+        "/oscal/parties": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
                 ],
+                "description": "Retrieves all parties.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Plan Of Action and Milestones"
-                ],
-                "summary": "Update POA\u0026M metadata",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "POA\u0026M ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Metadata data",
-                        "name": "metadata",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Metadata"
-                        }
-                    }
+                    "Oscal"
                 ],
+                "summary": "List parties",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Metadata"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Party"
                         }
                     },
                     "400": {
@@ -9784,8 +10172,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -9799,20 +10187,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/plan-of-action-and-milestones/{id}/observations": {
+        "/oscal/parties/{id}": {
             "get": {
-                "description": "Retrieves all observations for a given POA\u0026M.",
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves a single Party by its unique ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Plan Of Action and Milestones"
+                    "Oscal"
                 ],
-                "summary": "Get observations for a POA\u0026M",
+                "summary": "Get a Party",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "POA\u0026M ID",
+                        "description": "Party ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -9822,7 +10215,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Observation"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Party"
                         }
                     },
                     "400": {
@@ -9831,6 +10224,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -9844,42 +10243,23 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Creates a new observation for a given POA\u0026M.",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/oscal/plan-of-action-and-milestones": {
+            "get": {
+                "description": "Retrieves all Plan of Action and Milestones.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Plan Of Action and Milestones"
                 ],
-                "summary": "Create a new observation for a POA\u0026M",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "POA\u0026M ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Observation data",
-                        "name": "observation",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Observation"
-                        }
-                    }
-                ],
+                "summary": "List POA\u0026Ms",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Observation"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_PlanOfActionAndMilestones"
                         }
                     },
                     "400": {
@@ -9888,12 +10268,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -9901,11 +10275,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/plan-of-action-and-milestones/{id}/observations/{obsId}": {
-            "put": {
-                "description": "Updates an existing observation for a given POA\u0026M.",
+            },
+            "post": {
+                "description": "Creates a new Plan of Action and Milestones.",
                 "consumes": [
                     "application/json"
                 ],
@@ -9915,29 +10287,15 @@ const docTemplate = `{
                 "tags": [
                     "Plan Of Action and Milestones"
                 ],
-                "summary": "Update an observation for a POA\u0026M",
+                "summary": "Create a new POA\u0026M",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "POA\u0026M ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Observation ID",
-                        "name": "obsId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Observation data",
-                        "name": "observation",
+                        "description": "POA\u0026M data",
+                        "name": "poam",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Observation"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.PlanOfActionAndMilestones"
                         }
                     }
                 ],
@@ -9945,7 +10303,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Observation"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_PlanOfActionAndMilestones"
                         }
                     },
                     "400": {
@@ -9954,12 +10312,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -9967,13 +10319,18 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes an existing observation for a given POA\u0026M.",
+            }
+        },
+        "/oscal/plan-of-action-and-milestones/{id}": {
+            "get": {
+                "description": "Retrieves a single Plan of Action and Milestones by its unique ID.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "Plan Of Action and Milestones"
                 ],
-                "summary": "Delete an observation from a POA\u0026M",
+                "summary": "Get a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
@@ -9981,65 +10338,14 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Observation ID",
-                        "name": "obsId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    }
-                }
-            }
-        },
-        "/oscal/plan-of-action-and-milestones/{id}/poam-items": {
-            "get": {
-                "description": "Retrieves all POA\u0026M items for a given POA\u0026M.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Plan Of Action and Milestones"
-                ],
-                "summary": "Get POA\u0026M items",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "POA\u0026M ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_PoamItem"
-                        }
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_PlanOfActionAndMilestones"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -10061,8 +10367,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "Creates a new POAM item for a given POA\u0026M.",
+            "put": {
+                "description": "Updates an existing Plan of Action and Milestones.",
                 "consumes": [
                     "application/json"
                 ],
@@ -10072,7 +10378,7 @@ const docTemplate = `{
                 "tags": [
                     "Plan Of Action and Milestones"
                 ],
-                "summary": "Create a new POAM item for a POA\u0026M",
+                "summary": "Update a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
@@ -10082,12 +10388,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "POAM Item data",
-                        "name": "poam-item",
+                        "description": "POA\u0026M data",
+                        "name": "poam",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.PoamItem"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.PlanOfActionAndMilestones"
                         }
                     }
                 ],
@@ -10095,7 +10401,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_PoamItem"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_PlanOfActionAndMilestones"
                         }
                     },
                     "400": {
@@ -10117,21 +10423,13 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/plan-of-action-and-milestones/{id}/poam-items/{itemId}": {
-            "put": {
-                "description": "Updates an existing POAM item for a given POA\u0026M.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+            },
+            "delete": {
+                "description": "Deletes an existing Plan of Action and Milestones and all its related data.",
                 "tags": [
                     "Plan Of Action and Milestones"
                 ],
-                "summary": "Update a POAM item for a POA\u0026M",
+                "summary": "Delete a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
@@ -10139,30 +10437,11 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "POAM Item ID",
-                        "name": "itemId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "POAM Item data",
-                        "name": "poam-item",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.PoamItem"
-                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_PoamItem"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -10183,13 +10462,18 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes an existing POAM item for a given POA\u0026M.",
+            }
+        },
+        "/oscal/plan-of-action-and-milestones/{id}/back-matter": {
+            "get": {
+                "description": "Retrieves back-matter for a given POA\u0026M.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "Plan Of Action and Milestones"
                 ],
-                "summary": "Delete a POAM item from a POA\u0026M",
+                "summary": "Get POA\u0026M back-matter",
                 "parameters": [
                     {
                         "type": "string",
@@ -10197,18 +10481,14 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "POAM Item ID",
-                        "name": "itemId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -10231,16 +10511,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/plan-of-action-and-milestones/{id}/risks": {
+        "/oscal/plan-of-action-and-milestones/{id}/back-matter/resources": {
             "get": {
-                "description": "Retrieves all risks for a given POA\u0026M.",
+                "description": "Retrieves all back-matter resources for a given POA\u0026M.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Plan Of Action and Milestones"
                 ],
-                "summary": "Get risks for a POA\u0026M",
+                "summary": "Get back-matter resources for a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
@@ -10254,7 +10534,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Risk"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Resource"
                         }
                     },
                     "400": {
@@ -10278,7 +10558,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Creates a new risk for a given POA\u0026M.",
+                "description": "Creates a new back-matter resource for a given POA\u0026M.",
                 "consumes": [
                     "application/json"
                 ],
@@ -10288,7 +10568,7 @@ const docTemplate = `{
                 "tags": [
                     "Plan Of Action and Milestones"
                 ],
-                "summary": "Create a new risk for a POA\u0026M",
+                "summary": "Create a new back-matter resource for a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
@@ -10298,20 +10578,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Risk data",
-                        "name": "risk",
+                        "description": "Resource data",
+                        "name": "resource",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Risk"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Resource"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Risk"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Resource"
                         }
                     },
                     "400": {
@@ -10335,9 +10615,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/plan-of-action-and-milestones/{id}/risks/{riskId}": {
+        "/oscal/plan-of-action-and-milestones/{id}/back-matter/resources/{resourceId}": {
             "put": {
-                "description": "Updates an existing risk for a given POA\u0026M.",
+                "description": "Updates an existing back-matter resource for a given POA\u0026M.",
                 "consumes": [
                     "application/json"
                 ],
@@ -10347,7 +10627,7 @@ const docTemplate = `{
                 "tags": [
                     "Plan Of Action and Milestones"
                 ],
-                "summary": "Update a risk for a POA\u0026M",
+                "summary": "Update a back-matter resource for a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
@@ -10358,18 +10638,18 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Risk ID",
-                        "name": "riskId",
+                        "description": "Resource ID",
+                        "name": "resourceId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Risk data",
-                        "name": "risk",
+                        "description": "Resource data",
+                        "name": "resource",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Risk"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Resource"
                         }
                     }
                 ],
@@ -10377,7 +10657,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Risk"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Resource"
                         }
                     },
                     "400": {
@@ -10401,11 +10681,11 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Deletes an existing risk for a given POA\u0026M.",
+                "description": "Deletes an existing back-matter resource for a given POA\u0026M.",
                 "tags": [
                     "Plan Of Action and Milestones"
                 ],
-                "summary": "Delete a risk from a POA\u0026M",
+                "summary": "Delete a back-matter resource from a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
@@ -10416,8 +10696,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Risk ID",
-                        "name": "riskId",
+                        "description": "Resource ID",
+                        "name": "resourceId",
                         "in": "path",
                         "required": true
                     }
@@ -10447,16 +10727,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/plan-of-action-and-milestones/{id}/system-id": {
+        "/oscal/plan-of-action-and-milestones/{id}/findings": {
             "get": {
-                "description": "Retrieves system-id for a given POA\u0026M.",
+                "description": "Retrieves all findings for a given POA\u0026M.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Plan Of Action and Milestones"
                 ],
-                "summary": "Get POA\u0026M system-id",
+                "summary": "Get findings for a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
@@ -10470,7 +10750,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemId"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Finding"
                         }
                     },
                     "400": {
@@ -10493,8 +10773,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Updates system-id for a given POA\u0026M.",
+            "post": {
+                "description": "Creates a new finding for a given POA\u0026M.",
                 "consumes": [
                     "application/json"
                 ],
@@ -10504,7 +10784,7 @@ const docTemplate = `{
                 "tags": [
                     "Plan Of Action and Milestones"
                 ],
-                "summary": "Update system-id for a POA\u0026M",
+                "summary": "Create a new finding for a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
@@ -10514,12 +10794,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "System ID data",
-                        "name": "systemId",
+                        "description": "Finding data",
+                        "name": "finding",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.SystemId"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Finding"
                         }
                     }
                 ],
@@ -10527,7 +10807,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemId"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Finding"
                         }
                     },
                     "400": {
@@ -10549,9 +10829,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Creates system-id for a given POA\u0026M.",
+            }
+        },
+        "/oscal/plan-of-action-and-milestones/{id}/findings/{findingId}": {
+            "put": {
+                "description": "Updates an existing finding for a given POA\u0026M.",
                 "consumes": [
                     "application/json"
                 ],
@@ -10561,7 +10843,7 @@ const docTemplate = `{
                 "tags": [
                     "Plan Of Action and Milestones"
                 ],
-                "summary": "Create system-id for a POA\u0026M",
+                "summary": "Update a finding for a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
@@ -10571,12 +10853,19 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "System ID data",
-                        "name": "systemId",
+                        "type": "string",
+                        "description": "Finding ID",
+                        "name": "findingId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Finding data",
+                        "name": "finding",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.SystemId"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Finding"
                         }
                     }
                 ],
@@ -10584,7 +10873,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemId"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Finding"
                         }
                     },
                     "400": {
@@ -10606,29 +10895,32 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/profiles": {
-            "get": {
-                "security": [
+            },
+            "delete": {
+                "description": "Deletes an existing finding for a given POA\u0026M.",
+                "tags": [
+                    "Plan Of Action and Milestones"
+                ],
+                "summary": "Delete a finding from a POA\u0026M",
+                "parameters": [
                     {
-                        "OAuth2Password": []
+                        "type": "string",
+                        "description": "POA\u0026M ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Finding ID",
+                        "name": "findingId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
-                "description": "Retrieves all OSCAL profiles",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Profile"
-                ],
-                "summary": "List Profiles",
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscal_ProfileHandler"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -10636,8 +10928,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -10649,40 +10941,32 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Creates a new OSCAL Profile.",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/oscal/plan-of-action-and-milestones/{id}/full": {
+            "get": {
+                "description": "Retrieves a complete POA\u0026M by its ID, including all metadata and related objects.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Profile"
+                    "Plan Of Action and Milestones"
                 ],
-                "summary": "Create a new OSCAL Profile",
+                "summary": "Get a complete POA\u0026M",
                 "parameters": [
                     {
-                        "description": "Profile object",
-                        "name": "profile",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Profile"
-                        }
+                        "type": "string",
+                        "description": "POA\u0026M ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Profile"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_PlanOfActionAndMilestones"
                         }
                     },
                     "400": {
@@ -10691,8 +10975,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -10706,25 +10990,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/profiles/{id}": {
+        "/oscal/plan-of-action-and-milestones/{id}/import-ssp": {
             "get": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Get an OSCAL profile with the uuid provided",
+                "description": "Retrieves import-ssp for a given POA\u0026M.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Profile"
+                    "Plan Of Action and Milestones"
                 ],
-                "summary": "Get Profile",
+                "summary": "Get POA\u0026M import-ssp",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Profile ID",
+                        "description": "POA\u0026M ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -10734,7 +11013,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscal_ProfileHandler"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ImportSsp"
                         }
                     },
                     "400": {
@@ -10743,12 +11022,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -10762,37 +11035,42 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/profiles/{id}/back-matter": {
-            "get": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
+            },
+            "put": {
+                "description": "Updates import-ssp for a given POA\u0026M.",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "Get the BackMatter for a specific profile",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Profile"
+                    "Plan Of Action and Milestones"
                 ],
-                "summary": "Get Backmatter",
+                "summary": "Update import-ssp for a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Profile ID",
+                        "description": "POA\u0026M ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Import SSP data",
+                        "name": "importSsp",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.ImportSsp"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ImportSsp"
                         }
                     },
                     "400": {
@@ -10801,12 +11079,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -10820,37 +11092,42 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/profiles/{id}/full": {
-            "get": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
+            },
+            "post": {
+                "description": "Creates import-ssp for a given POA\u0026M.",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "Retrieves the full OSCAL Profile, including all nested content.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Profile"
+                    "Plan Of Action and Milestones"
                 ],
-                "summary": "Get full Profile",
+                "summary": "Create import-ssp for a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Profile ID",
+                        "description": "POA\u0026M ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Import SSP data",
+                        "name": "importSsp",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.ImportSsp"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Profile"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ImportSsp"
                         }
                     },
                     "400": {
@@ -10859,12 +11136,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -10880,25 +11151,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/profiles/{id}/imports": {
+        "/oscal/plan-of-action-and-milestones/{id}/local-definitions": {
             "get": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "List imports for a specific profile",
+                "description": "Retrieves local definitions for a given POA\u0026M.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Profile"
+                    "Plan Of Action and Milestones"
                 ],
-                "summary": "List Imports",
+                "summary": "Get POA\u0026M local definitions",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Profile ID",
+                        "description": "POA\u0026M ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -10908,7 +11174,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Import"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_PlanOfActionAndMilestonesLocalDefinitions"
                         }
                     },
                     "400": {
@@ -10917,12 +11183,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -10938,47 +11198,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/profiles/{id}/imports/add": {
-            "post": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Adds an import to a profile by its UUID and type (catalog/profile). Only catalogs are currently supported currently",
-                "consumes": [
-                    "application/json"
-                ],
+        "/oscal/plan-of-action-and-milestones/{id}/metadata": {
+            "get": {
+                "description": "Retrieves metadata for a given POA\u0026M.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Profile"
+                    "Plan Of Action and Milestones"
                 ],
-                "summary": "Add Import to Profile",
+                "summary": "Get POA\u0026M metadata",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Profile ID",
+                        "description": "POA\u0026M ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Request data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscal.ProfileHandler"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Import"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Metadata"
                         }
                     },
                     "400": {
@@ -10987,24 +11230,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -11012,44 +11243,42 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/profiles/{id}/imports/{href}": {
-            "get": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
+            },
+            "put": {
+                "description": "Updates metadata for a given POA\u0026M.",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "Retrieves a specific import from a profile by its backmatter href",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Profile"
+                    "Plan Of Action and Milestones"
                 ],
-                "summary": "Get Import from Profile by Backmatter Href",
+                "summary": "Update POA\u0026M metadata",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Profile UUID",
+                        "description": "POA\u0026M ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Import Href",
-                        "name": "href",
-                        "in": "path",
-                        "required": true
+                        "description": "Metadata data",
+                        "name": "metadata",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Metadata"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Import"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Metadata"
                         }
                     },
                     "400": {
@@ -11058,12 +11287,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -11077,54 +11300,32 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Updates an existing import in a profile by its href",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/oscal/plan-of-action-and-milestones/{id}/observations": {
+            "get": {
+                "description": "Retrieves all observations for a given POA\u0026M.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Profile"
+                    "Plan Of Action and Milestones"
                 ],
-                "summary": "Update Import in Profile",
+                "summary": "Get observations for a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Profile ID",
+                        "description": "POA\u0026M ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Import Href",
-                        "name": "href",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Import data to update",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Import"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Import"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Observation"
                         }
                     },
                     "400": {
@@ -11133,12 +11334,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -11153,48 +11348,45 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
+            "post": {
+                "description": "Creates a new observation for a given POA\u0026M.",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "Deletes an import from a profile by its href",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Profile"
+                    "Plan Of Action and Milestones"
                 ],
-                "summary": "Delete Import from Profile",
+                "summary": "Create a new observation for a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Profile ID",
+                        "description": "POA\u0026M ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Import Href",
-                        "name": "href",
-                        "in": "path",
-                        "required": true
+                        "description": "Observation data",
+                        "name": "observation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Observation"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Import deleted successfully"
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.Error"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Observation"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -11214,35 +11406,49 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/profiles/{id}/merge": {
-            "get": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
+        "/oscal/plan-of-action-and-milestones/{id}/observations/{obsId}": {
+            "put": {
+                "description": "Updates an existing observation for a given POA\u0026M.",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "Retrieves the merge section for a specific profile.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Profile"
+                    "Plan Of Action and Milestones"
                 ],
-                "summary": "Get merge section",
+                "summary": "Update an observation for a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Profile ID",
+                        "description": "POA\u0026M ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Observation ID",
+                        "name": "obsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Observation data",
+                        "name": "observation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Observation"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Merge"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Observation"
                         }
                     },
                     "400": {
@@ -11251,12 +11457,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -11271,47 +11471,31 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Updates the merge information for a specific profile",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+            "delete": {
+                "description": "Deletes an existing observation for a given POA\u0026M.",
                 "tags": [
-                    "Profile"
+                    "Plan Of Action and Milestones"
                 ],
-                "summary": "Update Merge",
+                "summary": "Delete an observation from a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Profile ID",
+                        "description": "POA\u0026M ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Merge data to update",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Merge"
-                        }
+                        "type": "string",
+                        "description": "Observation ID",
+                        "name": "obsId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Merge"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -11319,12 +11503,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -11340,25 +11518,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/profiles/{id}/modify": {
+        "/oscal/plan-of-action-and-milestones/{id}/poam-items": {
             "get": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Retrieves the modify section for a specific profile.",
+                "description": "Retrieves all POA\u0026M items for a given POA\u0026M.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Profile"
+                    "Plan Of Action and Milestones"
                 ],
-                "summary": "Get modify section",
+                "summary": "Get POA\u0026M items",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Profile ID",
+                        "description": "POA\u0026M ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -11368,7 +11541,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Modify"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_PoamItem"
                         }
                     },
                     "400": {
@@ -11390,37 +11563,42 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/profiles/{id}/resolve": {
+            },
             "post": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
+                "description": "Creates a new POAM item for a given POA\u0026M.",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "Resolves a Profiled identified by the \"profile ID\" param and stores a new catalog in the database",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Profile"
+                    "Plan Of Action and Milestones"
                 ],
-                "summary": "Resolves a Profile as a stored catalog",
+                "summary": "Create a new POAM item for a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Profile ID",
+                        "description": "POA\u0026M ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "POAM Item data",
+                        "name": "poam-item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.PoamItem"
+                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscal_ProfileHandler"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_PoamItem"
                         }
                     },
                     "400": {
@@ -11429,8 +11607,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -11444,84 +11622,49 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/profiles/{id}/resolved": {
-            "get": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
+        "/oscal/plan-of-action-and-milestones/{id}/poam-items/{itemId}": {
+            "put": {
+                "description": "Updates an existing POAM item for a given POA\u0026M.",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "Returns a resolved OSCAL catalog based on a given Profile ID, applying all imports and modifications.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Profile"
+                    "Plan Of Action and Milestones"
                 ],
-                "summary": "Get Resolved Profile",
+                "summary": "Update a POAM item for a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Profile ID",
+                        "description": "POA\u0026M ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Catalog"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
+                    {
+                        "type": "string",
+                        "description": "POAM Item ID",
+                        "name": "itemId",
+                        "in": "path",
+                        "required": true
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    {
+                        "description": "POAM Item data",
+                        "name": "poam-item",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api.Error"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.PoamItem"
                         }
                     }
-                }
-            }
-        },
-        "/oscal/roles": {
-            "get": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Retrieves all roles.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Oscal"
                 ],
-                "summary": "List roles",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Role"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_PoamItem"
                         }
                     },
                     "400": {
@@ -11530,8 +11673,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -11543,38 +11686,32 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/roles/{id}": {
-            "get": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Retrieves a single Role by its unique ID.",
-                "produces": [
-                    "application/json"
-                ],
+            },
+            "delete": {
+                "description": "Deletes an existing POAM item for a given POA\u0026M.",
                 "tags": [
-                    "Oscal"
+                    "Plan Of Action and Milestones"
                 ],
-                "summary": "Get a Role",
+                "summary": "Delete a POAM item from a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Party ID",
+                        "description": "POA\u0026M ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "POAM Item ID",
+                        "name": "itemId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Role"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -11582,12 +11719,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -11603,26 +11734,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/system-security-plans": {
+        "/oscal/plan-of-action-and-milestones/{id}/risks": {
             "get": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Retrieves all System Security Plans.",
+                "description": "Retrieves all risks for a given POA\u0026M.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
+                    "Plan Of Action and Milestones"
+                ],
+                "summary": "Get risks for a POA\u0026M",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "POA\u0026M ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "List System Security Plans",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_SystemSecurityPlan"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Risk"
                         }
                     },
                     "400": {
@@ -11631,8 +11766,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -11646,12 +11781,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Creates a System Security Plan from input.",
+                "description": "Creates a new risk for a given POA\u0026M.",
                 "consumes": [
                     "application/json"
                 ],
@@ -11659,17 +11789,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
+                    "Plan Of Action and Milestones"
                 ],
-                "summary": "Create a System Security Plan",
+                "summary": "Create a new risk for a POA\u0026M",
                 "parameters": [
                     {
-                        "description": "SSP data",
-                        "name": "ssp",
+                        "type": "string",
+                        "description": "POA\u0026M ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Risk data",
+                        "name": "risk",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.SystemSecurityPlan"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Risk"
                         }
                     }
                 ],
@@ -11677,7 +11814,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemSecurityPlan"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Risk"
                         }
                     },
                     "400": {
@@ -11686,12 +11823,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -11707,90 +11838,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/system-security-plans/{id}": {
-            "get": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
+        "/oscal/plan-of-action-and-milestones/{id}/risks/{riskId}": {
+            "put": {
+                "description": "Updates an existing risk for a given POA\u0026M.",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "Retrieves a single System Security Plan by its unique ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
+                    "Plan Of Action and Milestones"
                 ],
-                "summary": "Get a System Security Plan",
+                "summary": "Update a risk for a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "System Security Plan ID",
+                        "description": "POA\u0026M ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemSecurityPlan"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    }
-                }
-            },
-            "put": {
-                "description": "Updates an existing System Security Plan.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "System Security Plans"
-                ],
-                "summary": "Update a System Security Plan",
-                "parameters": [
                     {
                         "type": "string",
-                        "description": "SSP ID",
-                        "name": "id",
+                        "description": "Risk ID",
+                        "name": "riskId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "SSP data",
-                        "name": "ssp",
+                        "description": "Risk data",
+                        "name": "risk",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.SystemSecurityPlan"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Risk"
                         }
                     }
                 ],
@@ -11798,7 +11880,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemSecurityPlan"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Risk"
                         }
                     },
                     "400": {
@@ -11822,18 +11904,25 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Deletes an existing System Security Plan and all its related data.",
+                "description": "Deletes an existing risk for a given POA\u0026M.",
                 "tags": [
-                    "System Security Plans"
+                    "Plan Of Action and Milestones"
                 ],
-                "summary": "Delete a System Security Plan",
+                "summary": "Delete a risk from a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "SSP ID",
+                        "description": "POA\u0026M ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Risk ID",
+                        "name": "riskId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -11861,20 +11950,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/system-security-plans/{id}/back-matter": {
+        "/oscal/plan-of-action-and-milestones/{id}/system-id": {
             "get": {
-                "description": "Retrieves back-matter for a given SSP.",
+                "description": "Retrieves system-id for a given POA\u0026M.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
+                    "Plan Of Action and Milestones"
                 ],
-                "summary": "Get SSP back-matter",
+                "summary": "Get POA\u0026M system-id",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "SSP ID",
+                        "description": "POA\u0026M ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -11884,7 +11973,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemId"
                         }
                     },
                     "400": {
@@ -11908,7 +11997,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Updates back-matter for a given SSP.",
+                "description": "Updates system-id for a given POA\u0026M.",
                 "consumes": [
                     "application/json"
                 ],
@@ -11916,24 +12005,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
+                    "Plan Of Action and Milestones"
                 ],
-                "summary": "Update SSP back-matter",
+                "summary": "Update system-id for a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "SSP ID",
+                        "description": "POA\u0026M ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Back Matter data",
-                        "name": "back-matter",
+                        "description": "System ID data",
+                        "name": "systemId",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.BackMatter"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.SystemId"
                         }
                     }
                 ],
@@ -11941,7 +12030,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemId"
                         }
                     },
                     "400": {
@@ -11963,32 +12052,42 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/system-security-plans/{id}/back-matter/resources": {
-            "get": {
-                "description": "Retrieves all back-matter resources for a given SSP.",
+            },
+            "post": {
+                "description": "Creates system-id for a given POA\u0026M.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
+                    "Plan Of Action and Milestones"
                 ],
-                "summary": "Get back-matter resources for a SSP",
+                "summary": "Create system-id for a POA\u0026M",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "SSP ID",
+                        "description": "POA\u0026M ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "System ID data",
+                        "name": "systemId",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.SystemId"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Resource"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemId"
                         }
                     },
                     "400": {
@@ -12010,42 +12109,28 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Creates a new back-matter resource for a given SSP.",
-                "consumes": [
-                    "application/json"
+            }
+        },
+        "/oscal/profiles": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
                 ],
+                "description": "Retrieves all OSCAL profiles",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
-                ],
-                "summary": "Create a new back-matter resource for a SSP",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "SSP ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Resource data",
-                        "name": "resource",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Resource"
-                        }
-                    }
+                    "Profile"
                 ],
+                "summary": "List Profiles",
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Resource"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscal_ProfileHandler"
                         }
                     },
                     "400": {
@@ -12054,8 +12139,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -12067,11 +12152,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/system-security-plans/{id}/back-matter/resources/{resourceId}": {
-            "put": {
-                "description": "Updates an existing back-matter resource for a given SSP.",
+            },
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Creates a new OSCAL Profile.",
                 "consumes": [
                     "application/json"
                 ],
@@ -12079,39 +12167,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
+                    "Profile"
                 ],
-                "summary": "Update a back-matter resource for a SSP",
+                "summary": "Create a new OSCAL Profile",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "SSP ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Resource ID",
-                        "name": "resourceId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Resource data",
-                        "name": "resource",
+                        "description": "Profile object",
+                        "name": "profile",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Resource"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Profile"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Resource"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Profile"
                         }
                     },
                     "400": {
@@ -12120,8 +12194,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -12133,32 +12207,38 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes an existing back-matter resource for a given SSP.",
+            }
+        },
+        "/oscal/profiles/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Get an OSCAL profile with the uuid provided",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "System Security Plans"
+                    "Profile"
                 ],
-                "summary": "Delete a back-matter resource from a SSP",
+                "summary": "Get Profile",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "SSP ID",
+                        "description": "Profile ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Resource ID",
-                        "name": "resourceId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscal_ProfileHandler"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -12166,6 +12246,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -12181,25 +12267,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/system-security-plans/{id}/control-implementation": {
+        "/oscal/profiles/{id}/back-matter": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves the Control Implementation for a given System Security Plan.",
+                "description": "Get the BackMatter for a specific profile",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
+                    "Profile"
                 ],
-                "summary": "Get Control Implementation",
+                "summary": "Get Backmatter",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "System Security Plan ID",
+                        "description": "Profile ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -12209,7 +12295,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ControlImplementation"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
                         }
                     },
                     "400": {
@@ -12237,42 +12323,37 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Updates the Control Implementation for a given System Security Plan.",
-                "consumes": [
-                    "application/json"
+            }
+        },
+        "/oscal/profiles/{id}/full": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
                 ],
+                "description": "Retrieves the full OSCAL Profile, including all nested content.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
+                    "Profile"
                 ],
-                "summary": "Update Control Implementation",
+                "summary": "Get full Profile",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "System Security Plan ID",
+                        "description": "Profile ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Updated Control Implementation object",
-                        "name": "control-implementation",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.ControlImplementation"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ControlImplementation"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Profile"
                         }
                     },
                     "400": {
@@ -12281,6 +12362,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -12296,20 +12383,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/system-security-plans/{id}/control-implementation/implemented-requirements": {
+        "/oscal/profiles/{id}/imports": {
             "get": {
-                "description": "Retrieves all implemented requirements for a given SSP.",
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "List imports for a specific profile",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
+                    "Profile"
                 ],
-                "summary": "Get implemented requirements for a SSP",
+                "summary": "List Imports",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "SSP ID",
+                        "description": "Profile ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -12319,7 +12411,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_ImplementedRequirement"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Import"
                         }
                     },
                     "400": {
@@ -12328,8 +12420,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -12341,9 +12439,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/oscal/profiles/{id}/imports/add": {
             "post": {
-                "description": "Creates a new implemented requirement for a given SSP.",
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Adds an import to a profile by its UUID and type (catalog/profile). Only catalogs are currently supported currently",
                 "consumes": [
                     "application/json"
                 ],
@@ -12351,24 +12456,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
+                    "Profile"
                 ],
-                "summary": "Create a new implemented requirement for a SSP",
+                "summary": "Add Import to Profile",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "SSP ID",
+                        "description": "Profile ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Implemented Requirement data",
-                        "name": "requirement",
+                        "description": "Request data",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.ImplementedRequirement"
+                            "$ref": "#/definitions/oscal.ProfileHandler"
                         }
                     }
                 ],
@@ -12376,7 +12481,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ImplementedRequirement"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Import"
                         }
                     },
                     "400": {
@@ -12385,12 +12490,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -12400,49 +12517,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/system-security-plans/{id}/control-implementation/implemented-requirements/{reqId}": {
-            "put": {
-                "description": "Updates an existing implemented requirement for a given SSP.",
-                "consumes": [
-                    "application/json"
+        "/oscal/profiles/{id}/imports/{href}": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
                 ],
+                "description": "Retrieves a specific import from a profile by its backmatter href",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
+                    "Profile"
                 ],
-                "summary": "Update an implemented requirement for a SSP",
+                "summary": "Get Import from Profile by Backmatter Href",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "SSP ID",
+                        "description": "Profile UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Requirement ID",
-                        "name": "reqId",
+                        "description": "Import Href",
+                        "name": "href",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Implemented Requirement data",
-                        "name": "requirement",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.ImplementedRequirement"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ImplementedRequirement"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Import"
                         }
                     },
                     "400": {
@@ -12451,48 +12561,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    }
-                }
-            },
-            "delete": {
-                "description": "Deletes an existing implemented requirement for a given SSP.",
-                "tags": [
-                    "System Security Plans"
-                ],
-                "summary": "Delete an implemented requirement from a SSP",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "SSP ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Requirement ID",
-                        "name": "reqId",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -12510,11 +12580,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/system-security-plans/{id}/control-implementation/implemented-requirements/{reqId}/statements": {
-            "post": {
-                "description": "Creates a new statement within an implemented requirement for a given SSP.",
+            },
+            "put": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Updates an existing import in a profile by its href",
                 "consumes": [
                     "application/json"
                 ],
@@ -12522,39 +12595,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
+                    "Profile"
                 ],
-                "summary": "Create a new statement within an implemented requirement",
+                "summary": "Update Import in Profile",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "SSP ID",
+                        "description": "Profile ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Requirement ID",
-                        "name": "reqId",
+                        "description": "Import Href",
+                        "name": "href",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Statement data",
-                        "name": "statement",
+                        "description": "Import data to update",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Statement"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Import"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Statement"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Import"
                         }
                     },
                     "400": {
@@ -12563,6 +12636,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -12576,59 +12655,40 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/system-security-plans/{id}/control-implementation/implemented-requirements/{reqId}/statements/{stmtId}": {
-            "put": {
-                "description": "Updates an existing statement within an implemented requirement for a given SSP.",
-                "consumes": [
-                    "application/json"
+            },
+            "delete": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
                 ],
+                "description": "Deletes an import from a profile by its href",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
+                    "Profile"
                 ],
-                "summary": "Update a statement within an implemented requirement",
+                "summary": "Delete Import from Profile",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "SSP ID",
+                        "description": "Profile ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Requirement ID",
-                        "name": "reqId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Statement ID",
-                        "name": "stmtId",
+                        "description": "Import Href",
+                        "name": "href",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Statement data",
-                        "name": "statement",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Statement"
-                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Statement"
-                        }
+                    "204": {
+                        "description": "Import deleted successfully"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -12636,6 +12696,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -12651,20 +12717,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/system-security-plans/{id}/import-profile": {
+        "/oscal/profiles/{id}/merge": {
             "get": {
-                "description": "Retrieves import-profile for a given SSP.",
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves the merge section for a specific profile.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
+                    "Profile"
                 ],
-                "summary": "Get SSP import-profile",
+                "summary": "Get merge section",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "SSP ID",
+                        "description": "Profile ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -12674,7 +12745,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ImportProfile"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Merge"
                         }
                     },
                     "400": {
@@ -12683,6 +12754,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -12698,7 +12775,12 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Updates import-profile for a given SSP.",
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Updates the merge information for a specific profile",
                 "consumes": [
                     "application/json"
                 ],
@@ -12706,24 +12788,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
+                    "Profile"
                 ],
-                "summary": "Update SSP import-profile",
+                "summary": "Update Merge",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "SSP ID",
+                        "description": "Profile ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Import Profile data",
-                        "name": "import-profile",
+                        "description": "Merge data to update",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.ImportProfile"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Merge"
                         }
                     }
                 ],
@@ -12731,7 +12813,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ImportProfile"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Merge"
                         }
                     },
                     "400": {
@@ -12740,6 +12822,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -12755,20 +12843,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/system-security-plans/{id}/metadata": {
+        "/oscal/profiles/{id}/modify": {
             "get": {
-                "description": "Retrieves metadata for a given SSP.",
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves the modify section for a specific profile.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
+                    "Profile"
                 ],
-                "summary": "Get SSP metadata",
+                "summary": "Get modify section",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "SSP ID",
+                        "description": "Profile ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -12778,7 +12871,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Metadata"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Modify"
                         }
                     },
                     "400": {
@@ -12800,42 +12893,37 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Updates metadata for a given SSP.",
-                "consumes": [
-                    "application/json"
+            }
+        },
+        "/oscal/profiles/{id}/resolve": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
                 ],
+                "description": "Resolves a Profiled identified by the \"profile ID\" param and stores a new catalog in the database",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
+                    "Profile"
                 ],
-                "summary": "Update SSP metadata",
+                "summary": "Resolves a Profile as a stored catalog",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "SSP ID",
+                        "description": "Profile ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Metadata data",
-                        "name": "metadata",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Metadata"
-                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Metadata"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscal_ProfileHandler"
                         }
                     },
                     "400": {
@@ -12844,8 +12932,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -12859,25 +12947,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/system-security-plans/{id}/profile": {
+        "/oscal/profiles/{id}/resolved": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves the Profile attached to the specified System Security Plan.",
+                "description": "Returns a resolved OSCAL catalog based on a given Profile ID, applying all imports and modifications.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
+                    "Profile"
                 ],
-                "summary": "Get Profile for a System Security Plan",
+                "summary": "Get Resolved Profile",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "System Security Plan ID",
+                        "description": "Profile ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -12887,7 +12975,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Profile"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Catalog"
                         }
                     },
                     "400": {
@@ -12915,42 +13003,28 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Associates a given Profile with a System Security Plan.",
-                "consumes": [
-                    "application/json"
+            }
+        },
+        "/oscal/roles": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
                 ],
+                "description": "Retrieves all roles.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
-                ],
-                "summary": "Attach a Profile to a System Security Plan",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "SSP ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Profile ID to attach",
-                        "name": "profileId",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "string"
-                        }
-                    }
+                    "Oscal"
                 ],
+                "summary": "List roles",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemSecurityPlan"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Role"
                         }
                     },
                     "400": {
@@ -12959,8 +13033,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -12974,25 +13048,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/system-security-plans/{id}/system-characteristics": {
+        "/oscal/roles/{id}": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves the System Characteristics for a given System Security Plan.",
+                "description": "Retrieves a single Role by its unique ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System Security Plans"
+                    "Oscal"
                 ],
-                "summary": "Get System Characteristics",
+                "summary": "Get a Role",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "System Security Plan ID",
+                        "description": "Party ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -13002,7 +13076,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemCharacteristics"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Role"
                         }
                     },
                     "400": {
@@ -13030,14 +13104,57 @@ const docTemplate = `{
                         }
                     }
                 }
+            }
+        },
+        "/oscal/system-security-plans": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves all System Security Plans.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "List System Security Plans",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_SystemSecurityPlan"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Updates the System Characteristics for a given System Security Plan.",
+                "description": "Creates a System Security Plan from input.",
                 "consumes": [
                     "application/json"
                 ],
@@ -13047,22 +13164,15 @@ const docTemplate = `{
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Update System Characteristics",
+                "summary": "Create a System Security Plan",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "System Security Plan ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Updated System Characteristics object",
-                        "name": "characteristics",
+                        "description": "SSP data",
+                        "name": "ssp",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.SystemCharacteristics"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.SystemSecurityPlan"
                         }
                     }
                 ],
@@ -13070,7 +13180,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemCharacteristics"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemSecurityPlan"
                         }
                     },
                     "400": {
@@ -13100,21 +13210,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/system-security-plans/{id}/system-characteristics/authorization-boundary": {
+        "/oscal/system-security-plans/{id}": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves the Authorization Boundary for a given System Security Plan.",
+                "description": "Retrieves a single System Security Plan by its unique ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Get Authorization Boundary",
+                "summary": "Get a System Security Plan",
                 "parameters": [
                     {
                         "type": "string",
@@ -13128,7 +13238,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AuthorizationBoundary"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemSecurityPlan"
                         }
                     },
                     "400": {
@@ -13156,16 +13266,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/system-security-plans/{id}/system-characteristics/authorization-boundary/diagrams/{diagram}": {
+            },
             "put": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Updates a specific Diagram under the Authorization Boundary of a System Security Plan.",
+                "description": "Updates an existing System Security Plan.",
                 "consumes": [
                     "application/json"
                 ],
@@ -13175,29 +13278,22 @@ const docTemplate = `{
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Update an Authorization Boundary Diagram",
+                "summary": "Update a System Security Plan",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "System Security Plan ID",
+                        "description": "SSP ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Diagram ID",
-                        "name": "diagram",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Updated Diagram object",
-                        "name": "diagram",
+                        "description": "SSP data",
+                        "name": "ssp",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Diagram"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.SystemSecurityPlan"
                         }
                     }
                 ],
@@ -13205,7 +13301,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Diagram"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemSecurityPlan"
                         }
                     },
                     "400": {
@@ -13214,12 +13310,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -13233,38 +13323,25 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/system-security-plans/{id}/system-characteristics/data-flow": {
-            "get": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Retrieves the Data Flow for a given System Security Plan.",
-                "produces": [
-                    "application/json"
-                ],
+            },
+            "delete": {
+                "description": "Deletes an existing System Security Plan and all its related data.",
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Get Data Flow",
+                "summary": "Delete a System Security Plan",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "System Security Plan ID",
+                        "description": "SSP ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_DataFlow"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -13272,12 +13349,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -13293,14 +13364,54 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/system-security-plans/{id}/system-characteristics/data-flow/diagrams/{diagram}": {
-            "put": {
-                "security": [
+        "/oscal/system-security-plans/{id}/back-matter": {
+            "get": {
+                "description": "Retrieves back-matter for a given SSP.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "Get SSP back-matter",
+                "parameters": [
                     {
-                        "OAuth2Password": []
+                        "type": "string",
+                        "description": "SSP ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
-                "description": "Updates a specific Diagram under the Data Flow of a System Security Plan.",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates back-matter for a given SSP.",
                 "consumes": [
                     "application/json"
                 ],
@@ -13310,29 +13421,22 @@ const docTemplate = `{
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Update a Data Flow Diagram",
+                "summary": "Update SSP back-matter",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "System Security Plan ID",
+                        "description": "SSP ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Diagram ID",
-                        "name": "diagram",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Updated Diagram object",
-                        "name": "diagram",
+                        "description": "Back Matter data",
+                        "name": "back-matter",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Diagram"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.BackMatter"
                         }
                     }
                 ],
@@ -13340,7 +13444,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Diagram"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_BackMatter"
                         }
                     },
                     "400": {
@@ -13349,12 +13453,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -13370,25 +13468,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/system-security-plans/{id}/system-characteristics/network-architecture": {
+        "/oscal/system-security-plans/{id}/back-matter/resources": {
             "get": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Retrieves the Network Architecture for a given System Security Plan.",
+                "description": "Retrieves all back-matter resources for a given SSP.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Get Network Architecture",
+                "summary": "Get back-matter resources for a SSP",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "System Security Plan ID",
+                        "description": "SSP ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -13398,7 +13491,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_NetworkArchitecture"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_Resource"
                         }
                     },
                     "400": {
@@ -13407,12 +13500,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -13426,16 +13513,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/system-security-plans/{id}/system-characteristics/network-architecture/diagrams/{diagram}": {
-            "put": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Updates a specific Diagram under the Network Architecture of a System Security Plan.",
+            },
+            "post": {
+                "description": "Creates a new back-matter resource for a given SSP.",
                 "consumes": [
                     "application/json"
                 ],
@@ -13445,37 +13525,30 @@ const docTemplate = `{
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Update a Network Architecture Diagram",
+                "summary": "Create a new back-matter resource for a SSP",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "System Security Plan ID",
+                        "description": "SSP ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Diagram ID",
-                        "name": "diagram",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Updated Diagram object",
-                        "name": "diagram",
+                        "description": "Resource data",
+                        "name": "resource",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.Diagram"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Resource"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Diagram"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Resource"
                         }
                     },
                     "400": {
@@ -13484,12 +13557,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -13505,35 +13572,49 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/system-security-plans/{id}/system-implementation": {
-            "get": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
+        "/oscal/system-security-plans/{id}/back-matter/resources/{resourceId}": {
+            "put": {
+                "description": "Updates an existing back-matter resource for a given SSP.",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "Retrieves the System Implementation for a given System Security Plan.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Get System Implementation",
+                "summary": "Update a back-matter resource for a SSP",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "System Security Plan ID",
+                        "description": "SSP ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Resource ID",
+                        "name": "resourceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Resource data",
+                        "name": "resource",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Resource"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemImplementation"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Resource"
                         }
                     },
                     "400": {
@@ -13542,12 +13623,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -13562,47 +13637,31 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Updates the System Implementation for a given System Security Plan.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+            "delete": {
+                "description": "Deletes an existing back-matter resource for a given SSP.",
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Update System Implementation",
+                "summary": "Delete a back-matter resource from a SSP",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "System Security Plan ID",
+                        "description": "SSP ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated System Implementation object",
-                        "name": "system-implementation",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.SystemImplementation"
-                        }
+                        "type": "string",
+                        "description": "Resource ID",
+                        "name": "resourceId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemImplementation"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -13610,12 +13669,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -13631,21 +13684,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/system-security-plans/{id}/system-implementation/components": {
+        "/oscal/system-security-plans/{id}/control-implementation": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves components in the System Implementation for a given System Security Plan.",
+                "description": "Retrieves the Control Implementation for a given System Security Plan.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "List System Implementation Components",
+                "summary": "Get Control Implementation",
                 "parameters": [
                     {
                         "type": "string",
@@ -13659,7 +13712,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_SystemComponent"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ControlImplementation"
                         }
                     },
                     "400": {
@@ -13688,8 +13741,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "Creates a new system component for a given SSP.",
+            "put": {
+                "description": "Updates the Control Implementation for a given System Security Plan.",
                 "consumes": [
                     "application/json"
                 ],
@@ -13699,30 +13752,30 @@ const docTemplate = `{
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Create a new system component",
+                "summary": "Update Control Implementation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "SSP ID",
+                        "description": "System Security Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "System Component data",
-                        "name": "component",
-                        "in": "body",
+                        "description": "Updated Control Implementation object",
+                        "name": "control-implementation",
+                        "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.SystemComponent"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.ControlImplementation"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemComponent"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ControlImplementation"
                         }
                     },
                     "400": {
@@ -13746,42 +13799,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/system-security-plans/{id}/system-implementation/components/{componentId}": {
+        "/oscal/system-security-plans/{id}/control-implementation/implemented-requirements": {
             "get": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Retrieves component in the System Implementation for a given System Security Plan.",
+                "description": "Retrieves all implemented requirements for a given SSP.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Get System Implementation Component",
+                "summary": "Get implemented requirements for a SSP",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "System Security Plan ID",
+                        "description": "SSP ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Component ID",
-                        "name": "componentId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemComponent"
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_ImplementedRequirement"
                         }
                     },
                     "400": {
@@ -13790,12 +13831,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -13810,8 +13845,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Updates an existing system component for a given SSP.",
+            "post": {
+                "description": "Creates a new implemented requirement for a given SSP.",
                 "consumes": [
                     "application/json"
                 ],
@@ -13821,7 +13856,7 @@ const docTemplate = `{
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Update a system component",
+                "summary": "Create a new implemented requirement for a SSP",
                 "parameters": [
                     {
                         "type": "string",
@@ -13831,27 +13866,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Component ID",
-                        "name": "componentId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "System Component data",
-                        "name": "component",
+                        "description": "Implemented Requirement data",
+                        "name": "requirement",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.SystemComponent"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.ImplementedRequirement"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemComponent"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ImplementedRequirement"
                         }
                     },
                     "400": {
@@ -13873,13 +13901,21 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes an existing system component for a given SSP.",
+            }
+        },
+        "/oscal/system-security-plans/{id}/control-implementation/implemented-requirements/{reqId}": {
+            "put": {
+                "description": "Updates an existing implemented requirement for a given SSP.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Delete a system component",
+                "summary": "Update an implemented requirement for a SSP",
                 "parameters": [
                     {
                         "type": "string",
@@ -13890,15 +13926,27 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Component ID",
-                        "name": "componentId",
+                        "description": "Requirement ID",
+                        "name": "reqId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Implemented Requirement data",
+                        "name": "requirement",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.ImplementedRequirement"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ImplementedRequirement"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -13919,38 +13967,32 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/system-security-plans/{id}/system-implementation/inventory-items": {
-            "get": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
-                ],
-                "description": "Retrieves inventory items in the System Implementation for a given System Security Plan.",
-                "produces": [
-                    "application/json"
-                ],
+            },
+            "delete": {
+                "description": "Deletes an existing implemented requirement for a given SSP.",
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "List System Implementation Inventory Items",
+                "summary": "Delete an implemented requirement from a SSP",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "System Security Plan ID",
+                        "description": "SSP ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Requirement ID",
+                        "name": "reqId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_InventoryItem"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -13958,12 +14000,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -13977,9 +14013,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/oscal/system-security-plans/{id}/control-implementation/implemented-requirements/{reqId}/statements": {
             "post": {
-                "description": "Creates a new inventory item for a given SSP.",
+                "description": "Creates a new statement within an implemented requirement for a given SSP.",
                 "consumes": [
                     "application/json"
                 ],
@@ -13989,7 +14027,7 @@ const docTemplate = `{
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Create a new inventory item",
+                "summary": "Create a new statement within an implemented requirement",
                 "parameters": [
                     {
                         "type": "string",
@@ -13999,12 +14037,19 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Inventory Item data",
-                        "name": "item",
+                        "type": "string",
+                        "description": "Requirement ID",
+                        "name": "reqId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Statement data",
+                        "name": "statement",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.InventoryItem"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Statement"
                         }
                     }
                 ],
@@ -14012,7 +14057,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_InventoryItem"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Statement"
                         }
                     },
                     "400": {
@@ -14036,9 +14081,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/system-security-plans/{id}/system-implementation/inventory-items/{itemId}": {
+        "/oscal/system-security-plans/{id}/control-implementation/implemented-requirements/{reqId}/statements/{stmtId}": {
             "put": {
-                "description": "Updates an existing inventory item for a given SSP.",
+                "description": "Updates an existing statement within an implemented requirement for a given SSP.",
                 "consumes": [
                     "application/json"
                 ],
@@ -14048,7 +14093,7 @@ const docTemplate = `{
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Update an inventory item",
+                "summary": "Update a statement within an implemented requirement",
                 "parameters": [
                     {
                         "type": "string",
@@ -14059,18 +14104,25 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Item ID",
-                        "name": "itemId",
+                        "description": "Requirement ID",
+                        "name": "reqId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Inventory Item data",
-                        "name": "item",
+                        "type": "string",
+                        "description": "Statement ID",
+                        "name": "stmtId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Statement data",
+                        "name": "statement",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.InventoryItem"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Statement"
                         }
                     }
                 ],
@@ -14078,7 +14130,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_InventoryItem"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Statement"
                         }
                     },
                     "400": {
@@ -14100,13 +14152,18 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes an existing inventory item for a given SSP.",
+            }
+        },
+        "/oscal/system-security-plans/{id}/import-profile": {
+            "get": {
+                "description": "Retrieves import-profile for a given SSP.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Delete an inventory item",
+                "summary": "Get SSP import-profile",
                 "parameters": [
                     {
                         "type": "string",
@@ -14114,18 +14171,14 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Item ID",
-                        "name": "itemId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ImportProfile"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -14146,37 +14199,42 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/system-security-plans/{id}/system-implementation/leveraged-authorizations": {
-            "get": {
-                "security": [
-                    {
-                        "OAuth2Password": []
-                    }
+            },
+            "put": {
+                "description": "Updates import-profile for a given SSP.",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "Retrieves leveraged authorizations in the System Implementation for a given System Security Plan.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "List System Implementation Leveraged Authorizations",
+                "summary": "Update SSP import-profile",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "System Security Plan ID",
+                        "description": "SSP ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Import Profile data",
+                        "name": "import-profile",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.ImportProfile"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_LeveragedAuthorization"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_ImportProfile"
                         }
                     },
                     "400": {
@@ -14185,12 +14243,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api.Error"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -14204,19 +14256,18 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Creates a new leveraged authorization for a given SSP.",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/oscal/system-security-plans/{id}/metadata": {
+            "get": {
+                "description": "Retrieves metadata for a given SSP.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Create a new leveraged authorization",
+                "summary": "Get SSP metadata",
                 "parameters": [
                     {
                         "type": "string",
@@ -14224,22 +14275,13 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Leveraged Authorization data",
-                        "name": "auth",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.LeveragedAuthorization"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_LeveragedAuthorization"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Metadata"
                         }
                     },
                     "400": {
@@ -14261,11 +14303,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/oscal/system-security-plans/{id}/system-implementation/leveraged-authorizations/{authId}": {
+            },
             "put": {
-                "description": "Updates an existing leveraged authorization for a given SSP.",
+                "description": "Updates metadata for a given SSP.",
                 "consumes": [
                     "application/json"
                 ],
@@ -14275,7 +14315,7 @@ const docTemplate = `{
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Update a leveraged authorization",
+                "summary": "Update SSP metadata",
                 "parameters": [
                     {
                         "type": "string",
@@ -14285,19 +14325,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Authorization ID",
-                        "name": "authId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Leveraged Authorization data",
-                        "name": "auth",
+                        "description": "Metadata data",
+                        "name": "metadata",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.LeveragedAuthorization"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Metadata"
                         }
                     }
                 ],
@@ -14305,7 +14338,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_LeveragedAuthorization"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Metadata"
                         }
                     },
                     "400": {
@@ -14327,32 +14360,101 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes an existing leveraged authorization for a given SSP.",
+            }
+        },
+        "/oscal/system-security-plans/{id}/profile": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves the Profile attached to the specified System Security Plan.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Delete a leveraged authorization",
+                "summary": "Get Profile for a System Security Plan",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "SSP ID",
+                        "description": "System Security Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Profile"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Associates a given Profile with a System Security Plan.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "Attach a Profile to a System Security Plan",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "Authorization ID",
-                        "name": "authId",
+                        "description": "SSP ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Profile ID to attach",
+                        "name": "profileId",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemSecurityPlan"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -14375,21 +14477,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/system-security-plans/{id}/system-implementation/users": {
+        "/oscal/system-security-plans/{id}/system-characteristics": {
             "get": {
                 "security": [
                     {
                         "OAuth2Password": []
                     }
                 ],
-                "description": "Retrieves users in the System Implementation for a given System Security Plan.",
+                "description": "Retrieves the System Characteristics for a given System Security Plan.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "List System Implementation Users",
+                "summary": "Get System Characteristics",
                 "parameters": [
                     {
                         "type": "string",
@@ -14403,7 +14505,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_SystemUser"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemCharacteristics"
                         }
                     },
                     "400": {
@@ -14432,8 +14534,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "Creates a new system user for a given SSP.",
+            "put": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Updates the System Characteristics for a given System Security Plan.",
                 "consumes": [
                     "application/json"
                 ],
@@ -14443,30 +14550,30 @@ const docTemplate = `{
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Create a new system user",
+                "summary": "Update System Characteristics",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "SSP ID",
+                        "description": "System Security Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "System User data",
-                        "name": "user",
+                        "description": "Updated System Characteristics object",
+                        "name": "characteristics",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.SystemUser"
+                            "$ref": "#/definitions/oscalTypes_1_1_3.SystemCharacteristics"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemUser"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemCharacteristics"
                         }
                     },
                     "400": {
@@ -14475,6 +14582,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -14490,49 +14603,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/oscal/system-security-plans/{id}/system-implementation/users/{userId}": {
-            "put": {
-                "description": "Updates an existing system user for a given SSP.",
-                "consumes": [
-                    "application/json"
+        "/oscal/system-security-plans/{id}/system-characteristics/authorization-boundary": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
                 ],
+                "description": "Retrieves the Authorization Boundary for a given System Security Plan.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Update a system user",
+                "summary": "Get Authorization Boundary",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "SSP ID",
+                        "description": "System Security Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "userId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "System User data",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/oscalTypes_1_1_3.SystemUser"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemUser"
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_AuthorizationBoundary"
                         }
                     },
                     "400": {
@@ -14541,6 +14640,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -14554,32 +14659,57 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes an existing system user for a given SSP.",
+            }
+        },
+        "/oscal/system-security-plans/{id}/system-characteristics/authorization-boundary/diagrams/{diagram}": {
+            "put": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Updates a specific Diagram under the Authorization Boundary of a System Security Plan.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "System Security Plans"
                 ],
-                "summary": "Delete a system user",
+                "summary": "Update an Authorization Boundary Diagram",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "SSP ID",
+                        "description": "System Security Plan ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "userId",
+                        "description": "Diagram ID",
+                        "name": "diagram",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Updated Diagram object",
+                        "name": "diagram",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Diagram"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Diagram"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -14587,8 +14717,2014 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.Error"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/oscal/system-security-plans/{id}/system-characteristics/data-flow": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves the Data Flow for a given System Security Plan.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "Get Data Flow",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "System Security Plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_DataFlow"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/oscal/system-security-plans/{id}/system-characteristics/data-flow/diagrams/{diagram}": {
+            "put": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Updates a specific Diagram under the Data Flow of a System Security Plan.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "Update a Data Flow Diagram",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "System Security Plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Diagram ID",
+                        "name": "diagram",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated Diagram object",
+                        "name": "diagram",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Diagram"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Diagram"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/oscal/system-security-plans/{id}/system-characteristics/network-architecture": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves the Network Architecture for a given System Security Plan.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "Get Network Architecture",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "System Security Plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_NetworkArchitecture"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/oscal/system-security-plans/{id}/system-characteristics/network-architecture/diagrams/{diagram}": {
+            "put": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Updates a specific Diagram under the Network Architecture of a System Security Plan.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "Update a Network Architecture Diagram",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "System Security Plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Diagram ID",
+                        "name": "diagram",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated Diagram object",
+                        "name": "diagram",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.Diagram"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_Diagram"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/oscal/system-security-plans/{id}/system-implementation": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves the System Implementation for a given System Security Plan.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "Get System Implementation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "System Security Plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemImplementation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Updates the System Implementation for a given System Security Plan.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "Update System Implementation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "System Security Plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated System Implementation object",
+                        "name": "system-implementation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.SystemImplementation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemImplementation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/oscal/system-security-plans/{id}/system-implementation/components": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves components in the System Implementation for a given System Security Plan.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "List System Implementation Components",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "System Security Plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_SystemComponent"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a new system component for a given SSP.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "Create a new system component",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SSP ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "System Component data",
+                        "name": "component",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.SystemComponent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemComponent"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/oscal/system-security-plans/{id}/system-implementation/components/{componentId}": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves component in the System Implementation for a given System Security Plan.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "Get System Implementation Component",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "System Security Plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Component ID",
+                        "name": "componentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemComponent"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Updates an existing system component for a given SSP.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "Update a system component",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SSP ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Component ID",
+                        "name": "componentId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "System Component data",
+                        "name": "component",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.SystemComponent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemComponent"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes an existing system component for a given SSP.",
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "Delete a system component",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SSP ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Component ID",
+                        "name": "componentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/oscal/system-security-plans/{id}/system-implementation/inventory-items": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves inventory items in the System Implementation for a given System Security Plan.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "List System Implementation Inventory Items",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "System Security Plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_InventoryItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a new inventory item for a given SSP.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "Create a new inventory item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SSP ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Inventory Item data",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.InventoryItem"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_InventoryItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/oscal/system-security-plans/{id}/system-implementation/inventory-items/{itemId}": {
+            "put": {
+                "description": "Updates an existing inventory item for a given SSP.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "Update an inventory item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SSP ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "itemId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Inventory Item data",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.InventoryItem"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_InventoryItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes an existing inventory item for a given SSP.",
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "Delete an inventory item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SSP ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "itemId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/oscal/system-security-plans/{id}/system-implementation/leveraged-authorizations": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves leveraged authorizations in the System Implementation for a given System Security Plan.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "List System Implementation Leveraged Authorizations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "System Security Plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_LeveragedAuthorization"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a new leveraged authorization for a given SSP.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "Create a new leveraged authorization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SSP ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Leveraged Authorization data",
+                        "name": "auth",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.LeveragedAuthorization"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_LeveragedAuthorization"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/oscal/system-security-plans/{id}/system-implementation/leveraged-authorizations/{authId}": {
+            "put": {
+                "description": "Updates an existing leveraged authorization for a given SSP.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "Update a leveraged authorization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SSP ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization ID",
+                        "name": "authId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Leveraged Authorization data",
+                        "name": "auth",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.LeveragedAuthorization"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_LeveragedAuthorization"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes an existing leveraged authorization for a given SSP.",
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "Delete a leveraged authorization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SSP ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization ID",
+                        "name": "authId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/oscal/system-security-plans/{id}/system-implementation/users": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves users in the System Implementation for a given System Security Plan.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "List System Implementation Users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "System Security Plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataListResponse-oscalTypes_1_1_3_SystemUser"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a new system user for a given SSP.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "Create a new system user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SSP ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "System User data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.SystemUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemUser"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/oscal/system-security-plans/{id}/system-implementation/users/{userId}": {
+            "put": {
+                "description": "Updates an existing system user for a given SSP.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "Update a system user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SSP ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "System User data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oscalTypes_1_1_3.SystemUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-oscalTypes_1_1_3_SystemUser"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes an existing system user for a given SSP.",
+                "tags": [
+                    "System Security Plans"
+                ],
+                "summary": "Delete a system user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "SSP ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/system-grants": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Lists the grants giving users and roles access to system security plans. Users only have access to the system security plans granted to them, directly or through their roles, and to the assessment plans, assessment results and POA\u0026Ms importing them, unless they have the system:all permission.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Grants"
+                ],
+                "summary": "List system grants",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only list grants to this system security plan",
+                        "name": "systemSecurityPlanId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only list grants to this user",
+                        "name": "userId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only list grants to this role",
+                        "name": "roleName",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataListResponse-relational_SystemGrant"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Gives a user, or every user with a role, access to a system security plan. Exactly one of userId and roleName is required.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System Grants"
+                ],
+                "summary": "Create a system grant",
+                "parameters": [
+                    {
+                        "description": "System grant",
+                        "name": "grant",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SystemGrantRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-relational_SystemGrant"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/system-grants/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Deletes a system grant. Users left without grants have access to no system, unless they have the system:all permission.",
+                "tags": [
+                    "System Grants"
+                ],
+                "summary": "Delete a system grant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "System grant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Lists users by email, along with whether they are locked and how many times in a row they have failed to log in.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "List users",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only list users who are, or are not, locked",
+                        "name": "locked",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/service.ListResponse-relational_User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/unlock": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Unlocks a user locked after too many failed logins, and resets their failed logins. The unlock is recorded as an audit event.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Unlock a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-relational_User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Lists the webhook subscriptions compliance events are posted to.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List webhook subscriptions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataListResponse-relational_WebhookSubscription"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Subscribes a URL to compliance events: evidence.transitioned, poam-item.created, finding.created and profile.resolved.\nEvents are posted as JSON, signed in the X-CCF-Signature header as sha256= followed by the hex encoded HMAC-SHA256 of the X-CCF-Timestamp header, a period and the body, keyed by the secret of the subscription.\nDeliveries which fail are retried with exponential backoff. The secret is only returned by this request.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Create a webhook subscription",
+                "parameters": [
+                    {
+                        "description": "Webhook subscription",
+                        "name": "subscription",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.WebhookSubscriptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-handler_WebhookSubscriptionSecretResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Retrieves a webhook subscription by its ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get a webhook subscription",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-relational_WebhookSubscription"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Replaces a webhook subscription. Its secret is kept unless a new one is given.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Update a webhook subscription",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook subscription",
+                        "name": "subscription",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.WebhookSubscriptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-relational_WebhookSubscription"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Deletes a webhook subscription, along with its deliveries, including those not yet sent.",
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Delete a webhook subscription",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Lists the deliveries made to a webhook subscription, most recent first, with the outcome of their last attempt.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "delivered",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "Only list deliveries with this status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/service.ListResponse-relational_WebhookDelivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries/{deliveryId}/redeliver": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2Password": []
+                    }
+                ],
+                "description": "Schedules a delivery to be sent again as soon as possible, such as one which failed while its subscriber was unavailable.\nFailed deliveries get a single further attempt.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Redeliver a webhook delivery",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook delivery ID",
+                        "name": "deliveryId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GenericDataResponse-relational_WebhookDelivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api.Error"
                         }
@@ -14666,14 +16802,122 @@ const docTemplate = `{
         "datatypes.JSONType-relational_SystemComponentStatus": {
             "type": "object"
         },
-        "handler.ComplianceByControl.StatusCount": {
+        "gorm.DeletedAt": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
+                "time": {
+                    "type": "string"
+                },
+                "valid": {
+                    "description": "Valid is true if Time is not NULL",
+                    "type": "boolean"
+                }
+            }
+        },
+        "handler.AgentResponse": {
+            "type": "object",
+            "properties": {
+                "firstSeenAt": {
+                    "type": "string"
+                },
+                "hostname": {
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "object"
+                },
+                "lastSeenAt": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "plugins": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.AgentPlugin"
+                    }
                 },
                 "status": {
                     "type": "string"
+                },
+                "streamCount": {
+                    "type": "integer"
+                },
+                "streams": {
+                    "description": "Streams are only listed when a single agent is requested.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.AgentStream"
+                    }
+                },
+                "uuid": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.AgentStream": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "type": "string"
+                },
+                "href": {
+                    "description": "Href links to the history of the stream.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID is the ID of the latest evidence of the stream.",
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.AlertRuleRequest": {
+            "type": "object",
+            "required": [
+                "kind",
+                "name"
+            ],
+            "properties": {
+                "enabled": {
+                    "description": "Enabled defaults to true.",
+                    "type": "boolean"
+                },
+                "kind": {
+                    "$ref": "#/definitions/relational.AlertRuleKind"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notifiers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "target": {
+                    "description": "Target is the agent UUID, stream UUID or control ID the rule watches, depending on its kind.",
+                    "type": "string"
+                },
+                "threshold": {
+                    "description": "Threshold is a percentage of satisfied evidence, for compliance-below rules.",
+                    "type": "number"
+                },
+                "window": {
+                    "description": "Window is a duration such as 10m or 7d, for agent-offline and evidence-gap rules.",
+                    "type": "string"
                 }
             }
         },
@@ -14741,6 +16985,67 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.EvidenceAttachment": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "content": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "mediaType": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.EvidenceBatchItemResult": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "id": {
+                    "type": "string"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/handler.EvidenceBatchStatus"
+                },
+                "uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.EvidenceBatchStatus": {
+            "type": "string",
+            "enum": [
+                "created",
+                "unchanged",
+                "invalid",
+                "conflict",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "EvidenceBatchStatusCreated",
+                "EvidenceBatchStatusUnchanged",
+                "EvidenceBatchStatusInvalid",
+                "EvidenceBatchStatusConflict",
+                "EvidenceBatchStatusFailed"
+            ]
+        },
         "handler.EvidenceComponent": {
             "type": "object",
             "properties": {
@@ -14786,6 +17091,11 @@ const docTemplate = `{
         },
         "handler.EvidenceCreateRequest": {
             "type": "object",
+            "required": [
+                "end",
+                "start",
+                "uuid"
+            ],
             "properties": {
                 "activities": {
                     "description": "What steps did we take to create this evidence",
@@ -14794,6 +17104,14 @@ const docTemplate = `{
                         "$ref": "#/definitions/handler.EvidenceActivity"
                     }
                 },
+                "attachments": {
+                    "description": "Raw artifacts collected alongside the evidence.",
+                    "type": "array",
+                    "uniqueItems": true,
+                    "items": {
+                        "$ref": "#/definitions/handler.EvidenceAttachment"
+                    }
+                },
                 "components": {
                     "description": "Which components of the subject are being observed. A tool, user, policy etc.",
                     "type": "array",
@@ -14845,6 +17163,14 @@ const docTemplate = `{
                 "remarks": {
                     "type": "string"
                 },
+                "signature": {
+                    "description": "Signature of the canonical JSON of this request, made with a key registered for an agent in its origins.\nSee the provenance package for how the canonical JSON is formed.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/provenance.Signature"
+                        }
+                    ]
+                },
                 "start": {
                     "description": "When did we start collecting the evidence, and when did the process end, and how long is it valid for ?",
                     "type": "string"
@@ -14972,14 +17298,27 @@ const docTemplate = `{
             "type": "object",
             "properties": {
                 "data": {
-                    "description": "Items from the list response",
                     "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/handler.OscalLikeEvidence"
-                    }
+                    "items": {}
+                },
+                "limit": {
+                    "type": "integer"
                 },
                 "metadata": {
                     "$ref": "#/definitions/handler.ForControl.responseMetadata"
+                },
+                "nextCursor": {
+                    "description": "NextCursor fetches the following page, for lists which support cursor pagination. It is empty on the last page.",
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
                 }
             }
         },
@@ -15006,14 +17345,14 @@ const docTemplate = `{
                 }
             }
         },
-        "handler.GenericDataListResponse-handler_ComplianceByControl_StatusCount": {
+        "handler.GenericDataListResponse-handler_EvidenceBatchItemResult": {
             "type": "object",
             "properties": {
                 "data": {
                     "description": "Items from the list response",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/handler.ComplianceByControl.StatusCount"
+                        "$ref": "#/definitions/handler.EvidenceBatchItemResult"
                     }
                 }
             }
@@ -15030,26 +17369,26 @@ const docTemplate = `{
                 }
             }
         },
-        "handler.GenericDataListResponse-handler_OscalLikeEvidence": {
+        "handler.GenericDataListResponse-handler_StaleEvidenceGroup": {
             "type": "object",
             "properties": {
                 "data": {
                     "description": "Items from the list response",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/handler.OscalLikeEvidence"
+                        "$ref": "#/definitions/handler.StaleEvidenceGroup"
                     }
                 }
             }
         },
-        "handler.GenericDataListResponse-handler_OverTime_HeartbeatInterval": {
+        "handler.GenericDataListResponse-handler_StatusCount": {
             "type": "object",
             "properties": {
                 "data": {
                     "description": "Items from the list response",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/handler.OverTime.HeartbeatInterval"
+                        "$ref": "#/definitions/handler.StatusCount"
                     }
                 }
             }
@@ -15450,14 +17789,74 @@ const docTemplate = `{
                 }
             }
         },
-        "handler.GenericDataListResponse-relational_Evidence": {
+        "handler.GenericDataListResponse-relational_AlertRule": {
             "type": "object",
             "properties": {
                 "data": {
                     "description": "Items from the list response",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/relational.Evidence"
+                        "$ref": "#/definitions/relational.AlertRule"
+                    }
+                }
+            }
+        },
+        "handler.GenericDataListResponse-relational_EvidenceLabelName": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Items from the list response",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.EvidenceLabelName"
+                    }
+                }
+            }
+        },
+        "handler.GenericDataListResponse-relational_EvidenceRecovery": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Items from the list response",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.EvidenceRecovery"
+                    }
+                }
+            }
+        },
+        "handler.GenericDataListResponse-relational_SystemGrant": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Items from the list response",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.SystemGrant"
+                    }
+                }
+            }
+        },
+        "handler.GenericDataListResponse-relational_WebhookSubscription": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Items from the list response",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.WebhookSubscription"
+                    }
+                }
+            }
+        },
+        "handler.GenericDataListResponse-service_HeartbeatInterval": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Items from the list response",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/service.HeartbeatInterval"
                     }
                 }
             }
@@ -15498,14 +17897,27 @@ const docTemplate = `{
                 }
             }
         },
-        "handler.GenericDataResponse-auth_AuthHandler": {
+        "handler.GenericDataResponse-auth_AuthHandler": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Items from the list response",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/auth.AuthHandler"
+                        }
+                    ]
+                }
+            }
+        },
+        "handler.GenericDataResponse-handler_AgentResponse": {
             "type": "object",
             "properties": {
                 "data": {
                     "description": "Items from the list response",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/auth.AuthHandler"
+                            "$ref": "#/definitions/handler.AgentResponse"
                         }
                     ]
                 }
@@ -15537,6 +17949,19 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.GenericDataResponse-handler_WebhookSubscriptionSecretResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Items from the list response",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/handler.WebhookSubscriptionSecretResponse"
+                        }
+                    ]
+                }
+            }
+        },
         "handler.GenericDataResponse-oscalTypes_1_1_3_Activity": {
             "type": "object",
             "properties": {
@@ -16226,6 +18651,45 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.GenericDataResponse-relational_Alert": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Items from the list response",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/relational.Alert"
+                        }
+                    ]
+                }
+            }
+        },
+        "handler.GenericDataResponse-relational_AlertRule": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Items from the list response",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/relational.AlertRule"
+                        }
+                    ]
+                }
+            }
+        },
+        "handler.GenericDataResponse-relational_EvidenceDiff": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Items from the list response",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/relational.EvidenceDiff"
+                        }
+                    ]
+                }
+            }
+        },
         "handler.GenericDataResponse-relational_Filter": {
             "type": "object",
             "properties": {
@@ -16239,6 +18703,58 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.GenericDataResponse-relational_SystemGrant": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Items from the list response",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/relational.SystemGrant"
+                        }
+                    ]
+                }
+            }
+        },
+        "handler.GenericDataResponse-relational_User": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Items from the list response",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/relational.User"
+                        }
+                    ]
+                }
+            }
+        },
+        "handler.GenericDataResponse-relational_WebhookDelivery": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Items from the list response",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/relational.WebhookDelivery"
+                        }
+                    ]
+                }
+            }
+        },
+        "handler.GenericDataResponse-relational_WebhookSubscription": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Items from the list response",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/relational.WebhookSubscription"
+                        }
+                    ]
+                }
+            }
+        },
         "handler.HeartbeatCreateRequest": {
             "type": "object",
             "required": [
@@ -16249,8 +18765,30 @@ const docTemplate = `{
                 "created_at": {
                     "type": "string"
                 },
+                "hostname": {
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "description": "Agent details, registered in the agent registry. Details left out keep their last reported value.",
+                    "type": "string"
+                },
+                "plugins": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.AgentPlugin"
+                    }
+                },
                 "uuid": {
                     "type": "string"
+                },
+                "version": {
+                    "type": "string"
                 }
             }
         },
@@ -16263,6 +18801,13 @@ const docTemplate = `{
                         "$ref": "#/definitions/oscalTypes_1_1_3.Activity"
                     }
                 },
+                "attachments": {
+                    "description": "Raw artifacts collected alongside the evidence.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.EvidenceAttachment"
+                    }
+                },
                 "components": {
                     "type": "array",
                     "items": {
@@ -16294,6 +18839,10 @@ const docTemplate = `{
                         "$ref": "#/definitions/relational.Labels"
                     }
                 },
+                "last-seen-at": {
+                    "description": "When the same observation is made again, no new row is created. Instead, the repeat is recorded as a sighting\nand LastSeenAt is moved forward. End remains the time the observation was first made.",
+                    "type": "string"
+                },
                 "links": {
                     "type": "array",
                     "items": {
@@ -16312,9 +18861,23 @@ const docTemplate = `{
                         "$ref": "#/definitions/oscalTypes_1_1_3.Property"
                     }
                 },
+                "relevant-evidence": {
+                    "description": "RelevantEvidence references the attachments of the evidence, where they can be downloaded.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/oscalTypes_1_1_3.RelevantEvidence"
+                    }
+                },
                 "remarks": {
                     "type": "string"
                 },
+                "signing-key-id": {
+                    "type": "string"
+                },
+                "stale": {
+                    "description": "Stale is set when the evidence has expired, or has not been seen within the staleness window.",
+                    "type": "boolean"
+                },
                 "start": {
                     "description": "When did we start collecting the evidence, and when did the process end, and how long is it valid for ?",
                     "type": "string"
@@ -16334,17 +18897,63 @@ const docTemplate = `{
                 "uuid": {
                     "description": "UUID needs to remain consistent when automation runs again, but unique for each subject.\nIt represents the \"stream\" of the same observation being made over time.",
                     "type": "string"
+                },
+                "verification": {
+                    "description": "Verification records whether the evidence was signed by the agent named in its origins, and SigningKeyID the\nkey it was signed with. Evidence created before signatures were verified has no verification.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/relational.EvidenceVerification"
+                        }
+                    ]
+                }
+            }
+        },
+        "handler.StaleEvidenceGroup": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "streams": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.StaleEvidenceStream"
+                    }
+                },
+                "value": {
+                    "type": "string"
                 }
             }
         },
-        "handler.OverTime.HeartbeatInterval": {
+        "handler.StaleEvidenceStream": {
             "type": "object",
             "properties": {
-                "interval": {
+                "end": {
                     "type": "string"
                 },
-                "total": {
-                    "type": "integer"
+                "expires": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.Labels"
+                    }
+                },
+                "last-seen-at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "uuid": {
+                    "type": "string"
                 }
             }
         },
@@ -16373,6 +18982,86 @@ const docTemplate = `{
                 }
             }
         },
+        "handler.SystemGrantRequest": {
+            "type": "object",
+            "required": [
+                "systemSecurityPlanId"
+            ],
+            "properties": {
+                "roleName": {
+                    "type": "string"
+                },
+                "systemSecurityPlanId": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.WebhookSubscriptionRequest": {
+            "type": "object",
+            "required": [
+                "eventTypes",
+                "name",
+                "url"
+            ],
+            "properties": {
+                "enabled": {
+                    "description": "Enabled defaults to true.",
+                    "type": "boolean"
+                },
+                "eventTypes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.WebhookEventType"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "secret": {
+                    "description": "Secret signs deliveries. It is generated when a subscription is created without one, and kept when a\nsubscription is updated without one.",
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.WebhookSubscriptionSecretResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "description": "Enabled subscriptions receive new events. Deliveries to disabled subscriptions are held until they are enabled.",
+                    "type": "boolean"
+                },
+                "eventTypes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.WebhookEventType"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
         "handler.createFilterRequest": {
             "type": "object",
             "required": [
@@ -16397,8 +19086,12 @@ const docTemplate = `{
         "labelfilter.Condition": {
             "type": "object",
             "properties": {
+                "field": {
+                    "description": "Evidence field (e.g., \"status\", \"subject\"), defaulting to \"label\".",
+                    "type": "string"
+                },
                 "label": {
-                    "description": "Label name (e.g., \"type\", \"group\", \"app\").",
+                    "description": "Label name (e.g., \"type\", \"group\", \"app\"), or prop name for the \"prop\" field.",
                     "type": "string"
                 },
                 "operator": {
@@ -16408,6 +19101,13 @@ const docTemplate = `{
                 "value": {
                     "description": "Value for the condition (e.g., \"ssh\", \"prod\").",
                     "type": "string"
+                },
+                "values": {
+                    "description": "Values for list conditions (e.g., [\"ssh\", \"rdp\"]).",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
@@ -16423,7 +19123,7 @@ const docTemplate = `{
             "type": "object",
             "properties": {
                 "operator": {
-                    "description": "Logical operator (e.g., \"AND\", \"OR\").",
+                    "description": "Logical operator (e.g., \"AND\", \"OR\", \"NOT\").",
                     "type": "string"
                 },
                 "scopes": {
@@ -20671,11 +23371,37 @@ const docTemplate = `{
                 "remarks": {
                     "type": "string"
                 },
-                "responsible-parties": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/oscalTypes_1_1_3.ResponsibleParty"
-                    }
+                "responsible-parties": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/oscalTypes_1_1_3.ResponsibleParty"
+                    }
+                }
+            }
+        },
+        "provenance.Signature": {
+            "type": "object",
+            "properties": {
+                "algorithm": {
+                    "type": "string"
+                },
+                "key-id": {
+                    "type": "string"
+                },
+                "value": {
+                    "description": "Value is the standard base64 encoding of the signature.",
+                    "type": "string"
+                }
+            }
+        },
+        "relational.AccessRole": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
                 }
             }
         },
@@ -20809,6 +23535,112 @@ const docTemplate = `{
                 "AddressTypeHome"
             ]
         },
+        "relational.AgentPlugin": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "relational.Alert": {
+            "type": "object",
+            "properties": {
+                "firedAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "resolvedAt": {
+                    "type": "string"
+                },
+                "rule": {
+                    "$ref": "#/definitions/relational.AlertRule"
+                },
+                "ruleId": {
+                    "type": "string"
+                },
+                "state": {
+                    "$ref": "#/definitions/relational.AlertState"
+                },
+                "subject": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                }
+            }
+        },
+        "relational.AlertRule": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "description": "Enabled rules are evaluated. The alerts of disabled rules are resolved.",
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "$ref": "#/definitions/relational.AlertRuleKind"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notifiers": {
+                    "description": "Notifiers are the notifiers alerts are sent through, or every configured notifier when empty.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "target": {
+                    "type": "string"
+                },
+                "threshold": {
+                    "description": "Threshold is the lowest acceptable percentage of satisfied evidence.",
+                    "type": "number"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "window": {
+                    "description": "Window is how long an agent may go without a heartbeat, or a stream without evidence, as a duration such as 10m\nor a number of days such as 7d.",
+                    "type": "string"
+                }
+            }
+        },
+        "relational.AlertRuleKind": {
+            "type": "string",
+            "enum": [
+                "agent-offline",
+                "evidence-gap",
+                "compliance-below"
+            ],
+            "x-enum-varnames": [
+                "AlertRuleAgentOffline",
+                "AlertRuleEvidenceGap",
+                "AlertRuleComplianceBelow"
+            ]
+        },
+        "relational.AlertState": {
+            "type": "string",
+            "enum": [
+                "firing",
+                "resolved"
+            ],
+            "x-enum-varnames": [
+                "AlertStateFiring",
+                "AlertStateResolved"
+            ]
+        },
         "relational.AssessedControlsSelectControlById": {
             "type": "object",
             "properties": {
@@ -20880,6 +23712,46 @@ const docTemplate = `{
                 }
             }
         },
+        "relational.AuditEvent": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "description": "Actor is the email of the user, or the name of the command, which made the change, or AuditSystemActor.",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "details": {
+                    "type": "object"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ipAddress": {
+                    "description": "IPAddress is the address of the client whose request caused the event, if any.",
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/relational.AuditEventType"
+                },
+                "userId": {
+                    "description": "UserID is the user the event concerns, if any.",
+                    "type": "string"
+                }
+            }
+        },
+        "relational.AuditEventType": {
+            "type": "string",
+            "enum": [
+                "user.locked",
+                "user.unlocked"
+            ],
+            "x-enum-varnames": [
+                "AuditEventUserLocked",
+                "AuditEventUserUnlocked"
+            ]
+        },
         "relational.BackMatter": {
             "type": "object",
             "properties": {
@@ -21464,6 +24336,13 @@ const docTemplate = `{
                         "$ref": "#/definitions/relational.Activity"
                     }
                 },
+                "attachments": {
+                    "description": "Raw artifacts collected alongside the evidence.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.EvidenceAttachment"
+                    }
+                },
                 "components": {
                     "description": "Which components of the subject are being observed. A tool, user, policy etc.",
                     "type": "array",
@@ -21496,6 +24375,10 @@ const docTemplate = `{
                         "$ref": "#/definitions/relational.Labels"
                     }
                 },
+                "last-seen-at": {
+                    "description": "When the same observation is made again, no new row is created. Instead, the repeat is recorded as a sighting\nand LastSeenAt is moved forward. End remains the time the observation was first made.",
+                    "type": "string"
+                },
                 "links": {
                     "type": "array",
                     "items": {
@@ -21518,6 +24401,9 @@ const docTemplate = `{
                 "remarks": {
                     "type": "string"
                 },
+                "signing-key-id": {
+                    "type": "string"
+                },
                 "start": {
                     "description": "When did we start collecting the evidence, and when did the process end, and how long is it valid for ?",
                     "type": "string"
@@ -21543,9 +24429,227 @@ const docTemplate = `{
                 "uuid": {
                     "description": "UUID needs to remain consistent when automation runs again, but unique for each subject.\nIt represents the \"stream\" of the same observation being made over time.",
                     "type": "string"
+                },
+                "verification": {
+                    "description": "Verification records whether the evidence was signed by the agent named in its origins, and SigningKeyID the\nkey it was signed with. Evidence created before signatures were verified has no verification.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/relational.EvidenceVerification"
+                        }
+                    ]
+                }
+            }
+        },
+        "relational.EvidenceAttachment": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "hashes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.Hash"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "media-type": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                }
+            }
+        },
+        "relational.EvidenceDiff": {
+            "type": "object",
+            "properties": {
+                "activity-steps": {
+                    "$ref": "#/definitions/relational.EvidenceListDiff"
+                },
+                "components": {
+                    "$ref": "#/definitions/relational.EvidenceListDiff"
+                },
+                "from": {
+                    "$ref": "#/definitions/relational.EvidenceDiffVersion"
+                },
+                "inventory-items": {
+                    "$ref": "#/definitions/relational.EvidenceListDiff"
+                },
+                "labels": {
+                    "$ref": "#/definitions/relational.EvidenceListDiff"
+                },
+                "links": {
+                    "$ref": "#/definitions/relational.EvidenceListDiff"
+                },
+                "props": {
+                    "$ref": "#/definitions/relational.EvidenceListDiff"
+                },
+                "status": {
+                    "description": "Status is only set when the status changed.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/relational.EvidenceValueChange"
+                        }
+                    ]
+                },
+                "subjects": {
+                    "$ref": "#/definitions/relational.EvidenceListDiff"
+                },
+                "to": {
+                    "$ref": "#/definitions/relational.EvidenceDiffVersion"
+                },
+                "uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "relational.EvidenceDiffChange": {
+            "type": "object",
+            "properties": {
+                "from": {},
+                "key": {
+                    "type": "string"
+                },
+                "to": {}
+            }
+        },
+        "relational.EvidenceDiffVersion": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string"
+                }
+            }
+        },
+        "relational.EvidenceLabelName": {
+            "type": "object",
+            "properties": {
+                "high-cardinality": {
+                    "description": "HighCardinality is set when the label has more distinct values than is useful to filter on, such as a\nhostname label with a value for every stream.",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "streams": {
+                    "type": "integer"
+                },
+                "values": {
+                    "type": "integer"
+                }
+            }
+        },
+        "relational.EvidenceLabelValue": {
+            "type": "object",
+            "properties": {
+                "streams": {
+                    "type": "integer"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "relational.EvidenceListDiff": {
+            "type": "object",
+            "properties": {
+                "added": {
+                    "type": "array",
+                    "items": {}
+                },
+                "changed": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.EvidenceDiffChange"
+                    }
+                },
+                "removed": {
+                    "type": "array",
+                    "items": {}
+                }
+            }
+        },
+        "relational.EvidenceRecovery": {
+            "type": "object",
+            "properties": {
+                "failing-since": {
+                    "description": "FailingSince is when the stream last failed, if it has not yet recovered.",
+                    "type": "string"
+                },
+                "failures": {
+                    "type": "integer"
+                },
+                "mean-time-to-recover": {
+                    "description": "MeanTimeToRecover is the mean time, in seconds, from a stream failing to it being satisfied again.",
+                    "type": "number"
+                },
+                "recoveries": {
+                    "type": "integer"
+                },
+                "uuid": {
+                    "type": "string"
+                }
+            }
+        },
+        "relational.EvidenceTransition": {
+            "type": "object",
+            "properties": {
+                "evidence-id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "previous-evidence-id": {
+                    "type": "string"
+                },
+                "previous-state": {
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "uuid": {
+                    "description": "UUID is the evidence stream which changed state.",
+                    "type": "string"
                 }
             }
         },
+        "relational.EvidenceValueChange": {
+            "type": "object",
+            "properties": {
+                "from": {},
+                "to": {}
+            }
+        },
+        "relational.EvidenceVerification": {
+            "type": "string",
+            "enum": [
+                "verified",
+                "unsigned",
+                "invalid"
+            ],
+            "x-enum-varnames": [
+                "EvidenceVerificationVerified",
+                "EvidenceVerificationUnsigned",
+                "EvidenceVerificationInvalid"
+            ]
+        },
         "relational.Export": {
             "type": "object",
             "properties": {
@@ -22366,16 +25470,272 @@ const docTemplate = `{
                     }
                 },
                 "parentID": {
-                    "description": "Polymorphic relationship - allows ResponsibleParty to belong to different parent types",
+                    "description": "Polymorphic relationship - allows ResponsibleParty to belong to different parent types",
+                    "type": "string"
+                },
+                "parentType": {
+                    "type": "string"
+                },
+                "parties": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.ResponsiblePartyParties"
+                    }
+                },
+                "props": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.Prop"
+                    }
+                },
+                "remarks": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/relational.Role"
+                },
+                "role-id": {
+                    "description": "required",
+                    "type": "string"
+                }
+            }
+        },
+        "relational.ResponsiblePartyParties": {
+            "type": "object",
+            "properties": {
+                "partyID": {
+                    "type": "string"
+                },
+                "responsiblePartyID": {
+                    "type": "string"
+                }
+            }
+        },
+        "relational.ResponsibleRole": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.Link"
+                    }
+                },
+                "parentID": {
+                    "type": "string"
+                },
+                "parentType": {
+                    "type": "string"
+                },
+                "parties": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.Party"
+                    }
+                },
+                "props": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.Prop"
+                    }
+                },
+                "remarks": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/relational.Role"
+                },
+                "role-id": {
+                    "description": "required",
+                    "type": "string"
+                }
+            }
+        },
+        "relational.ReviewedControls": {
+            "type": "object",
+            "properties": {
+                "controlObjectiveSelections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.ControlObjectiveSelection"
+                    }
+                },
+                "controlSelections": {
+                    "description": "required",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.ControlSelection"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.Link"
+                    }
+                },
+                "props": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.Prop"
+                    }
+                },
+                "remarks": {
+                    "type": "string"
+                }
+            }
+        },
+        "relational.Revision": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "last-modified": {
+                    "type": "string"
+                },
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.Link"
+                    }
+                },
+                "metadata-id": {
+                    "description": "Revision only exist on a metadata object. We'll link them straight there with a BelongsTo relationship",
+                    "type": "string"
+                },
+                "oscal-version": {
+                    "type": "string"
+                },
+                "props": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.Prop"
+                    }
+                },
+                "published": {
+                    "type": "string"
+                },
+                "remarks": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "version": {
+                    "description": "required",
+                    "type": "string"
+                }
+            }
+        },
+        "relational.Role": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.Link"
+                    }
+                },
+                "props": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.Prop"
+                    }
+                },
+                "remarks": {
+                    "type": "string"
+                },
+                "short-name": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "relational.SatisfiedControlImplementationResponsibility": {
+            "type": "object",
+            "properties": {
+                "by-component-id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.Link"
+                    }
+                },
+                "props": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.Prop"
+                    }
+                },
+                "remarks": {
+                    "type": "string"
+                },
+                "responsibility-uuid": {
+                    "type": "string"
+                },
+                "responsible-roles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.ResponsibleRole"
+                    }
+                }
+            }
+        },
+        "relational.SelectObjectiveById": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "objective": {
+                    "description": "required",
+                    "type": "string"
+                },
+                "parentID": {
                     "type": "string"
                 },
                 "parentType": {
                     "type": "string"
+                }
+            }
+        },
+        "relational.SelectSubjectById": {
+            "type": "object",
+            "properties": {
+                "assessmentSubjectID": {
+                    "type": "string"
                 },
-                "parties": {
+                "id": {
+                    "type": "string"
+                },
+                "links": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/relational.ResponsiblePartyParties"
+                        "$ref": "#/definitions/relational.Link"
                     }
                 },
                 "props": {
@@ -22387,48 +25747,48 @@ const docTemplate = `{
                 "remarks": {
                     "type": "string"
                 },
-                "role": {
-                    "$ref": "#/definitions/relational.Role"
-                },
-                "role-id": {
-                    "description": "required",
+                "subjectUUID": {
+                    "description": "SubjectUUID technically represents a UUID of a component, party, location, user, or inventory item.\nIt will likely be updated once we can map it correctly",
                     "type": "string"
                 }
             }
         },
-        "relational.ResponsiblePartyParties": {
+        "relational.SetParameter": {
             "type": "object",
             "properties": {
-                "partyID": {
+                "param-id": {
                     "type": "string"
                 },
-                "responsiblePartyID": {
+                "remarks": {
                     "type": "string"
+                },
+                "values": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "relational.ResponsibleRole": {
+        "relational.Statement": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
-                },
-                "links": {
+                "by-components": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/relational.Link"
+                        "$ref": "#/definitions/relational.ByComponent"
                     }
                 },
-                "parentID": {
+                "id": {
                     "type": "string"
                 },
-                "parentType": {
+                "implementedRequirementId": {
                     "type": "string"
                 },
-                "parties": {
+                "links": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/relational.Party"
+                        "$ref": "#/definitions/relational.Link"
                     }
                 },
                 "props": {
@@ -22440,32 +25800,25 @@ const docTemplate = `{
                 "remarks": {
                     "type": "string"
                 },
-                "role": {
-                    "$ref": "#/definitions/relational.Role"
+                "responsible-roles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.ResponsibleRole"
+                    }
                 },
-                "role-id": {
-                    "description": "required",
+                "statement-id": {
                     "type": "string"
                 }
             }
         },
-        "relational.ReviewedControls": {
+        "relational.Step": {
             "type": "object",
             "properties": {
-                "controlObjectiveSelections": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/relational.ControlObjectiveSelection"
-                    }
-                },
-                "controlSelections": {
-                    "description": "required",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/relational.ControlSelection"
-                    }
+                "activityID": {
+                    "type": "string"
                 },
                 "description": {
+                    "description": "required",
                     "type": "string"
                 },
                 "id": {
@@ -22485,16 +25838,37 @@ const docTemplate = `{
                 },
                 "remarks": {
                     "type": "string"
+                },
+                "responsible-roles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.ResponsibleRole"
+                    }
+                },
+                "reviewed-controls": {
+                    "$ref": "#/definitions/relational.ReviewedControls"
+                },
+                "reviewedControlsID": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
                 }
             }
         },
-        "relational.Revision": {
+        "relational.SystemComponent": {
             "type": "object",
             "properties": {
-                "id": {
+                "description": {
                     "type": "string"
                 },
-                "last-modified": {
+                "evidence": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.Evidence"
+                    }
+                },
+                "id": {
                     "type": "string"
                 },
                 "links": {
@@ -22503,337 +25877,541 @@ const docTemplate = `{
                         "$ref": "#/definitions/relational.Link"
                     }
                 },
-                "metadata-id": {
-                    "description": "Revision only exist on a metadata object. We'll link them straight there with a BelongsTo relationship",
-                    "type": "string"
-                },
-                "oscal-version": {
-                    "type": "string"
-                },
                 "props": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/relational.Prop"
                     }
                 },
-                "published": {
+                "protocols": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.Protocol"
+                    }
+                },
+                "purpose": {
                     "type": "string"
                 },
                 "remarks": {
                     "type": "string"
                 },
+                "responsible-roles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.ResponsibleRole"
+                    }
+                },
+                "status": {
+                    "$ref": "#/definitions/datatypes.JSONType-relational_SystemComponentStatus"
+                },
+                "systemImplementationId": {
+                    "type": "string"
+                },
                 "title": {
                     "type": "string"
                 },
-                "version": {
-                    "description": "required",
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "relational.Role": {
+        "relational.SystemGrant": {
             "type": "object",
             "properties": {
-                "description": {
+                "createdAt": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "links": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/relational.Link"
-                    }
-                },
-                "props": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/relational.Prop"
-                    }
-                },
-                "remarks": {
+                "roleName": {
                     "type": "string"
                 },
-                "short-name": {
+                "systemSecurityPlanId": {
                     "type": "string"
                 },
-                "title": {
+                "userId": {
+                    "description": "Exactly one of UserID and RoleName is set.",
                     "type": "string"
                 }
             }
         },
-        "relational.SatisfiedControlImplementationResponsibility": {
+        "relational.TelephoneNumber": {
             "type": "object",
             "properties": {
-                "by-component-id": {
+                "number": {
                     "type": "string"
                 },
-                "description": {
+                "type": {
+                    "$ref": "#/definitions/relational.TelephoneNumberType"
+                }
+            }
+        },
+        "relational.TelephoneNumberType": {
+            "type": "string",
+            "enum": [
+                "home",
+                "office",
+                "mobile"
+            ],
+            "x-enum-varnames": [
+                "TelephoneNumberTypeHome",
+                "TelephoneNumberTypeOffice",
+                "TelephoneNumberTypeMobile"
+            ]
+        },
+        "relational.User": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "description": "Soft delete",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/gorm.DeletedAt"
+                        }
+                    ]
+                },
+                "email": {
+                    "type": "string"
+                },
+                "failedLogins": {
+                    "type": "integer"
+                },
+                "firstName": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "links": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/relational.Link"
-                    }
+                "isActive": {
+                    "type": "boolean"
                 },
-                "props": {
+                "isLocked": {
+                    "type": "boolean"
+                },
+                "lastLogin": {
+                    "type": "string"
+                },
+                "lastName": {
+                    "type": "string"
+                },
+                "lockedAt": {
+                    "description": "LockedAt is when the user was locked after too many failed logins, which the lockout duration is counted from.",
+                    "type": "string"
+                },
+                "oidcIssuer": {
+                    "description": "OIDCIssuer and OIDCSubject identify users who log in with an OpenID Connect provider.",
+                    "type": "string"
+                },
+                "oidcSubject": {
+                    "type": "string"
+                },
+                "roles": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/relational.Prop"
+                        "$ref": "#/definitions/relational.AccessRole"
                     }
                 },
-                "remarks": {
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "relational.WebhookDelivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deliveredAt": {
+                    "type": "string"
+                },
+                "eventId": {
+                    "description": "EventID is shared by the deliveries of an event to each subscription, so that subscribers can detect repeats.",
+                    "type": "string"
+                },
+                "eventType": {
+                    "$ref": "#/definitions/relational.WebhookEventType"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lastAttemptAt": {
+                    "type": "string"
+                },
+                "lastError": {
+                    "type": "string"
+                },
+                "nextAttemptAt": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "responseStatus": {
+                    "description": "ResponseStatus is the HTTP status of the response to the last attempt, if one was received.",
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/relational.WebhookDeliveryStatus"
+                },
+                "subscriptionId": {
+                    "type": "string"
+                }
+            }
+        },
+        "relational.WebhookDeliveryStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "delivered",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "WebhookDeliveryPending",
+                "WebhookDeliveryDelivered",
+                "WebhookDeliveryFailed"
+            ]
+        },
+        "relational.WebhookEventType": {
+            "type": "string",
+            "enum": [
+                "evidence.transitioned",
+                "poam-item.created",
+                "finding.created",
+                "profile.resolved"
+            ],
+            "x-enum-varnames": [
+                "WebhookEventEvidenceTransitioned",
+                "WebhookEventPoamItemCreated",
+                "WebhookEventFindingCreated",
+                "WebhookEventProfileResolved"
+            ]
+        },
+        "relational.WebhookSubscription": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
                     "type": "string"
                 },
-                "responsibility-uuid": {
-                    "type": "string"
+                "enabled": {
+                    "description": "Enabled subscriptions receive new events. Deliveries to disabled subscriptions are held until they are enabled.",
+                    "type": "boolean"
                 },
-                "responsible-roles": {
+                "eventTypes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/relational.ResponsibleRole"
+                        "$ref": "#/definitions/relational.WebhookEventType"
                     }
-                }
-            }
-        },
-        "relational.SelectObjectiveById": {
-            "type": "object",
-            "properties": {
+                },
                 "id": {
                     "type": "string"
                 },
-                "objective": {
-                    "description": "required",
+                "name": {
                     "type": "string"
                 },
-                "parentID": {
+                "updatedAt": {
                     "type": "string"
                 },
-                "parentType": {
+                "url": {
                     "type": "string"
                 }
             }
         },
-        "relational.SelectSubjectById": {
+        "service.EvidenceEvent": {
             "type": "object",
             "properties": {
-                "assessmentSubjectID": {
-                    "type": "string"
+                "evidence": {
+                    "$ref": "#/definitions/relational.Evidence"
                 },
-                "id": {
+                "previous-state": {
                     "type": "string"
                 },
-                "links": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/relational.Link"
-                    }
-                },
-                "props": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/relational.Prop"
-                    }
+                "sequence": {
+                    "description": "Sequence increases with every event published by a broadcaster, so subscribers can detect gaps.",
+                    "type": "integer"
                 },
-                "remarks": {
+                "state": {
                     "type": "string"
                 },
-                "subjectUUID": {
-                    "description": "SubjectUUID technically represents a UUID of a component, party, location, user, or inventory item.\nIt will likely be updated once we can map it correctly",
+                "time": {
                     "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/service.EvidenceEventType"
                 }
             }
         },
-        "relational.SetParameter": {
+        "service.EvidenceEventType": {
+            "type": "string",
+            "enum": [
+                "evidence.created",
+                "evidence.transitioned"
+            ],
+            "x-enum-varnames": [
+                "EvidenceCreated",
+                "EvidenceTransitioned"
+            ]
+        },
+        "service.HeartbeatInterval": {
             "type": "object",
             "properties": {
-                "param-id": {
-                    "type": "string"
-                },
-                "remarks": {
+                "interval": {
                     "type": "string"
                 },
-                "values": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "total": {
+                    "type": "integer"
                 }
             }
         },
-        "relational.Statement": {
+        "service.ListResponse-handler_AgentResponse": {
             "type": "object",
             "properties": {
-                "by-components": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/relational.ByComponent"
+                        "$ref": "#/definitions/handler.AgentResponse"
                     }
                 },
-                "id": {
-                    "type": "string"
+                "limit": {
+                    "type": "integer"
                 },
-                "implementedRequirementId": {
+                "nextCursor": {
+                    "description": "NextCursor fetches the following page, for lists which support cursor pagination. It is empty on the last page.",
                     "type": "string"
                 },
-                "links": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/relational.Link"
-                    }
+                "page": {
+                    "type": "integer"
                 },
-                "props": {
+                "total": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "service.ListResponse-handler_OscalLikeEvidence": {
+            "type": "object",
+            "properties": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/relational.Prop"
+                        "$ref": "#/definitions/handler.OscalLikeEvidence"
                     }
                 },
-                "remarks": {
+                "limit": {
+                    "type": "integer"
+                },
+                "nextCursor": {
+                    "description": "NextCursor fetches the following page, for lists which support cursor pagination. It is empty on the last page.",
                     "type": "string"
                 },
-                "responsible-roles": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/relational.ResponsibleRole"
-                    }
+                "page": {
+                    "type": "integer"
                 },
-                "statement-id": {
-                    "type": "string"
+                "total": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
                 }
             }
         },
-        "relational.Step": {
+        "service.ListResponse-relational_Alert": {
             "type": "object",
             "properties": {
-                "activityID": {
-                    "type": "string"
-                },
-                "description": {
-                    "description": "required",
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "links": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/relational.Link"
+                        "$ref": "#/definitions/relational.Alert"
                     }
                 },
-                "props": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/relational.Prop"
-                    }
+                "limit": {
+                    "type": "integer"
                 },
-                "remarks": {
+                "nextCursor": {
+                    "description": "NextCursor fetches the following page, for lists which support cursor pagination. It is empty on the last page.",
                     "type": "string"
                 },
-                "responsible-roles": {
+                "page": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "service.ListResponse-relational_AuditEvent": {
+            "type": "object",
+            "properties": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/relational.ResponsibleRole"
+                        "$ref": "#/definitions/relational.AuditEvent"
                     }
                 },
-                "reviewed-controls": {
-                    "$ref": "#/definitions/relational.ReviewedControls"
+                "limit": {
+                    "type": "integer"
                 },
-                "reviewedControlsID": {
+                "nextCursor": {
+                    "description": "NextCursor fetches the following page, for lists which support cursor pagination. It is empty on the last page.",
                     "type": "string"
                 },
-                "title": {
-                    "type": "string"
+                "page": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
                 }
             }
         },
-        "relational.SystemComponent": {
+        "service.ListResponse-relational_Evidence": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string"
-                },
-                "evidence": {
+                "data": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/relational.Evidence"
                     }
                 },
-                "id": {
+                "limit": {
+                    "type": "integer"
+                },
+                "nextCursor": {
+                    "description": "NextCursor fetches the following page, for lists which support cursor pagination. It is empty on the last page.",
                     "type": "string"
                 },
-                "links": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/relational.Link"
-                    }
+                "page": {
+                    "type": "integer"
                 },
-                "props": {
+                "total": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "service.ListResponse-relational_EvidenceLabelValue": {
+            "type": "object",
+            "properties": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/relational.Prop"
+                        "$ref": "#/definitions/relational.EvidenceLabelValue"
                     }
                 },
-                "protocols": {
+                "limit": {
+                    "type": "integer"
+                },
+                "nextCursor": {
+                    "description": "NextCursor fetches the following page, for lists which support cursor pagination. It is empty on the last page.",
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "service.ListResponse-relational_EvidenceTransition": {
+            "type": "object",
+            "properties": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/relational.Protocol"
+                        "$ref": "#/definitions/relational.EvidenceTransition"
                     }
                 },
-                "purpose": {
-                    "type": "string"
+                "limit": {
+                    "type": "integer"
                 },
-                "remarks": {
+                "nextCursor": {
+                    "description": "NextCursor fetches the following page, for lists which support cursor pagination. It is empty on the last page.",
                     "type": "string"
                 },
-                "responsible-roles": {
+                "page": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "service.ListResponse-relational_User": {
+            "type": "object",
+            "properties": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/relational.ResponsibleRole"
+                        "$ref": "#/definitions/relational.User"
                     }
                 },
-                "status": {
-                    "$ref": "#/definitions/datatypes.JSONType-relational_SystemComponentStatus"
+                "limit": {
+                    "type": "integer"
                 },
-                "systemImplementationId": {
+                "nextCursor": {
+                    "description": "NextCursor fetches the following page, for lists which support cursor pagination. It is empty on the last page.",
                     "type": "string"
                 },
-                "title": {
-                    "type": "string"
+                "page": {
+                    "type": "integer"
                 },
-                "type": {
-                    "type": "string"
+                "total": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
                 }
             }
         },
-        "relational.TelephoneNumber": {
+        "service.ListResponse-relational_WebhookDelivery": {
             "type": "object",
             "properties": {
-                "number": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/relational.WebhookDelivery"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "nextCursor": {
+                    "description": "NextCursor fetches the following page, for lists which support cursor pagination. It is empty on the last page.",
                     "type": "string"
                 },
-                "type": {
-                    "$ref": "#/definitions/relational.TelephoneNumberType"
+                "page": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
                 }
             }
-        },
-        "relational.TelephoneNumberType": {
-            "type": "string",
-            "enum": [
-                "home",
-                "office",
-                "mobile"
-            ],
-            "x-enum-varnames": [
-                "TelephoneNumberTypeHome",
-                "TelephoneNumberTypeOffice",
-                "TelephoneNumberTypeMobile"
-            ]
         }
     },
     "securityDefinitions": {
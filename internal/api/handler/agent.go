@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/api/middleware"
+	"github.com/compliance-framework/api/internal/config"
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	AgentStatusOnline  = "online"
+	AgentStatusOffline = "offline"
+)
+
+type AgentHandler struct {
+	db         *gorm.DB
+	sugar      *zap.SugaredLogger
+	config     *config.Config
+	pagination *service.PaginationConfig
+}
+
+func NewAgentHandler(sugar *zap.SugaredLogger, db *gorm.DB, config *config.Config) *AgentHandler {
+	return &AgentHandler{
+		sugar:      sugar,
+		db:         db,
+		config:     config,
+		pagination: service.NewPaginationConfig(),
+	}
+}
+
+func (h *AgentHandler) Register(api *echo.Group) {
+	read := middleware.RequirePermission(relational.PermissionEvidenceRead)
+
+	api.GET("", h.List, read)
+	api.GET("/:uuid", h.Get, read)
+}
+
+// AgentStream is an evidence stream collected by an agent.
+type AgentStream struct {
+	relational.EvidenceOriginStream
+	// Href links to the history of the stream.
+	Href string `json:"href"`
+}
+
+// AgentResponse is an agent of the registry, with its state and the evidence streams it collects.
+type AgentResponse struct {
+	relational.Agent
+	Status      string `json:"status"`
+	StreamCount int    `json:"streamCount"`
+	// Streams are only listed when a single agent is requested.
+	Streams []AgentStream `json:"streams,omitempty"`
+}
+
+// streams loads the evidence streams whose origins include each of the agents.
+func (h *AgentHandler) streams(agents []relational.Agent) (map[uuid.UUID][]AgentStream, error) {
+	streams := map[uuid.UUID][]AgentStream{}
+	if len(agents) == 0 {
+		return streams, nil
+	}
+	actors := make([]uuid.UUID, 0, len(agents))
+	for _, agent := range agents {
+		actors = append(actors, agent.UUID)
+	}
+
+	var origins []relational.EvidenceOriginStream
+	if err := relational.GetEvidenceStreamsByOriginQuery(h.db, actors).
+		Order(`l."end" DESC`).
+		Scan(&origins).Error; err != nil {
+		return nil, err
+	}
+	for _, origin := range origins {
+		streams[origin.ActorUUID] = append(streams[origin.ActorUUID], AgentStream{
+			EvidenceOriginStream: origin,
+			Href:                 fmt.Sprintf("/api/evidence/history/%s", origin.UUID),
+		})
+	}
+	return streams, nil
+}
+
+// streamCounts counts the evidence streams whose origins include each of the agents.
+func (h *AgentHandler) streamCounts(agents []relational.Agent) (map[uuid.UUID]int, error) {
+	counts := map[uuid.UUID]int{}
+	if len(agents) == 0 {
+		return counts, nil
+	}
+	actors := make([]uuid.UUID, 0, len(agents))
+	for _, agent := range agents {
+		actors = append(actors, agent.UUID)
+	}
+
+	var rows []struct {
+		ActorUUID uuid.UUID
+		Count     int
+	}
+	if err := h.db.Table("(?) AS s", relational.GetEvidenceStreamsByOriginQuery(h.db, actors)).
+		Select("s.actor_uuid, count(*) AS count").
+		Group("s.actor_uuid").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.ActorUUID] = row.Count
+	}
+	return counts, nil
+}
+
+func (h *AgentHandler) response(agent relational.Agent, streamCount int, now time.Time) AgentResponse {
+	status := AgentStatusOffline
+	if agent.IsOnline(now, h.config.AgentOfflineAfter) {
+		status = AgentStatusOnline
+	}
+	return AgentResponse{
+		Agent:       agent,
+		Status:      status,
+		StreamCount: streamCount,
+	}
+}
+
+// List godoc
+//
+//	@Summary		List agents
+//	@Description	Lists the agents known from their heartbeats, most recently seen first, with whether they are online and the number of evidence streams they collect.
+//	@Description	Agents are online when they sent a heartbeat within the configured offline threshold.
+//	@Tags			Agents
+//	@Produce		json
+//	@Param			status	query		string	false	"Only list agents which are online or offline"	Enums(online, offline)
+//	@Param			page	query		int		false	"Page number, starting at 1"
+//	@Param			limit	query		int		false	"Number of items per page"
+//	@Success		200		{object}	service.ListResponse[AgentResponse]
+//	@Failure		400		{object}	api.Error
+//	@Failure		500		{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/agents [get]
+func (h *AgentHandler) List(ctx echo.Context) error {
+	page, err := h.pagination.ParseParams(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+
+	now := time.Now()
+	query := h.db.Model(&relational.Agent{})
+	switch status := ctx.QueryParam("status"); status {
+	case "":
+	case AgentStatusOnline:
+		query = query.Where("last_seen_at >= ?", now.Add(-h.config.AgentOfflineAfter))
+	case AgentStatusOffline:
+		query = query.Where("last_seen_at < ?", now.Add(-h.config.AgentOfflineAfter))
+	default:
+		return ctx.JSON(http.StatusBadRequest, api.NewError(fmt.Errorf("unknown agent status %q, expected %s or %s", status, AgentStatusOnline, AgentStatusOffline)))
+	}
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		h.sugar.Errorw("Failed to count agents", "error", err)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+	var agents []relational.Agent
+	if err := query.
+		Order("last_seen_at DESC").
+		Order("uuid").
+		Limit(page.Limit).
+		Offset(page.Offset).
+		Find(&agents).Error; err != nil {
+		h.sugar.Errorw("Failed to list agents", "error", err)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	counts, err := h.streamCounts(agents)
+	if err != nil {
+		h.sugar.Errorw("Failed to count agent evidence streams", "error", err)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+	responses := make([]AgentResponse, 0, len(agents))
+	for _, agent := range agents {
+		responses = append(responses, h.response(agent, counts[agent.UUID], now))
+	}
+
+	return ctx.JSON(http.StatusOK, service.NewListResponse(responses, total, page.Page, page.Limit))
+}
+
+// Get godoc
+//
+//	@Summary		Get an agent
+//	@Description	Retrieves an agent known from its heartbeats, with whether it is online and the evidence streams whose origins include it.
+//	@Tags			Agents
+//	@Produce		json
+//	@Param			uuid	path		string	true	"Agent UUID"
+//	@Success		200		{object}	GenericDataResponse[AgentResponse]
+//	@Failure		400		{object}	api.Error
+//	@Failure		404		{object}	api.Error
+//	@Failure		500		{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/agents/{uuid} [get]
+func (h *AgentHandler) Get(ctx echo.Context) error {
+	id, err := uuid.Parse(ctx.Param("uuid"))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+
+	agent := relational.Agent{}
+	if err := h.db.First(&agent, "uuid = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, api.NewError(err))
+		}
+		h.sugar.Errorw("Failed to load agent", "uuid", id, "error", err)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	streams, err := h.streams([]relational.Agent{agent})
+	if err != nil {
+		h.sugar.Errorw("Failed to load agent evidence streams", "uuid", id, "error", err)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+	response := h.response(agent, len(streams[agent.UUID]), time.Now())
+	response.Streams = streams[agent.UUID]
+
+	return ctx.JSON(http.StatusOK, GenericDataResponse[AgentResponse]{Data: response})
+}
@@ -0,0 +1,158 @@
+//go:build integration
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/compliance-framework/api/internal/tests"
+	oscalTypes_1_1_3 "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+func TestAgentApi(t *testing.T) {
+	suite.Run(t, new(AgentApiIntegrationSuite))
+}
+
+type AgentApiIntegrationSuite struct {
+	tests.IntegrationTestSuite
+}
+
+func (suite *AgentApiIntegrationSuite) TestAgentRegistry() {
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	err = suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+
+	do := func(method string, path string, body any) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		reqBody, _ := json.Marshal(body)
+		req := httptest.NewRequest(method, path, bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		server.E().ServeHTTP(rec, req)
+		return rec
+	}
+
+	online := uuid.New()
+	offline := uuid.New()
+	received := time.Now()
+	// Agents are seen when their heartbeats are received, whatever the time their clocks report.
+	skewed := time.Now().Add(-time.Hour)
+	for _, heartbeat := range []HeartbeatCreateRequest{
+		{UUID: offline, CreatedAt: skewed},
+		{UUID: online, CreatedAt: skewed, Name: "aws", Version: "1.0.0"},
+		{
+			UUID:      online,
+			CreatedAt: skewed,
+			Version:   "1.1.0",
+			Hostname:  "runner-1",
+			Plugins:   []relational.AgentPlugin{{Name: "ssh", Version: "0.1.0"}},
+			Labels:    map[string]string{"env": "prod"},
+		},
+	} {
+		rec := do(http.MethodPost, "/api/agent/heartbeat", heartbeat)
+		suite.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	}
+	lastSeen := time.Now().Add(-suite.Config.AgentOfflineAfter - time.Minute)
+	suite.Require().NoError(suite.DB.Model(&relational.Agent{}).
+		Where("uuid = ?", offline).
+		Updates(map[string]any{"first_seen_at": lastSeen, "last_seen_at": lastSeen}).Error)
+
+	evidence := EvidenceCreateRequest{
+		UUID:  uuid.New(),
+		Title: "SSH password login",
+		Start: time.Now().Add(-time.Minute),
+		End:   time.Now(),
+		Origins: []oscalTypes_1_1_3.Origin{
+			{Actors: []oscalTypes_1_1_3.OriginActor{{Type: "tool", ActorUuid: online.String()}}},
+		},
+		Status: oscalTypes_1_1_3.ObjectiveStatus{State: "satisfied"},
+	}
+	rec := do(http.MethodPost, "/api/evidence", evidence)
+	suite.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+	suite.Run("Agents are listed most recently seen first", func() {
+		rec := do(http.MethodGet, "/api/agents", nil)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		response := &service.ListResponse[AgentResponse]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		suite.Require().Len(response.Data, 2)
+		suite.Equal(int64(2), response.Total)
+
+		suite.Equal(online, response.Data[0].UUID)
+		suite.Equal(AgentStatusOnline, response.Data[0].Status)
+		suite.Equal(1, response.Data[0].StreamCount)
+		suite.Empty(response.Data[0].Streams)
+		suite.Equal(offline, response.Data[1].UUID)
+		suite.Equal(AgentStatusOffline, response.Data[1].Status)
+		suite.Equal(0, response.Data[1].StreamCount)
+
+		var details struct{ Labels, Plugins string }
+		suite.Require().NoError(suite.DB.Model(&relational.Agent{}).
+			Select("labels::text AS labels, plugins::text AS plugins").
+			Where("uuid = ?", offline).
+			Scan(&details).Error)
+		suite.Equal("{}", details.Labels, "Expected agents to be registered without labels rather than null ones")
+		suite.Equal("[]", details.Plugins)
+	})
+
+	suite.Run("Agents are filtered by status", func() {
+		rec := do(http.MethodGet, "/api/agents?status=offline", nil)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		response := &service.ListResponse[AgentResponse]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		suite.Require().Len(response.Data, 1)
+		suite.Equal(offline, response.Data[0].UUID)
+
+		rec = do(http.MethodGet, "/api/agents?status=unknown", nil)
+		suite.Equal(http.StatusBadRequest, rec.Code)
+	})
+
+	suite.Run("Agent details and streams", func() {
+		rec := do(http.MethodGet, "/api/agents/"+online.String(), nil)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		response := &GenericDataResponse[AgentResponse]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		agent := response.Data
+		suite.Equal("aws", agent.Name)
+		suite.Equal("1.1.0", agent.Version)
+		suite.Equal("runner-1", agent.Hostname)
+		suite.Equal([]relational.AgentPlugin{{Name: "ssh", Version: "0.1.0"}}, []relational.AgentPlugin(agent.Plugins))
+		suite.Equal(map[string]string{"env": "prod"}, agent.Labels.Data())
+		suite.WithinDuration(received, agent.FirstSeenAt, time.Minute)
+		suite.WithinDuration(time.Now(), agent.LastSeenAt, time.Minute)
+
+		suite.Require().Len(agent.Streams, 1)
+		suite.Equal(evidence.UUID, agent.Streams[0].UUID)
+		suite.Equal("SSH password login", agent.Streams[0].Title)
+		suite.Equal("satisfied", agent.Streams[0].State)
+		suite.Equal("/api/evidence/history/"+evidence.UUID.String(), agent.Streams[0].Href)
+	})
+
+	suite.Run("Unknown agents are not found", func() {
+		suite.Equal(http.StatusNotFound, do(http.MethodGet, "/api/agents/"+uuid.New().String(), nil).Code)
+		suite.Equal(http.StatusBadRequest, do(http.MethodGet, "/api/agents/not-a-uuid", nil).Code)
+	})
+}
@@ -0,0 +1,383 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/api/middleware"
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// AlertHandler manages alert rules, and lists the alerts they fire.
+type AlertHandler struct {
+	db         *gorm.DB
+	sugar      *zap.SugaredLogger
+	pagination *service.PaginationConfig
+}
+
+func NewAlertHandler(sugar *zap.SugaredLogger, db *gorm.DB) *AlertHandler {
+	return &AlertHandler{
+		sugar:      sugar,
+		db:         db,
+		pagination: service.NewPaginationConfig(),
+	}
+}
+
+// Register registers the alert endpoints.
+func (h *AlertHandler) Register(api *echo.Group) {
+	read := middleware.RequirePermission(relational.PermissionEvidenceRead)
+	write := middleware.RequirePermission(relational.PermissionAlertWrite)
+
+	api.GET("", h.List, read)
+	api.GET("/:id", h.Get, read)
+	api.GET("/rules", h.ListRules, read)
+	api.GET("/rules/:id", h.GetRule, read)
+	api.POST("/rules", h.CreateRule, write)
+	api.PUT("/rules/:id", h.UpdateRule, write)
+	api.DELETE("/rules/:id", h.DeleteRule, write)
+}
+
+// AlertRuleRequest creates or replaces an alert rule.
+type AlertRuleRequest struct {
+	Name string                   `json:"name" validate:"required"`
+	Kind relational.AlertRuleKind `json:"kind" validate:"required"`
+	// Target is the agent UUID, stream UUID or control ID the rule watches, depending on its kind.
+	Target string `json:"target"`
+	// Window is a duration such as 10m or 7d, for agent-offline and evidence-gap rules.
+	Window string `json:"window"`
+	// Threshold is a percentage of satisfied evidence, for compliance-below rules.
+	Threshold float64  `json:"threshold"`
+	Notifiers []string `json:"notifiers"`
+	// Enabled defaults to true.
+	Enabled *bool `json:"enabled"`
+}
+
+// apply copies the request onto a rule, and validates the result.
+func (r *AlertRuleRequest) apply(rule *relational.AlertRule) error {
+	rule.Name = r.Name
+	rule.Kind = r.Kind
+	rule.Target = r.Target
+	rule.Window = r.Window
+	rule.Threshold = r.Threshold
+	rule.Notifiers = datatypes.NewJSONSlice(r.Notifiers)
+	if rule.Notifiers == nil {
+		rule.Notifiers = datatypes.NewJSONSlice([]string{})
+	}
+	rule.Enabled = r.Enabled == nil || *r.Enabled
+
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+	// Agents and streams are compared by UUID, so targets are stored as UUIDs are formatted elsewhere.
+	if rule.Kind != relational.AlertRuleComplianceBelow && rule.Target != "" {
+		rule.Target = uuid.MustParse(rule.Target).String()
+	}
+	return nil
+}
+
+// errUnknownControl is returned for compliance-below rules whose target is not the ID of a control.
+var errUnknownControl = errors.New("unknown control")
+
+// checkTarget checks the control a compliance-below rule watches exists, as the rule could never be evaluated
+// otherwise. Agents and streams may be watched before they are first seen, so their targets are not checked.
+func (h *AlertHandler) checkTarget(rule *relational.AlertRule) error {
+	if rule.Kind != relational.AlertRuleComplianceBelow {
+		return nil
+	}
+	var count int64
+	if err := h.db.Model(&relational.Control{}).Where("id = ?", rule.Target).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return fmt.Errorf("%w %q", errUnknownControl, rule.Target)
+	}
+	return nil
+}
+
+func alertTargetErrorStatus(err error) int {
+	if errors.Is(err, errUnknownControl) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// parseID reads the id path parameter as a UUID.
+func (h *AlertHandler) parseID(ctx echo.Context) (uuid.UUID, error) {
+	idParam := ctx.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.sugar.Warnw("Invalid alert id", "id", idParam, "error", err)
+	}
+	return id, err
+}
+
+// List godoc
+//
+//	@Summary		List alerts
+//	@Description	Lists alerts fired by alert rules, most recently fired first.
+//	@Tags			Alerts
+//	@Produce		json
+//	@Param			state	query		string	false	"Only list alerts which are firing or resolved"	Enums(firing, resolved)
+//	@Param			rule	query		string	false	"Only list alerts fired by this rule"
+//	@Param			page	query		int		false	"Page number, starting at 1"
+//	@Param			limit	query		int		false	"Number of items per page"
+//	@Success		200		{object}	service.ListResponse[relational.Alert]
+//	@Failure		400		{object}	api.Error
+//	@Failure		500		{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/alerts [get]
+func (h *AlertHandler) List(ctx echo.Context) error {
+	page, err := h.pagination.ParseParams(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+
+	query := h.db.Model(&relational.Alert{})
+	if state := relational.AlertState(ctx.QueryParam("state")); state != "" {
+		if !slices.Contains([]relational.AlertState{relational.AlertStateFiring, relational.AlertStateResolved}, state) {
+			return ctx.JSON(http.StatusBadRequest, api.NewError(fmt.Errorf("unknown alert state %q, expected %s or %s", state, relational.AlertStateFiring, relational.AlertStateResolved)))
+		}
+		query = query.Where("state = ?", state)
+	}
+	if rule := ctx.QueryParam("rule"); rule != "" {
+		id, err := uuid.Parse(rule)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, api.NewError(fmt.Errorf("invalid rule parameter: %w", err)))
+		}
+		query = query.Where("rule_id = ?", id)
+	}
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		h.sugar.Errorw("Failed to count alerts", "error", err)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+	alerts := []relational.Alert{}
+	if err := query.
+		Preload("Rule").
+		Order("fired_at DESC").
+		Order("id").
+		Limit(page.Limit).
+		Offset(page.Offset).
+		Find(&alerts).Error; err != nil {
+		h.sugar.Errorw("Failed to list alerts", "error", err)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	return ctx.JSON(http.StatusOK, service.NewListResponse(alerts, total, page.Page, page.Limit))
+}
+
+// Get godoc
+//
+//	@Summary		Get an alert
+//	@Description	Retrieves an alert, along with the rule which fired it.
+//	@Tags			Alerts
+//	@Produce		json
+//	@Param			id	path		string	true	"Alert ID"
+//	@Success		200	{object}	GenericDataResponse[relational.Alert]
+//	@Failure		400	{object}	api.Error
+//	@Failure		404	{object}	api.Error
+//	@Failure		500	{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/alerts/{id} [get]
+func (h *AlertHandler) Get(ctx echo.Context) error {
+	id, err := h.parseID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+
+	var alert relational.Alert
+	if err := h.db.Preload("Rule").First(&alert, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, api.NewError(err))
+		}
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	return ctx.JSON(http.StatusOK, GenericDataResponse[relational.Alert]{Data: alert})
+}
+
+// ListRules godoc
+//
+//	@Summary		List alert rules
+//	@Description	Lists the alert rules, which are evaluated periodically.
+//	@Tags			Alerts
+//	@Produce		json
+//	@Success		200	{object}	GenericDataListResponse[relational.AlertRule]
+//	@Failure		500	{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/alerts/rules [get]
+func (h *AlertHandler) ListRules(ctx echo.Context) error {
+	rules := []relational.AlertRule{}
+	if err := h.db.Order("created_at").Find(&rules).Error; err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	return ctx.JSON(http.StatusOK, GenericDataListResponse[relational.AlertRule]{Data: rules})
+}
+
+// GetRule godoc
+//
+//	@Summary		Get an alert rule
+//	@Description	Retrieves an alert rule by its ID.
+//	@Tags			Alerts
+//	@Produce		json
+//	@Param			id	path		string	true	"Alert rule ID"
+//	@Success		200	{object}	GenericDataResponse[relational.AlertRule]
+//	@Failure		400	{object}	api.Error
+//	@Failure		404	{object}	api.Error
+//	@Failure		500	{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/alerts/rules/{id} [get]
+func (h *AlertHandler) GetRule(ctx echo.Context) error {
+	id, err := h.parseID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+
+	var rule relational.AlertRule
+	if err := h.db.First(&rule, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, api.NewError(err))
+		}
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	return ctx.JSON(http.StatusOK, GenericDataResponse[relational.AlertRule]{Data: rule})
+}
+
+// CreateRule godoc
+//
+//	@Summary		Create an alert rule
+//	@Description	Creates an alert rule. agent-offline rules fire for agents without a heartbeat within the window, evidence-gap rules for streams without evidence within the window, and compliance-below rules when the percentage of satisfied evidence for a control drops below the threshold.
+//	@Tags			Alerts
+//	@Accept			json
+//	@Produce		json
+//	@Param			rule	body		AlertRuleRequest	true	"Alert rule"
+//	@Success		201		{object}	GenericDataResponse[relational.AlertRule]
+//	@Failure		400		{object}	api.Error
+//	@Failure		500		{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/alerts/rules [post]
+func (h *AlertHandler) CreateRule(ctx echo.Context) error {
+	var req AlertRuleRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+	if err := ctx.Validate(req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.Validator(err))
+	}
+
+	rule := relational.AlertRule{}
+	if err := req.apply(&rule); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+	if err := h.checkTarget(&rule); err != nil {
+		return ctx.JSON(alertTargetErrorStatus(err), api.NewError(err))
+	}
+	if err := h.db.Create(&rule).Error; err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	return ctx.JSON(http.StatusCreated, GenericDataResponse[relational.AlertRule]{Data: rule})
+}
+
+// UpdateRule godoc
+//
+//	@Summary		Update an alert rule
+//	@Description	Replaces an alert rule. Alerts it already fired are resolved at its next evaluation once their condition no longer holds, or when it is disabled.
+//	@Tags			Alerts
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string				true	"Alert rule ID"
+//	@Param			rule	body		AlertRuleRequest	true	"Alert rule"
+//	@Success		200		{object}	GenericDataResponse[relational.AlertRule]
+//	@Failure		400		{object}	api.Error
+//	@Failure		404		{object}	api.Error
+//	@Failure		500		{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/alerts/rules/{id} [put]
+func (h *AlertHandler) UpdateRule(ctx echo.Context) error {
+	id, err := h.parseID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+
+	var req AlertRuleRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+	if err := ctx.Validate(req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.Validator(err))
+	}
+
+	var rule relational.AlertRule
+	if err := h.db.First(&rule, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, api.NewError(err))
+		}
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+	if err := req.apply(&rule); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+	if err := h.checkTarget(&rule); err != nil {
+		return ctx.JSON(alertTargetErrorStatus(err), api.NewError(err))
+	}
+	if err := h.db.Save(&rule).Error; err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	return ctx.JSON(http.StatusOK, GenericDataResponse[relational.AlertRule]{Data: rule})
+}
+
+// DeleteRule godoc
+//
+//	@Summary		Delete an alert rule
+//	@Description	Deletes an alert rule, along with the alerts it fired.
+//	@Tags			Alerts
+//	@Param			id	path	string	true	"Alert rule ID"
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	api.Error
+//	@Failure		404	{object}	api.Error
+//	@Failure		500	{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/alerts/rules/{id} [delete]
+func (h *AlertHandler) DeleteRule(ctx echo.Context) error {
+	id, err := h.parseID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+
+	err = h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("rule_id = ?", id).Delete(&relational.Alert{}).Error; err != nil {
+			return err
+		}
+		result := tx.Delete(&relational.AlertRule{}, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, api.NewError(err))
+		}
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,201 @@
+//go:build integration
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/compliance-framework/api/internal/tests"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+func TestAlertApi(t *testing.T) {
+	suite.Run(t, new(AlertApiIntegrationSuite))
+}
+
+type AlertApiIntegrationSuite struct {
+	tests.IntegrationTestSuite
+}
+
+func (suite *AlertApiIntegrationSuite) TestAlerts() {
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	err = suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	read, readKey, err := relational.NewAgentCredential("read", relational.AgentCredentialScopeRead, nil)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.DB.Create(read).Error)
+
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+
+	doAs := func(key string, method string, path string, body any) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		reqBody, _ := json.Marshal(body)
+		req := httptest.NewRequest(method, path, bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", key))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		server.E().ServeHTTP(rec, req)
+		return rec
+	}
+	do := func(method string, path string, body any) *httptest.ResponseRecorder {
+		return doAs(*token, method, path, body)
+	}
+
+	agent := uuid.New()
+	var rule relational.AlertRule
+
+	suite.Run("Rules are created enabled", func() {
+		rec := do(http.MethodPost, "/api/alerts/rules", AlertRuleRequest{
+			Name:      "Agent offline",
+			Kind:      relational.AlertRuleAgentOffline,
+			Target:    agent.String(),
+			Window:    "10m",
+			Notifiers: []string{relational.AlertNotifierLog},
+		})
+		suite.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+		response := &GenericDataResponse[relational.AlertRule]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		rule = response.Data
+		suite.NotNil(rule.ID)
+		suite.True(rule.Enabled)
+		suite.Equal(agent.String(), rule.Target)
+	})
+
+	suite.Run("Windows may be a number of days", func() {
+		rec := do(http.MethodPost, "/api/alerts/rules", AlertRuleRequest{
+			Name:   "Weekly evidence",
+			Kind:   relational.AlertRuleEvidenceGap,
+			Window: "7d",
+		})
+		suite.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	})
+
+	suite.Run("Invalid rules are rejected", func() {
+		for _, req := range []AlertRuleRequest{
+			{Kind: relational.AlertRuleAgentOffline, Window: "10m"},
+			{Name: "No window", Kind: relational.AlertRuleAgentOffline},
+			{Name: "No threshold", Kind: relational.AlertRuleComplianceBelow, Target: "AC-1"},
+			{Name: "Unknown control", Kind: relational.AlertRuleComplianceBelow, Target: "XX-1", Threshold: 50},
+			{Name: "Unknown kind", Kind: "disk-full"},
+			{Name: "Unknown notifier", Kind: relational.AlertRuleEvidenceGap, Window: "1h", Notifiers: []string{"pager"}},
+		} {
+			rec := do(http.MethodPost, "/api/alerts/rules", req)
+			suite.Equal(http.StatusBadRequest, rec.Code, req.Name)
+		}
+	})
+
+	suite.Run("Agent keys cannot create rules", func() {
+		rec := doAs(readKey, http.MethodPost, "/api/alerts/rules", AlertRuleRequest{
+			Name:   "Evidence gap",
+			Kind:   relational.AlertRuleEvidenceGap,
+			Window: "1h",
+		})
+		suite.Equal(http.StatusForbidden, rec.Code)
+
+		rec = doAs(readKey, http.MethodGet, "/api/alerts/rules", nil)
+		suite.Equal(http.StatusOK, rec.Code)
+	})
+
+	suite.Run("Rules are updated", func() {
+		enabled := false
+		rec := do(http.MethodPut, "/api/alerts/rules/"+rule.ID.String(), AlertRuleRequest{
+			Name:    "Agent offline",
+			Kind:    relational.AlertRuleAgentOffline,
+			Target:  agent.String(),
+			Window:  "30m",
+			Enabled: &enabled,
+		})
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		rec = do(http.MethodGet, "/api/alerts/rules/"+rule.ID.String(), nil)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		response := &GenericDataResponse[relational.AlertRule]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		suite.Equal("30m", response.Data.Window)
+		suite.False(response.Data.Enabled)
+		suite.Empty(response.Data.Notifiers)
+
+		rec = do(http.MethodPut, "/api/alerts/rules/"+uuid.NewString(), AlertRuleRequest{
+			Name:   "Agent offline",
+			Kind:   relational.AlertRuleAgentOffline,
+			Window: "30m",
+		})
+		suite.Equal(http.StatusNotFound, rec.Code)
+	})
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	alerts := []relational.Alert{
+		{RuleID: *rule.ID, Subject: agent.String(), State: relational.AlertStateResolved, FiredAt: now.Add(-time.Hour), ResolvedAt: &now},
+		{RuleID: *rule.ID, Subject: agent.String(), State: relational.AlertStateFiring, FiredAt: now},
+	}
+	suite.Require().NoError(suite.DB.Create(&alerts).Error)
+
+	suite.Run("Alerts are listed most recently fired first", func() {
+		rec := do(http.MethodGet, "/api/alerts", nil)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		response := &service.ListResponse[relational.Alert]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		suite.Equal(int64(2), response.Total)
+		suite.Require().Len(response.Data, 2)
+		suite.Equal(relational.AlertStateFiring, response.Data[0].State)
+		suite.Require().NotNil(response.Data[0].Rule)
+		suite.Equal("Agent offline", response.Data[0].Rule.Name)
+	})
+
+	suite.Run("Alerts are filtered", func() {
+		rec := do(http.MethodGet, "/api/alerts?state=resolved&rule="+rule.ID.String(), nil)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		response := &service.ListResponse[relational.Alert]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		suite.Require().Len(response.Data, 1)
+		suite.Equal(*alerts[0].ID, *response.Data[0].ID)
+
+		rec = do(http.MethodGet, "/api/alerts?rule="+uuid.NewString(), nil)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		suite.Empty(response.Data)
+
+		suite.Equal(http.StatusBadRequest, do(http.MethodGet, "/api/alerts?state=pending", nil).Code)
+		suite.Equal(http.StatusBadRequest, do(http.MethodGet, "/api/alerts?rule=agent", nil).Code)
+	})
+
+	suite.Run("Alerts are retrieved", func() {
+		rec := do(http.MethodGet, "/api/alerts/"+alerts[1].ID.String(), nil)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		response := &GenericDataResponse[relational.Alert]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		suite.Equal(agent.String(), response.Data.Subject)
+
+		suite.Equal(http.StatusNotFound, do(http.MethodGet, "/api/alerts/"+uuid.NewString(), nil).Code)
+	})
+
+	suite.Run("Deleting a rule deletes its alerts", func() {
+		rec := do(http.MethodDelete, "/api/alerts/rules/"+rule.ID.String(), nil)
+		suite.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+
+		var count int64
+		suite.Require().NoError(suite.DB.Model(&relational.Alert{}).Count(&count).Error)
+		suite.Zero(count)
+
+		suite.Equal(http.StatusNotFound, do(http.MethodDelete, "/api/alerts/rules/"+rule.ID.String(), nil).Code)
+	})
+}
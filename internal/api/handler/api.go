@@ -2,18 +2,45 @@ package handler
 
 import (
 	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/api/middleware"
 	"github.com/compliance-framework/api/internal/config"
+	"github.com/compliance-framework/api/internal/service"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
 
 func RegisterHandlers(server *api.Server, logger *zap.SugaredLogger, db *gorm.DB, config *config.Config) {
+	authMiddleware := middleware.AgentOrUserAuthMiddleware(db, config.JWTPublicKey)
+
 	filterHandler := NewFilterHandler(logger, db)
-	filterHandler.Register(server.API().Group("/filters"))
+	filterHandler.Register(server.API().Group("/filters", authMiddleware))
 
 	heartbeatHandler := NewHeartbeatHandler(logger, db)
-	heartbeatHandler.Register(server.API().Group("/agent/heartbeat"))
+	heartbeatHandler.Register(server.API().Group("/agent/heartbeat", authMiddleware))
+
+	agentHandler := NewAgentHandler(logger, db, config)
+	agentHandler.Register(server.API().Group("/agents", authMiddleware))
+
+	alertHandler := NewAlertHandler(logger, db)
+	alertHandler.Register(server.API().Group("/alerts", authMiddleware))
+
+	webhookHandler := NewWebhookHandler(logger, db)
+	webhookHandler.Register(server.API().Group("/webhooks", authMiddleware))
+
+	systemGrantHandler := NewSystemGrantHandler(logger, db)
+	systemGrantHandler.Register(server.API().Group("/system-grants", authMiddleware))
+
+	userHandler := NewUserHandler(logger, db, config)
+	userHandler.Register(server.API().Group("/users", authMiddleware))
+
+	auditEventHandler := NewAuditEventHandler(logger, db)
+	auditEventHandler.Register(server.API().Group("/audit-events", authMiddleware))
 
-	evidenceHandler := NewEvidenceHandler(logger, db)
-	evidenceHandler.Register(server.API().Group("/evidence"))
+	evidenceBroadcaster := service.NewEvidenceBroadcaster()
+	attachmentStore, err := service.NewBlobStore(config.EvidenceAttachmentStore, config.EvidenceAttachmentPath, db)
+	if err != nil {
+		logger.Fatalw("Failed to create evidence attachment store", "error", err)
+	}
+	evidenceHandler := NewEvidenceHandler(logger, db, config, evidenceBroadcaster, attachmentStore)
+	evidenceHandler.Register(server.API().Group("/evidence", authMiddleware))
 }
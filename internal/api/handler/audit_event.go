@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/api/middleware"
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// AuditEventHandler lists the audit log.
+type AuditEventHandler struct {
+	db         *gorm.DB
+	sugar      *zap.SugaredLogger
+	pagination *service.PaginationConfig
+}
+
+func NewAuditEventHandler(sugar *zap.SugaredLogger, db *gorm.DB) *AuditEventHandler {
+	return &AuditEventHandler{
+		sugar:      sugar,
+		db:         db,
+		pagination: service.NewPaginationConfig(),
+	}
+}
+
+// Register registers the audit event endpoints.
+func (h *AuditEventHandler) Register(api *echo.Group) {
+	api.Use(middleware.RequirePermission(relational.PermissionUserManage))
+
+	api.GET("", h.List)
+}
+
+// List godoc
+//
+//	@Summary		List audit events
+//	@Description	Lists audit events, such as users being locked after too many failed logins and unlocked, most recent first.
+//	@Tags			Audit Events
+//	@Produce		json
+//	@Param			type	query		string	false	"Only list events of this type"	Enums(user.locked, user.unlocked)
+//	@Param			userId	query		string	false	"Only list events concerning this user"
+//	@Param			page	query		int		false	"Page number, starting at 1"
+//	@Param			limit	query		int		false	"Number of items per page"
+//	@Success		200		{object}	service.ListResponse[relational.AuditEvent]
+//	@Failure		400		{object}	api.Error
+//	@Failure		500		{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/audit-events [get]
+func (h *AuditEventHandler) List(ctx echo.Context) error {
+	page, err := h.pagination.ParseParams(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+
+	query := h.db.Model(&relational.AuditEvent{})
+	if eventType := relational.AuditEventType(ctx.QueryParam("type")); eventType != "" {
+		if !slices.Contains(relational.AuditEventTypes, eventType) {
+			return ctx.JSON(http.StatusBadRequest, api.NewError(fmt.Errorf("unknown audit event type %q", eventType)))
+		}
+		query = query.Where("type = ?", eventType)
+	}
+	if userID := ctx.QueryParam("userId"); userID != "" {
+		id, err := uuid.Parse(userID)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, api.NewError(fmt.Errorf("invalid userId parameter: %w", err)))
+		}
+		query = query.Where("user_id = ?", id)
+	}
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		h.sugar.Errorw("Failed to count audit events", "error", err)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+	events := []relational.AuditEvent{}
+	if err := query.
+		Order("created_at DESC").
+		Order("id").
+		Limit(page.Limit).
+		Offset(page.Offset).
+		Find(&events).Error; err != nil {
+		h.sugar.Errorw("Failed to list audit events", "error", err)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	return ctx.JSON(http.StatusOK, service.NewListResponse(events, total, page.Page, page.Limit))
+}
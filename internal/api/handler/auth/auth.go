@@ -2,44 +2,70 @@ package auth
 
 import (
 	"errors"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/compliance-framework/api/internal/api"
 	"github.com/compliance-framework/api/internal/api/handler"
 	"github.com/compliance-framework/api/internal/authn"
 	"github.com/compliance-framework/api/internal/config"
+	"github.com/compliance-framework/api/internal/service"
 	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
 
+// refreshTokenCookie holds the refresh token of browsers, which is only sent to the auth endpoints.
+const refreshTokenCookie = "ccf_refresh_token"
+
 type AuthHandler struct {
-	sugar  *zap.SugaredLogger
-	db     *gorm.DB
-	config *config.Config
+	sugar    *zap.SugaredLogger
+	db       *gorm.DB
+	config   *config.Config
+	oidc     *authn.OIDCProvider
+	sessions *service.SessionManager
+	lockout  *service.AccountLockout
+	// ipThrottle and accountThrottle limit the logins attempted from an IP address, and for an account.
+	ipThrottle      *service.LoginThrottle
+	accountThrottle *service.LoginThrottle
 }
 
 func NewAuthHandler(logger *zap.SugaredLogger, db *gorm.DB, config *config.Config) *AuthHandler {
 	return &AuthHandler{
-		sugar:  logger,
-		db:     db,
-		config: config,
+		sugar:    logger,
+		db:       db,
+		config:   config,
+		oidc:     authn.NewOIDCProvider(config),
+		sessions: service.NewSessionManager(db, config),
+		lockout:  service.NewAccountLockout(db, config),
+
+		ipThrottle:      service.NewLoginThrottle(config.LoginIPRateLimit, config.LoginRateLimitWindow),
+		accountThrottle: service.NewLoginThrottle(config.LoginAccountRateLimit, config.LoginRateLimitWindow),
 	}
 }
 
 func (h *AuthHandler) Register(api *echo.Group) {
 	api.POST("/login", h.LoginUser)
 	api.POST("/token", h.GetOAuth2Token)
+	api.POST("/refresh", h.RefreshToken)
+	api.POST("/logout", h.Logout)
 	api.GET("/publickey.pub", h.GetPublicKeyPEM)
 	api.GET("/publickey", h.GetJWK)
+	if h.oidc != nil {
+		api.GET("/oidc/login", h.OIDCLogin)
+		api.GET("/oidc/callback", h.OIDCCallback)
+	}
 }
 
 // LoginUser godoc
 //
 //	@Summary		Login user
-//	@Description	Login user and returns a JWT token and sets a cookie with the token
+//	@Description	Login user and returns a JWT token and a refresh token, and sets cookies with both
 //	@Tags			Auth
 //	@Accept			json
 //	@Produce		json
@@ -47,6 +73,7 @@ func (h *AuthHandler) Register(api *echo.Group) {
 //	@Success		200				{object}	handler.GenericDataResponse[auth.AuthHandler.LoginUser.response]
 //	@Failure		400				{object}	api.Error
 //	@Failure		401				{object}	handler.GenericDataResponse[auth.AuthHandler.LoginUser.errorResponse]
+//	@Failure		429				{object}	api.Error
 //	@Failure		500				{object}	api.Error
 //	@Router			/auth/login [post]
 func (h *AuthHandler) LoginUser(ctx echo.Context) error {
@@ -56,7 +83,8 @@ func (h *AuthHandler) LoginUser(ctx echo.Context) error {
 	}
 
 	type response struct {
-		AuthToken string `json:"auth_token"`
+		AuthToken    string `json:"auth_token"`
+		RefreshToken string `json:"refresh_token"`
 	}
 
 	type errorResponse map[string][]string
@@ -75,7 +103,11 @@ func (h *AuthHandler) LoginUser(ctx echo.Context) error {
 		},
 	}
 
-	user, unauthorized, err := h.CheckUser(loginReq.Email, loginReq.Password)
+	if allowed, retryAfter := h.throttle(ctx, loginReq.Email); !allowed {
+		return tooManyAttempts(ctx, retryAfter)
+	}
+
+	user, unauthorized, err := h.CheckUser(loginReq.Email, loginReq.Password, ctx.RealIP())
 	if err != nil {
 		if unauthorized {
 			return ctx.JSON(http.StatusUnauthorized, incorrectCredentialsValidation)
@@ -83,27 +115,49 @@ func (h *AuthHandler) LoginUser(ctx echo.Context) error {
 		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
 	}
 
-	token, err := authn.GenerateJWTToken(user, h.config.JWTPrivateKey)
+	tokens, err := h.sessions.Create(user)
 	if err != nil {
-		h.sugar.Errorw("Failed to generate JWT token", "error", err)
+		h.sugar.Errorw("Failed to create session", "error", err)
 		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
 	}
 
 	ret := response{
-		AuthToken: *token,
+		AuthToken:    tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 	}
 
+	h.setAuthCookies(ctx, tokens)
+
+	return ctx.JSON(http.StatusOK, handler.GenericDataResponse[response]{Data: ret})
+}
+
+// setAuthCookies sets the cookies browsers authenticate and refresh their session with. The refresh token is only
+// sent to the auth endpoints.
+func (h *AuthHandler) setAuthCookies(ctx echo.Context, tokens *service.SessionTokens) {
 	cookie := new(http.Cookie)
 
 	cookie.Name = "ccf_auth_token"
-	cookie.Value = *token
-	cookie.Expires = time.Now().Add(time.Hour * 24)
+	cookie.Value = tokens.AccessToken
+	cookie.Expires = time.Now().Add(h.sessions.AccessTokenTTL())
 	cookie.HttpOnly = true
 	cookie.Secure = true
 	cookie.Path = "/"
 	ctx.SetCookie(cookie)
 
-	return ctx.JSON(http.StatusOK, handler.GenericDataResponse[response]{Data: ret})
+	ctx.SetCookie(&http.Cookie{
+		Name:     refreshTokenCookie,
+		Value:    tokens.RefreshToken,
+		Expires:  time.Now().Add(h.sessions.RefreshTokenTTL()),
+		HttpOnly: true,
+		Secure:   true,
+		Path:     "/api/auth",
+	})
+}
+
+// clearAuthCookies removes the cookies set by setAuthCookies.
+func clearAuthCookies(ctx echo.Context) {
+	ctx.SetCookie(&http.Cookie{Name: "ccf_auth_token", Path: "/", MaxAge: -1, HttpOnly: true, Secure: true})
+	ctx.SetCookie(&http.Cookie{Name: refreshTokenCookie, Path: "/api/auth", MaxAge: -1, HttpOnly: true, Secure: true})
 }
 
 // GetOAuth2Token godoc
@@ -118,19 +172,25 @@ func (h *AuthHandler) LoginUser(ctx echo.Context) error {
 //	@Success		200			{object}	auth.AuthHandler.GetOAuth2Token.response
 //	@Failure		400			{object}	api.Error
 //	@Failure		401			{object}	api.Error
+//	@Failure		429			{object}	api.Error
 //	@Failure		500			{object}	api.Error
 //	@Router			/auth/token [post]
 func (h *AuthHandler) GetOAuth2Token(ctx echo.Context) error {
 	type response struct {
-		AccessToken string `json:"access_token"`
-		TokenType   string `json:"token_type"`
-		ExpiresIn   int    `json:"expires_in"`
+		AccessToken  string `json:"access_token"`
+		TokenType    string `json:"token_type"`
+		ExpiresIn    int    `json:"expires_in"`
+		RefreshToken string `json:"refresh_token"`
 	}
 
 	username := ctx.FormValue("username")
 	password := ctx.FormValue("password")
 
-	user, unauthorized, err := h.CheckUser(username, password)
+	if allowed, retryAfter := h.throttle(ctx, username); !allowed {
+		return tooManyAttempts(ctx, retryAfter)
+	}
+
+	user, unauthorized, err := h.CheckUser(username, password, ctx.RealIP())
 	if err != nil {
 		if unauthorized {
 			return ctx.JSON(http.StatusUnauthorized, api.NewError(err))
@@ -138,41 +198,185 @@ func (h *AuthHandler) GetOAuth2Token(ctx echo.Context) error {
 		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
 	}
 
-	token, err := authn.GenerateJWTToken(user, h.config.JWTPrivateKey)
+	tokens, err := h.sessions.Create(user)
 	if err != nil {
-		h.sugar.Errorw("Failed to generate JWT token", "error", err)
+		h.sugar.Errorw("Failed to create session", "error", err)
 		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
 	}
 
 	ret := &response{
-		AccessToken: *token,
-		TokenType:   "bearer",
-		ExpiresIn:   86400,
+		AccessToken:  tokens.AccessToken,
+		TokenType:    "bearer",
+		ExpiresIn:    int(h.sessions.AccessTokenTTL().Seconds()),
+		RefreshToken: tokens.RefreshToken,
 	}
 
 	return ctx.JSON(http.StatusOK, ret)
 }
 
+// RefreshToken godoc
+//
+//	@Summary		Refresh tokens
+//	@Description	Exchanges a refresh token, sent in the body or in the ccf_refresh_token cookie, for a new JWT token and a new refresh token, and sets cookies with both. The refresh token is replaced, and using it again revokes the session.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			refreshRequest	body		auth.AuthHandler.RefreshToken.refreshRequest	false	"Refresh token, unless sent as a cookie"
+//	@Success		200				{object}	handler.GenericDataResponse[auth.AuthHandler.RefreshToken.response]
+//	@Failure		400				{object}	api.Error
+//	@Failure		401				{object}	api.Error
+//	@Failure		500				{object}	api.Error
+//	@Router			/auth/refresh [post]
+func (h *AuthHandler) RefreshToken(ctx echo.Context) error {
+	type refreshRequest struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+
+	type response struct {
+		AuthToken    string `json:"auth_token"`
+		RefreshToken string `json:"refresh_token"`
+	}
+
+	var req refreshRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+	refreshToken := h.refreshTokenFromRequest(ctx, req.RefreshToken)
+	if refreshToken == "" {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(errors.New("missing refresh token")))
+	}
+
+	tokens, err := h.sessions.Refresh(refreshToken)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidRefreshToken) {
+			clearAuthCookies(ctx)
+			return ctx.JSON(http.StatusUnauthorized, api.NewError(err))
+		}
+		h.sugar.Errorw("Failed to refresh session", "error", err)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	h.setAuthCookies(ctx, tokens)
+
+	return ctx.JSON(http.StatusOK, handler.GenericDataResponse[response]{Data: response{
+		AuthToken:    tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}})
+}
+
+// Logout godoc
+//
+//	@Summary		Logout
+//	@Description	Revokes the session of the refresh token, sent in the body or in the ccf_refresh_token cookie, and of the JWT token the request is authenticated with, and clears the auth cookies. Tokens which are invalid or already revoked are ignored.
+//	@Tags			Auth
+//	@Accept			json
+//	@Param			logoutRequest	body	auth.AuthHandler.Logout.logoutRequest	false	"Refresh token, unless sent as a cookie"
+//	@Success		204				"No Content"
+//	@Failure		400				{object}	api.Error
+//	@Failure		500				{object}	api.Error
+//	@Router			/auth/logout [post]
+func (h *AuthHandler) Logout(ctx echo.Context) error {
+	type logoutRequest struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+
+	var req logoutRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+
+	if refreshToken := h.refreshTokenFromRequest(ctx, req.RefreshToken); refreshToken != "" {
+		session, err := h.sessions.FindByRefreshToken(refreshToken)
+		if err == nil {
+			err = h.sessions.Revoke(*session.ID)
+		}
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			h.sugar.Errorw("Failed to revoke session", "error", err)
+			return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+		}
+	}
+
+	if claims, err := authn.VerifyJWTToken(accessTokenFromRequest(ctx), h.config.JWTPublicKey); err == nil {
+		if err := h.revokeAccessToken(claims); err != nil {
+			h.sugar.Errorw("Failed to revoke token", "error", err)
+			return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+		}
+	}
+
+	clearAuthCookies(ctx)
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// revokeAccessToken revokes the session of the token, or only the token when it was not issued for a session.
+func (h *AuthHandler) revokeAccessToken(claims *authn.UserClaims) error {
+	if sessionID, err := uuid.Parse(claims.SessionID); err == nil {
+		return h.sessions.Revoke(sessionID)
+	}
+	return h.sessions.RevokeToken(claims)
+}
+
+// refreshTokenFromRequest returns the refresh token sent in the body of a request, or else in its cookie.
+func (h *AuthHandler) refreshTokenFromRequest(ctx echo.Context, token string) string {
+	if token != "" {
+		return token
+	}
+	if cookie, err := ctx.Cookie(refreshTokenCookie); err == nil {
+		return cookie.Value
+	}
+	return ""
+}
+
+// accessTokenFromRequest returns the JWT token a request is authenticated with, as JWTMiddleware finds it.
+func accessTokenFromRequest(ctx echo.Context) string {
+	if cookie, err := ctx.Cookie("ccf_auth_token"); err == nil {
+		return cookie.Value
+	}
+	token, _ := strings.CutPrefix(ctx.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+	return token
+}
+
+// throttle records a login attempted for the account from the IP address of the request, and reports whether it is
+// within the rate limits of both. Otherwise it returns how long until another attempt will be allowed.
+func (h *AuthHandler) throttle(ctx echo.Context, username string) (bool, time.Duration) {
+	now := time.Now()
+	if allowed, retryAfter := h.ipThrottle.Allow(ctx.RealIP(), now); !allowed {
+		h.sugar.Warnw("Login attempts from IP address throttled", "ip", ctx.RealIP())
+		return false, retryAfter
+	}
+	if allowed, retryAfter := h.accountThrottle.Allow(strings.ToLower(username), now); !allowed {
+		h.sugar.Warnw("Login attempts for account throttled", "username", username, "ip", ctx.RealIP())
+		return false, retryAfter
+	}
+	return true, 0
+}
+
+// tooManyAttempts rejects a throttled login, telling the client when to try again.
+func tooManyAttempts(ctx echo.Context, retryAfter time.Duration) error {
+	ctx.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	return ctx.JSON(http.StatusTooManyRequests, api.NewError(errors.New("too many login attempts, try again later")))
+}
+
 // CheckUser verifies a user's credentials.
 //
-// It looks up the user by email (username) in the database. If the user is not found,
-// it returns (nil, true, error) where the error is a generic invalid credentials error and
-// the boolean indicates unauthorized access. If a database error occurs, it returns (nil, false, error).
-// If the user is found but the password does not match, it returns (nil, true, error) with the same
-// invalid credentials error. If the credentials are valid, it returns the user, false, and nil error.
+// It looks up the user by email (username) in the database. If the user is not found, is locked, or the password
+// does not match, it returns (nil, true, error) where the error is a generic invalid credentials error and the
+// boolean indicates unauthorized access. Passwords are not checked while a user is locked, and failing to match
+// counts towards locking the user, who is unlocked once the lockout duration has passed. If a database error occurs,
+// it returns (nil, false, error). If the credentials are valid, it returns the user, false, and nil error.
 //
 // Parameters:
 //   - username: the user's email address
 //   - password: the user's password
+//   - ip: the IP address the login is attempted from, which is recorded when it locks the user
 //
 // Returns:
 //   - *[relational.User]: the user object if credentials are valid, otherwise nil
 //   - bool: true if unauthorized (invalid credentials), false otherwise
 //   - error: error if any occurred, or nil
-func (h *AuthHandler) CheckUser(username, password string) (*relational.User, bool, error) {
+func (h *AuthHandler) CheckUser(username, password, ip string) (*relational.User, bool, error) {
 	var user relational.User
 	invalidError := errors.New("invalid email or password")
-	if err := h.db.Where("email = ?", username).First(&user).Error; err != nil {
+	if err := h.db.Preload("Roles.Permissions").Where("lower(email) = lower(?)", username).First(&user).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			h.sugar.Warnw("User not found", "username", username)
 			return nil, true, invalidError
@@ -181,11 +385,40 @@ func (h *AuthHandler) CheckUser(username, password string) (*relational.User, bo
 		return nil, false, err
 	}
 
+	now := time.Now()
+	locked, err := h.lockout.IsLocked(&user, now)
+	if err != nil {
+		h.sugar.Errorw("Failed to unlock user", "error", err)
+		return nil, false, err
+	}
+	if locked {
+		h.sugar.Warnw("Login attempt by locked user", "username", username, "ip", ip)
+		return nil, true, invalidError
+	}
+
 	if !user.CheckPassword(password) {
-		h.sugar.Warnw("Invalid password attempt", "username", username)
+		h.sugar.Warnw("Invalid password attempt", "username", username, "ip", ip)
+		locked, err := h.lockout.RecordFailure(&user, ip, now)
+		if err != nil {
+			h.sugar.Errorw("Failed to record failed login", "error", err)
+			return nil, false, err
+		}
+		if locked {
+			h.sugar.Warnw("User locked after too many failed logins", "username", username, "failedLogins", user.FailedLogins)
+		}
+		return nil, true, invalidError
+	}
+
+	if !user.IsActive {
+		h.sugar.Warnw("Login attempt by inactive user", "username", username)
 		return nil, true, invalidError
 	}
 
+	if err := h.lockout.RecordSuccess(&user, now); err != nil {
+		h.sugar.Errorw("Failed to record login", "error", err)
+		return nil, false, err
+	}
+
 	return &user, false, nil
 }
 
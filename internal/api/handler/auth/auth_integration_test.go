@@ -8,12 +8,19 @@ import (
 	"encoding/json"
 	"encoding/pem"
 	"fmt"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/authn"
+	"github.com/compliance-framework/api/internal/config"
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/service/relational"
 	"github.com/compliance-framework/api/internal/tests"
+	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
 )
@@ -30,7 +37,8 @@ type AuthAPIIntegrationSuite struct {
 
 type loginResponse struct {
 	Data struct {
-		AuthToken string `json:"auth_token"`
+		AuthToken    string `json:"auth_token"`
+		RefreshToken string `json:"refresh_token"`
 	} `json:"data"`
 }
 
@@ -112,3 +120,251 @@ func (suite *AuthAPIIntegrationSuite) TestPublicKeyEndpoint() {
 	respKey, _ := pem.Decode(rec.Body.Bytes())
 	suite.Require().NotNil(respKey, "Expected PEM-encoded public key in response")
 }
+
+func (suite *AuthAPIIntegrationSuite) login() loginResponse {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/login", bytes.NewReader([]byte(`{"email":"test@example.com","password":"Pa55w0rd"}`)))
+	req.Header.Set("Content-Type", "application/json")
+	suite.server.E().ServeHTTP(rec, req)
+	suite.Require().Equal(http.StatusOK, rec.Code)
+
+	var resp loginResponse
+	suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	suite.Require().NotEmpty(resp.Data.RefreshToken)
+	return resp
+}
+
+func (suite *AuthAPIIntegrationSuite) refresh(refreshToken string) (int, loginResponse) {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/refresh", bytes.NewReader([]byte(fmt.Sprintf(`{"refresh_token":%q}`, refreshToken))))
+	req.Header.Set("Content-Type", "application/json")
+	suite.server.E().ServeHTTP(rec, req)
+
+	var resp loginResponse
+	if rec.Code == http.StatusOK {
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	}
+	return rec.Code, resp
+}
+
+func (suite *AuthAPIIntegrationSuite) isRevoked(token string) bool {
+	claims, err := authn.VerifyJWTToken(token, suite.Config.JWTPublicKey)
+	suite.Require().NoError(err)
+	revoked, err := service.IsTokenRevoked(suite.DB, claims)
+	suite.Require().NoError(err)
+	return revoked
+}
+
+func (suite *AuthAPIIntegrationSuite) TestRefreshRotatesRefreshToken() {
+	suite.Require().NoError(suite.IntegrationTestSuite.Migrator.Refresh())
+
+	login := suite.login()
+	claims, err := authn.VerifyJWTToken(login.Data.AuthToken, suite.Config.JWTPublicKey)
+	suite.Require().NoError(err)
+	suite.NotEmpty(claims.ID, "Expected the token to have an ID")
+	suite.NotEmpty(claims.SessionID, "Expected the token to be issued for a session")
+
+	code, refreshed := suite.refresh(login.Data.RefreshToken)
+	suite.Require().Equal(http.StatusOK, code)
+	suite.NotEqual(login.Data.RefreshToken, refreshed.Data.RefreshToken, "Expected the refresh token to be rotated")
+
+	refreshedClaims, err := authn.VerifyJWTToken(refreshed.Data.AuthToken, suite.Config.JWTPublicKey)
+	suite.Require().NoError(err)
+	suite.Equal(claims.SessionID, refreshedClaims.SessionID)
+
+	code, _ = suite.refresh(refreshed.Data.RefreshToken)
+	suite.Equal(http.StatusOK, code, "Expected the new refresh token to be usable")
+}
+
+func (suite *AuthAPIIntegrationSuite) TestRefreshTokenReuseRevokesSession() {
+	suite.Require().NoError(suite.IntegrationTestSuite.Migrator.Refresh())
+
+	login := suite.login()
+	code, refreshed := suite.refresh(login.Data.RefreshToken)
+	suite.Require().Equal(http.StatusOK, code)
+
+	code, _ = suite.refresh(login.Data.RefreshToken)
+	suite.Equal(http.StatusUnauthorized, code, "Expected the replaced refresh token to be rejected")
+
+	code, _ = suite.refresh(refreshed.Data.RefreshToken)
+	suite.Equal(http.StatusUnauthorized, code, "Expected the session to be revoked")
+	suite.True(suite.isRevoked(refreshed.Data.AuthToken), "Expected the access tokens of the session to be revoked")
+}
+
+func (suite *AuthAPIIntegrationSuite) TestRefreshInvalidToken() {
+	suite.Require().NoError(suite.IntegrationTestSuite.Migrator.Refresh())
+
+	code, _ := suite.refresh("unknown")
+	suite.Equal(http.StatusUnauthorized, code)
+}
+
+func (suite *AuthAPIIntegrationSuite) TestLogout() {
+	suite.Require().NoError(suite.IntegrationTestSuite.Migrator.Refresh())
+
+	login := suite.login()
+	suite.False(suite.isRevoked(login.Data.AuthToken))
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/logout", bytes.NewReader([]byte(fmt.Sprintf(`{"refresh_token":%q}`, login.Data.RefreshToken))))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+login.Data.AuthToken)
+	suite.server.E().ServeHTTP(rec, req)
+	suite.Require().Equal(http.StatusNoContent, rec.Code)
+
+	for _, cookie := range rec.Result().Cookies() {
+		suite.Empty(cookie.Value, "Expected %s cookie to be cleared", cookie.Name)
+	}
+	suite.True(suite.isRevoked(login.Data.AuthToken), "Expected the access token to be revoked")
+
+	code, _ := suite.refresh(login.Data.RefreshToken)
+	suite.Equal(http.StatusUnauthorized, code, "Expected the refresh token to be revoked")
+}
+
+func (suite *AuthAPIIntegrationSuite) TestRefreshDeactivatedUser() {
+	suite.Require().NoError(suite.IntegrationTestSuite.Migrator.Refresh())
+
+	login := suite.login()
+	suite.Require().NoError(suite.DB.Exec("UPDATE ccf_users SET is_active = false WHERE email = ?", "test@example.com").Error)
+
+	code, _ := suite.refresh(login.Data.RefreshToken)
+	suite.Equal(http.StatusUnauthorized, code, "Expected deactivated users not to be able to refresh their session")
+	suite.True(suite.isRevoked(login.Data.AuthToken))
+}
+
+// newServer returns a server whose config has been changed, such as to lock accounts or throttle logins.
+func (suite *AuthAPIIntegrationSuite) newServer(configure func(cfg *config.Config)) *api.Server {
+	cfg := *suite.Config
+	configure(&cfg)
+	server := api.NewServer(context.Background(), suite.logger, &cfg)
+	RegisterHandlers(server, suite.logger, suite.DB, &cfg)
+	return server
+}
+
+func attemptLogin(server *api.Server, password string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/login", bytes.NewReader([]byte(fmt.Sprintf(`{"email":"test@example.com","password":%q}`, password))))
+	req.Header.Set("Content-Type", "application/json")
+	server.E().ServeHTTP(rec, req)
+	return rec
+}
+
+func (suite *AuthAPIIntegrationSuite) testUser() relational.User {
+	var user relational.User
+	suite.Require().NoError(suite.DB.First(&user, "email = ?", "test@example.com").Error)
+	return user
+}
+
+func (suite *AuthAPIIntegrationSuite) TestLockout() {
+	suite.Require().NoError(suite.IntegrationTestSuite.Migrator.Refresh())
+	server := suite.newServer(func(cfg *config.Config) {
+		cfg.LoginMaxFailures = 3
+		cfg.LoginLockoutDuration = time.Hour
+	})
+
+	suite.Run("Consecutive failures lock the user", func() {
+		for range 3 {
+			suite.Equal(http.StatusUnauthorized, attemptLogin(server, "wrongPassword").Code)
+		}
+		user := suite.testUser()
+		suite.True(user.IsLocked)
+		suite.Equal(3, user.FailedLogins)
+		suite.NotNil(user.LockedAt)
+
+		var events []relational.AuditEvent
+		suite.Require().NoError(suite.DB.Find(&events, "type = ?", relational.AuditEventUserLocked).Error)
+		suite.Require().Len(events, 1, "Expected the lockout to be recorded once")
+		suite.Equal(*user.ID, *events[0].UserID)
+		suite.Equal(relational.AuditSystemActor, events[0].Actor)
+		suite.NotEmpty(events[0].IPAddress)
+	})
+
+	suite.Run("Locked users cannot log in with their password", func() {
+		suite.Equal(http.StatusUnauthorized, attemptLogin(server, "Pa55w0rd").Code)
+	})
+
+	suite.Run("Users are unlocked once the lockout has expired", func() {
+		suite.Require().NoError(suite.DB.Model(&relational.User{}).
+			Where("email = ?", "test@example.com").
+			Update("locked_at", time.Now().Add(-2*time.Hour)).Error)
+
+		suite.Equal(http.StatusOK, attemptLogin(server, "Pa55w0rd").Code)
+		user := suite.testUser()
+		suite.False(user.IsLocked)
+		suite.Zero(user.FailedLogins)
+		suite.NotNil(user.LastLogin)
+
+		var count int64
+		suite.Require().NoError(suite.DB.Model(&relational.AuditEvent{}).Where("type = ?", relational.AuditEventUserUnlocked).Count(&count).Error)
+		suite.EqualValues(1, count)
+	})
+
+	suite.Run("Successful logins reset the failures", func() {
+		suite.Equal(http.StatusUnauthorized, attemptLogin(server, "wrongPassword").Code)
+		suite.Equal(http.StatusOK, attemptLogin(server, "Pa55w0rd").Code)
+		suite.Zero(suite.testUser().FailedLogins)
+	})
+
+	suite.Run("Email addresses are matched whatever their case", func() {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/login", bytes.NewReader([]byte(`{"email":"Test@Example.com","password":"wrongPassword"}`)))
+		req.Header.Set("Content-Type", "application/json")
+		server.E().ServeHTTP(rec, req)
+		suite.Equal(http.StatusUnauthorized, rec.Code)
+		suite.Equal(1, suite.testUser().FailedLogins)
+	})
+}
+
+func (suite *AuthAPIIntegrationSuite) TestLoginThrottle() {
+	suite.Require().NoError(suite.IntegrationTestSuite.Migrator.Refresh())
+	server := suite.newServer(func(cfg *config.Config) {
+		cfg.LoginAccountRateLimit = 2
+		cfg.LoginRateLimitWindow = time.Minute
+	})
+
+	suite.Equal(http.StatusUnauthorized, attemptLogin(server, "wrongPassword").Code)
+	suite.Equal(http.StatusOK, attemptLogin(server, "Pa55w0rd").Code)
+
+	rec := attemptLogin(server, "Pa55w0rd")
+	suite.Equal(http.StatusTooManyRequests, rec.Code, "Expected attempts beyond the limit to be rejected")
+	suite.NotEmpty(rec.Header().Get("Retry-After"))
+
+	rec = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/auth/token", bytes.NewReader([]byte("username=test@example.com&password=Pa55w0rd")))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	server.E().ServeHTTP(rec, req)
+	suite.Equal(http.StatusTooManyRequests, rec.Code, "Expected the token endpoint to share the limit")
+}
+
+func (suite *AuthAPIIntegrationSuite) TestLoginThrottleForwardedFor() {
+	suite.Require().NoError(suite.IntegrationTestSuite.Migrator.Refresh())
+
+	attempt := func(server *api.Server, forwardedFor string) int {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/api/auth/login", bytes.NewReader([]byte(`{"email":"test@example.com","password":"Pa55w0rd"}`)))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(echo.HeaderXForwardedFor, forwardedFor)
+		server.E().ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	suite.Run("Forwarded addresses from untrusted clients are ignored", func() {
+		server := suite.newServer(func(cfg *config.Config) {
+			cfg.LoginIPRateLimit = 1
+		})
+		suite.Equal(http.StatusOK, attempt(server, "203.0.113.1"))
+		suite.Equal(http.StatusTooManyRequests, attempt(server, "203.0.113.2"), "Expected clients not to choose their own address")
+	})
+
+	suite.Run("Forwarded addresses from trusted proxies identify clients", func() {
+		server := suite.newServer(func(cfg *config.Config) {
+			cfg.LoginIPRateLimit = 1
+			// httptest requests are made from 192.0.2.1.
+			_, proxy, err := net.ParseCIDR("192.0.2.0/24")
+			suite.Require().NoError(err)
+			cfg.TrustedProxies = []*net.IPNet{proxy}
+		})
+		suite.Equal(http.StatusOK, attempt(server, "203.0.113.1"))
+		suite.Equal(http.StatusOK, attempt(server, "203.0.113.2"))
+		suite.Equal(http.StatusTooManyRequests, attempt(server, "203.0.113.1"))
+	})
+}
@@ -0,0 +1,250 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/authn"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// oidcStateCookie holds the state and nonce of a login in progress, so the callback can check it was started here.
+const oidcStateCookie = "ccf_oidc_state"
+
+var (
+	errInactiveUser = errors.New("user is not active")
+	errLockedUser   = errors.New("user is locked")
+	errLinkedUser   = errors.New("the email address is already linked to another identity")
+	errExistingUser = errors.New("a user with the email address already exists, and cannot be linked to the identity")
+)
+
+// OIDCLogin godoc
+//
+//	@Summary		Login with OpenID Connect
+//	@Description	Redirects the browser to the OpenID Connect provider to log in. Only available when CCF_OIDC_ISSUER is set.
+//	@Tags			Auth
+//	@Success		302	"Redirect to the provider"
+//	@Failure		500	{object}	api.Error
+//	@Router			/auth/oidc/login [get]
+func (h *AuthHandler) OIDCLogin(ctx echo.Context) error {
+	state, err := randomToken()
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+	nonce, err := randomToken()
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	url, err := h.oidc.AuthCodeURL(ctx.Request().Context(), state, nonce)
+	if err != nil {
+		h.sugar.Errorw("Failed to start OpenID Connect login", "error", err)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	ctx.SetCookie(&http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    state + "." + nonce,
+		Expires:  time.Now().Add(10 * time.Minute),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+		Path:     "/",
+	})
+
+	return ctx.Redirect(http.StatusFound, url)
+}
+
+// OIDCCallback godoc
+//
+//	@Summary		OpenID Connect callback
+//	@Description	Completes a login with the OpenID Connect provider, creating the user on their first login and giving them the roles their groups are mapped to. Sets the auth cookies and redirects to CCF_OIDC_LOGIN_REDIRECT_URL.
+//	@Tags			Auth
+//	@Param			code	query	string	true	"Authorization code"
+//	@Param			state	query	string	true	"State"
+//	@Success		302		"Redirect once logged in"
+//	@Failure		400		{object}	api.Error
+//	@Failure		401		{object}	api.Error
+//	@Failure		403		{object}	api.Error
+//	@Failure		409		{object}	api.Error
+//	@Failure		500		{object}	api.Error
+//	@Router			/auth/oidc/callback [get]
+func (h *AuthHandler) OIDCCallback(ctx echo.Context) error {
+	cookie, err := ctx.Cookie(oidcStateCookie)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(errors.New("no login is in progress")))
+	}
+	ctx.SetCookie(&http.Cookie{Name: oidcStateCookie, Path: "/", MaxAge: -1, HttpOnly: true, Secure: true})
+
+	state, nonce, _ := strings.Cut(cookie.Value, ".")
+	if subtle.ConstantTimeCompare([]byte(state), []byte(ctx.QueryParam("state"))) != 1 {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(errors.New("invalid login state")))
+	}
+	if providerError := ctx.QueryParam("error"); providerError != "" {
+		h.sugar.Warnw("OpenID Connect provider rejected login", "error", providerError, "description", ctx.QueryParam("error_description"))
+		return ctx.JSON(http.StatusUnauthorized, api.NewError(errors.New(providerError)))
+	}
+	code := ctx.QueryParam("code")
+	if code == "" {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(errors.New("missing authorization code")))
+	}
+
+	identity, err := h.oidc.Exchange(ctx.Request().Context(), code, nonce)
+	if err != nil {
+		h.sugar.Warnw("Failed to complete OpenID Connect login", "error", err)
+		return ctx.JSON(http.StatusUnauthorized, api.NewError(err))
+	}
+
+	user, err := h.provisionOIDCUser(identity)
+	if err != nil {
+		if errors.Is(err, errInactiveUser) || errors.Is(err, errLockedUser) {
+			return ctx.JSON(http.StatusForbidden, api.NewError(err))
+		}
+		if errors.Is(err, errLinkedUser) || errors.Is(err, errExistingUser) {
+			return ctx.JSON(http.StatusConflict, api.NewError(err))
+		}
+		h.sugar.Errorw("Failed to provision OpenID Connect user", "error", err, "email", identity.Email)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	tokens, err := h.sessions.Create(user)
+	if err != nil {
+		h.sugar.Errorw("Failed to create session", "error", err)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+	h.setAuthCookies(ctx, tokens)
+
+	return ctx.Redirect(http.StatusFound, h.config.OIDCLoginRedirectURL)
+}
+
+// provisionOIDCUser returns the user with the identity, creating them on their first login. When
+// CCF_OIDC_LINK_EXISTING_USERS is set, existing users with the same email address, verified by the provider, are
+// linked to the identity, so they can keep their roles and grants. Otherwise the login is rejected, as linking them
+// would let anyone able to claim the email address with the provider take over the user.
+// When a role mapping or default role is configured, the roles of the user are replaced with those of their groups
+// on every login. Otherwise new users are given the read-only role, as they are by `users create`.
+func (h *AuthHandler) provisionOIDCUser(identity *authn.OIDCIdentity) (*relational.User, error) {
+	var user relational.User
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		created := false
+		err := tx.Where("oidc_issuer = ? AND oidc_subject = ?", identity.Issuer, identity.Subject).First(&user).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = tx.Where("lower(email) = lower(?)", identity.Email).First(&user).Error
+			if err == nil && user.OIDCSubject != nil {
+				return errLinkedUser
+			}
+			if err == nil && (!h.config.OIDCLinkExistingUsers || !identity.EmailVerified) {
+				return errExistingUser
+			}
+		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			user = relational.User{Email: identity.Email, IsActive: true}
+			created = true
+			password, err := randomToken()
+			if err != nil {
+				return err
+			}
+			if err := user.SetPassword(password); err != nil {
+				return err
+			}
+		} else if err != nil {
+			return err
+		}
+		if !user.IsActive {
+			return errInactiveUser
+		}
+		now := time.Now()
+		if !created {
+			locked, err := h.lockout.IsLocked(&user, now)
+			if err != nil {
+				return err
+			}
+			if locked {
+				return errLockedUser
+			}
+		}
+
+		user.OIDCIssuer = &identity.Issuer
+		user.OIDCSubject = &identity.Subject
+		user.LastLogin = &now
+		if identity.GivenName != "" {
+			user.FirstName = identity.GivenName
+		}
+		if identity.FamilyName != "" {
+			user.LastName = identity.FamilyName
+		}
+		if err := tx.Omit("Roles").Save(&user).Error; err != nil {
+			return err
+		}
+
+		if len(h.config.OIDCRoleMapping) > 0 || h.config.OIDCDefaultRole != "" {
+			roles, err := h.oidcRoles(tx, identity.Groups)
+			if err != nil {
+				return err
+			}
+			if err := tx.Model(&user).Association("Roles").Replace(&roles); err != nil {
+				return err
+			}
+		} else if created {
+			var role relational.AccessRole
+			if err := tx.First(&role, "name = ?", relational.RoleReadOnly).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(&user).Association("Roles").Append(&role); err != nil {
+				return err
+			}
+		}
+
+		return tx.Preload("Roles.Permissions").First(&user, "id = ?", user.ID).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// oidcRoles returns the roles the groups are mapped to, or the default role when none of them are.
+func (h *AuthHandler) oidcRoles(tx *gorm.DB, groups []string) ([]relational.AccessRole, error) {
+	names := []string{}
+	for _, group := range groups {
+		for _, name := range h.config.OIDCRoleMapping[group] {
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	if len(names) == 0 && h.config.OIDCDefaultRole != "" {
+		names = append(names, h.config.OIDCDefaultRole)
+	}
+
+	roles := []relational.AccessRole{}
+	if len(names) == 0 {
+		return roles, nil
+	}
+	if err := tx.Where("name IN ?", names).Find(&roles).Error; err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if !slices.ContainsFunc(roles, func(role relational.AccessRole) bool { return role.Name == name }) {
+			h.sugar.Warnw("OpenID Connect groups are mapped to an unknown role", "role", name)
+		}
+	}
+	return roles, nil
+}
+
+func randomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
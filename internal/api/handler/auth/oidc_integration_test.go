@@ -0,0 +1,193 @@
+//go:build integration
+
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/authn"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/compliance-framework/api/internal/tests"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+func TestOIDCAPI(t *testing.T) {
+	suite.Run(t, new(OIDCAPIIntegrationSuite))
+}
+
+type OIDCAPIIntegrationSuite struct {
+	tests.IntegrationTestSuite
+}
+
+func (suite *OIDCAPIIntegrationSuite) TestOIDCLogin() {
+	err := suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	mock, err := tests.NewMockOIDCProvider("ccf")
+	suite.Require().NoError(err)
+	defer mock.Close()
+
+	cfg := *suite.Config
+	cfg.OIDCIssuer = mock.URL
+	cfg.OIDCClientID = "ccf"
+	cfg.OIDCRedirectURL = "https://ccf.example.com/api/auth/oidc/callback"
+	cfg.OIDCScopes = []string{"profile", "email"}
+	cfg.OIDCGroupsClaim = "groups"
+	cfg.OIDCRoleMapping = map[string][]string{
+		"ccf-admins": {relational.RoleAdmin},
+		"auditors":   {relational.RoleAssessor, "unknown"},
+	}
+	cfg.OIDCDefaultRole = relational.RoleReadOnly
+	cfg.OIDCLoginRedirectURL = "https://ccf.example.com/"
+
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), &cfg)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, &cfg)
+
+	// login goes through the flow as a browser would, and returns the response to the callback.
+	login := func() *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		server.E().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/auth/oidc/login", nil))
+		suite.Require().Equal(http.StatusFound, rec.Code, rec.Body.String())
+		cookies := rec.Result().Cookies()
+		suite.Require().Len(cookies, 1)
+		suite.Require().Equal("ccf_oidc_state", cookies[0].Name)
+
+		code, state, err := mock.Authorize(rec.Header().Get("Location"))
+		suite.Require().NoError(err)
+
+		rec = httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/auth/oidc/callback?code="+code+"&state="+state, nil)
+		req.AddCookie(cookies[0])
+		server.E().ServeHTTP(rec, req)
+		return rec
+	}
+
+	// claims returns the claims of the auth token set by a successful login.
+	claims := func(rec *httptest.ResponseRecorder) *authn.UserClaims {
+		suite.Require().Equal(http.StatusFound, rec.Code, rec.Body.String())
+		suite.Equal("https://ccf.example.com/", rec.Header().Get("Location"))
+		for _, cookie := range rec.Result().Cookies() {
+			if cookie.Name == "ccf_auth_token" {
+				suite.True(cookie.HttpOnly)
+				suite.True(cookie.Secure)
+				claims, err := authn.VerifyJWTToken(cookie.Value, cfg.JWTPublicKey)
+				suite.Require().NoError(err)
+				return claims
+			}
+		}
+		suite.FailNow("ccf_auth_token cookie was not set")
+		return nil
+	}
+
+	suite.Run("Users are created on their first login", func() {
+		mock.Claims = map[string]any{
+			"sub":         "jane",
+			"email":       "jane@example.com",
+			"given_name":  "Jane",
+			"family_name": "Doe",
+			"groups":      []string{"auditors", "engineers"},
+		}
+		tokenClaims := claims(login())
+		suite.Equal("jane@example.com", tokenClaims.Subject)
+		suite.Equal([]string{relational.RoleAssessor}, tokenClaims.Roles)
+
+		var user relational.User
+		suite.Require().NoError(suite.DB.First(&user, "email = ?", "jane@example.com").Error)
+		suite.Equal("Jane", user.FirstName)
+		suite.Equal("jane", *user.OIDCSubject)
+		suite.NotNil(user.LastLogin)
+	})
+
+	suite.Run("Roles follow the groups of the user", func() {
+		mock.Claims["groups"] = []string{"ccf-admins"}
+		suite.Equal([]string{relational.RoleAdmin}, claims(login()).Roles)
+
+		mock.Claims["groups"] = []string{}
+		suite.Equal([]string{relational.RoleReadOnly}, claims(login()).Roles)
+
+		var count int64
+		suite.Require().NoError(suite.DB.Model(&relational.User{}).Where("email = ?", "jane@example.com").Count(&count).Error)
+		suite.Equal(int64(1), count)
+	})
+
+	user := relational.User{Email: "john@example.com", FirstName: "John", LastName: "Smith"}
+	suite.Require().NoError(user.SetPassword("Pa55w0rd"))
+	suite.Require().NoError(suite.DB.Create(&user).Error)
+
+	suite.Run("Existing users are not linked unless enabled", func() {
+		mock.Claims = map[string]any{"sub": "john", "email": "john@example.com", "email_verified": true}
+		suite.Equal(http.StatusConflict, login().Code)
+	})
+
+	cfg.OIDCLinkExistingUsers = true
+
+	suite.Run("Existing users are not linked by unverified email addresses", func() {
+		mock.Claims = map[string]any{"sub": "john", "email": "john@example.com"}
+		suite.Equal(http.StatusConflict, login().Code)
+
+		suite.Require().NoError(suite.DB.First(&user, "id = ?", user.ID).Error)
+		suite.Nil(user.OIDCSubject)
+	})
+
+	suite.Run("Existing users are linked by verified email address", func() {
+		mock.Claims = map[string]any{"sub": "john", "email": "john@example.com", "email_verified": true, "groups": "ccf-admins"}
+		suite.Equal("john@example.com", claims(login()).Subject)
+
+		suite.Require().NoError(suite.DB.First(&user, "id = ?", user.ID).Error)
+		suite.Equal("john", *user.OIDCSubject)
+		suite.Equal("John", user.FirstName)
+		suite.True(user.CheckPassword("Pa55w0rd"))
+	})
+
+	suite.Run("Emails linked to another identity are rejected", func() {
+		mock.Claims = map[string]any{"sub": "someone-else", "email": "john@example.com", "email_verified": true}
+		suite.Equal(http.StatusConflict, login().Code)
+	})
+
+	suite.Run("Locked users are rejected", func() {
+		suite.Require().NoError(suite.DB.Model(&relational.User{}).Where("email = ?", "jane@example.com").
+			Updates(map[string]any{"is_locked": true, "locked_at": time.Now()}).Error)
+		mock.Claims = map[string]any{"sub": "jane", "email": "jane@example.com"}
+		suite.Equal(http.StatusForbidden, login().Code)
+		suite.Require().NoError(suite.DB.Model(&relational.User{}).Where("email = ?", "jane@example.com").
+			Updates(map[string]any{"is_locked": false, "locked_at": nil}).Error)
+	})
+
+	suite.Run("Inactive users are rejected", func() {
+		suite.Require().NoError(suite.DB.Model(&relational.User{}).Where("email = ?", "jane@example.com").Update("is_active", false).Error)
+		mock.Claims = map[string]any{"sub": "jane", "email": "jane@example.com"}
+		suite.Equal(http.StatusForbidden, login().Code)
+	})
+
+	suite.Run("New users are read-only without a role mapping", func() {
+		mapping := cfg.OIDCRoleMapping
+		defer func() {
+			cfg.OIDCRoleMapping = mapping
+			cfg.OIDCDefaultRole = relational.RoleReadOnly
+		}()
+		cfg.OIDCRoleMapping = nil
+		cfg.OIDCDefaultRole = ""
+
+		mock.Claims = map[string]any{"sub": "alex", "email": "alex@example.com", "groups": []string{"ccf-admins"}}
+		suite.Equal([]string{relational.RoleReadOnly}, claims(login()).Roles)
+	})
+
+	suite.Run("Callbacks without a matching state are rejected", func() {
+		rec := httptest.NewRecorder()
+		server.E().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/auth/oidc/callback?code=code&state=state", nil))
+		suite.Equal(http.StatusBadRequest, rec.Code)
+
+		rec = httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/auth/oidc/callback?code=code&state=state", nil)
+		req.AddCookie(&http.Cookie{Name: "ccf_oidc_state", Value: "other.nonce"})
+		server.E().ServeHTTP(rec, req)
+		suite.Equal(http.StatusBadRequest, rec.Code)
+	})
+}
@@ -1,43 +1,81 @@
 package handler
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/compliance-framework/api/internal"
 	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/api/middleware"
+	"github.com/compliance-framework/api/internal/config"
 	"github.com/compliance-framework/api/internal/converters/labelfilter"
+	"github.com/compliance-framework/api/internal/provenance"
+	"github.com/compliance-framework/api/internal/service"
 	"github.com/compliance-framework/api/internal/service/relational"
 	oscalTypes_1_1_3 "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"maps"
+	"mime"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 )
 
 type EvidenceHandler struct {
-	db    *gorm.DB
-	sugar *zap.SugaredLogger
+	db          *gorm.DB
+	sugar       *zap.SugaredLogger
+	config      *config.Config
+	pagination  *service.PaginationConfig
+	broadcaster *service.EvidenceBroadcaster
+	blobs       service.BlobStore
+	verifier    *service.EvidenceVerifier
 }
 
-func NewEvidenceHandler(sugar *zap.SugaredLogger, db *gorm.DB) *EvidenceHandler {
+// NewEvidenceHandler creates a handler for evidence, publishing evidence it creates to broadcaster, and keeping
+// the content of attachments in blobs.
+func NewEvidenceHandler(sugar *zap.SugaredLogger, db *gorm.DB, config *config.Config, broadcaster *service.EvidenceBroadcaster, blobs service.BlobStore) *EvidenceHandler {
 	return &EvidenceHandler{
-		sugar: sugar,
-		db:    db,
+		sugar:       sugar,
+		db:          db,
+		config:      config,
+		pagination:  service.NewPaginationConfig(),
+		broadcaster: broadcaster,
+		blobs:       blobs,
+		verifier:    service.NewEvidenceVerifier(db),
 	}
 }
 
 func (h *EvidenceHandler) Register(api *echo.Group) {
-	api.POST("", h.Create)
-	api.GET("/:id", h.Get)
-	api.GET("/history/:id", h.History)
-	api.POST("/search", h.Search)
-	api.GET("/for-control/:id", h.ForControl)
-	api.GET("/status-over-time/:id", h.StatusOverTimeByUUID)
-	api.POST("/status-over-time", h.StatusOverTime)
-	api.GET("/compliance-by-control/:id", h.ComplianceByControl)
+	ingest := middleware.RequirePermission(relational.PermissionEvidenceWrite)
+	read := middleware.RequirePermission(relational.PermissionEvidenceRead)
+
+	api.POST("", h.Create, ingest)
+	api.POST("/batch", h.CreateBatch, ingest)
+	api.GET("/:id", h.Get, read)
+	api.GET("/:id/diff", h.Diff, read)
+	api.GET("/:id/attachments/:name", h.Attachment, read)
+	api.GET("/labels", h.Labels, read)
+	api.GET("/labels/:name/values", h.LabelValues, read)
+	api.GET("/history/:id", h.History, read)
+	api.POST("/search", h.Search, read)
+	api.GET("/stale", h.Stale, read)
+	api.GET("/stream", h.Stream, read)
+	api.GET("/transitions", h.Transitions, read)
+	api.GET("/transitions/mttr", h.Recoveries, read)
+	api.GET("/for-control/:id", h.ForControl, read)
+	api.GET("/status-over-time/:id", h.StatusOverTimeByUUID, read)
+	api.POST("/status-over-time", h.StatusOverTime, read)
+	api.GET("/compliance-by-control/:id", h.ComplianceByControl, read)
 }
 
 type EvidenceActivityStep struct {
@@ -108,6 +146,15 @@ type EvidenceComponent struct {
 	Links       []oscalTypes_1_1_3.Link
 }
 
+// EvidenceAttachment is a raw artifact collected alongside evidence, such as policy output or a screenshot.
+// Its content is base64 encoded in JSON. Names must be unique within a piece of evidence.
+type EvidenceAttachment struct {
+	Name        string `validate:"required,excludes=/"`
+	Description string
+	MediaType   string
+	Content     []byte
+}
+
 type EvidenceSubject struct {
 	Identifier string
 
@@ -126,7 +173,7 @@ type EvidenceCreateRequest struct {
 	// It represents the "stream" of the same observation being made over time.
 	// For the same checks, performed on the same machine, the UUID for each check should remain the same.
 	// For the same check, performed on two different machines, the UUID should differ.
-	UUID        uuid.UUID
+	UUID        uuid.UUID `validate:"required"`
 	Title       string
 	Description string
 	Remarks     *string
@@ -135,8 +182,8 @@ type EvidenceCreateRequest struct {
 	Labels map[string]string
 
 	// When did we start collecting the evidence, and when did the process end, and how long is it valid for ?
-	Start   time.Time
-	End     time.Time
+	Start   time.Time `validate:"required"`
+	End     time.Time `validate:"required"`
 	Expires *time.Time
 
 	Props []oscalTypes_1_1_3.Property
@@ -153,12 +200,21 @@ type EvidenceCreateRequest struct {
 	Subjects []EvidenceSubject
 	// Did we satisfy what was being tested for, or did we fail ?
 	Status oscalTypes_1_1_3.ObjectiveStatus
+	// Raw artifacts collected alongside the evidence.
+	Attachments []EvidenceAttachment `validate:"unique=Name,dive"`
+	// Signature of the canonical JSON of this request, made with a key registered for an agent in its origins.
+	// See the provenance package for how the canonical JSON is formed.
+	Signature *provenance.Signature
+
+	// verification is the outcome of verifying Signature, set before the evidence is written.
+	verification *service.EvidenceVerificationResult
 }
 
 // Create godoc
 //
 //	@Summary		Create new Evidence
 //	@Description	Creates a new Evidence record including activities, inventory items, components, and subjects.
+//	@Description	The signature of the evidence is verified, and evidence which is unsigned or invalid is rejected when signatures are required.
 //	@Tags			Evidence
 //	@Accept			json
 //	@Produce		json
@@ -171,15 +227,494 @@ type EvidenceCreateRequest struct {
 func (h *EvidenceHandler) Create(ctx echo.Context) error {
 	// Bind the incoming JSON payload into a slice of SDK findings.
 	var input *EvidenceCreateRequest
-	if err := ctx.Bind(&input); err != nil {
+	document, err := bindDocument(ctx, &input)
+	if err != nil {
 		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
 	}
 
-	err := ctx.Validate(input)
+	err = ctx.Validate(input)
 	if err != nil {
 		return ctx.JSON(http.StatusBadRequest, api.Validator(err))
 	}
 
+	if err := h.verifyEvidence(ctx.Request().Context(), input, document); err != nil {
+		if errors.Is(err, errEvidenceSignatureRequired) {
+			return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+		}
+		h.sugar.Errorw("Failed to verify evidence signature", "uuid", input.UUID, "error", err)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	var events []service.EvidenceEvent
+	err = h.db.Transaction(func(tx *gorm.DB) error {
+		_, events, err = h.createEvidence(tx, input)
+		return err
+	})
+	if err != nil {
+		h.sugar.Errorw("Failed to create evidence", "uuid", input.UUID, "error", err)
+		return ctx.JSON(evidenceWriteErrorStatus(err), api.NewError(err))
+	}
+	h.broadcaster.Publish(events...)
+
+	// Return a 201 Created response with no content.
+	return ctx.NoContent(http.StatusCreated)
+}
+
+type EvidenceBatchStatus string
+
+const (
+	// EvidenceBatchStatusCreated indicates the evidence was written to the database.
+	EvidenceBatchStatusCreated EvidenceBatchStatus = "created"
+	// EvidenceBatchStatusUnchanged indicates the evidence repeated the latest evidence of its stream,
+	// and was recorded as a sighting of the existing evidence instead of a new record.
+	EvidenceBatchStatusUnchanged EvidenceBatchStatus = "unchanged"
+	// EvidenceBatchStatusInvalid indicates the evidence failed validation, or signature verification when signatures
+	// are required, and was not written.
+	EvidenceBatchStatusInvalid EvidenceBatchStatus = "invalid"
+	// EvidenceBatchStatusConflict indicates evidence for the same stream and end time already exists.
+	EvidenceBatchStatusConflict EvidenceBatchStatus = "conflict"
+	// EvidenceBatchStatusFailed indicates the chunk containing the evidence could not be written and was rolled back.
+	EvidenceBatchStatusFailed EvidenceBatchStatus = "failed"
+)
+
+// EvidenceBatchItemResult reports the outcome for a single item of a batch request.
+// Index refers to the position of the item in the submitted array.
+type EvidenceBatchItemResult struct {
+	Index  int                 `json:"index"`
+	UUID   uuid.UUID           `json:"uuid"`
+	ID     *uuid.UUID          `json:"id,omitempty"`
+	Status EvidenceBatchStatus `json:"status"`
+	Errors map[string]any      `json:"errors,omitempty"`
+}
+
+// CreateBatch godoc
+//
+//	@Summary		Create a batch of Evidence
+//	@Description	Creates many Evidence records in a single request. Items are written in one transaction, or in transactions of `chunk` items when set, and a status is returned for each item. Batches of more items than configured are rejected.
+//	@Tags			Evidence
+//	@Accept			json
+//	@Produce		json
+//	@Param			evidence	body		[]EvidenceCreateRequest	true	"Evidence create requests"
+//	@Param			chunk		query		int						false	"Number of items written per transaction. Defaults to the whole batch."
+//	@Success		201			{object}	GenericDataListResponse[EvidenceBatchItemResult]
+//	@Success		207			{object}	GenericDataListResponse[EvidenceBatchItemResult]
+//	@Failure		400			{object}	api.Error
+//	@Failure		413			{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/evidence/batch [post]
+func (h *EvidenceHandler) CreateBatch(ctx echo.Context) error {
+	var input []EvidenceCreateRequest
+	body, err := bindDocument(ctx, &input)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+	// Each item is signed on its own, so signatures are verified against the items as they were sent.
+	documents := []json.RawMessage{}
+	if err := json.Unmarshal(body, &documents); err != nil || len(documents) != len(input) {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(errors.New("evidence batch must be a JSON array")))
+	}
+	if len(input) > h.config.EvidenceBatchMaxItems {
+		return ctx.JSON(http.StatusRequestEntityTooLarge, api.NewError(fmt.Errorf("evidence batches may contain at most %d items", h.config.EvidenceBatchMaxItems)))
+	}
+
+	chunkSize := len(input)
+	if chunkParam := ctx.QueryParam("chunk"); chunkParam != "" {
+		size, err := strconv.Atoi(chunkParam)
+		if err != nil || size <= 0 {
+			return ctx.JSON(http.StatusBadRequest, api.NewError(fmt.Errorf("invalid chunk parameter: %s", chunkParam)))
+		}
+		chunkSize = size
+	}
+
+	results := make([]EvidenceBatchItemResult, len(input))
+	for i := range input {
+		results[i] = EvidenceBatchItemResult{
+			Index: i,
+			UUID:  input[i].UUID,
+		}
+		if err := ctx.Validate(&input[i]); err != nil {
+			results[i].Status = EvidenceBatchStatusInvalid
+			results[i].Errors = api.Validator(err).Errors
+			continue
+		}
+		if err := h.verifyEvidence(ctx.Request().Context(), &input[i], documents[i]); err != nil {
+			if !errors.Is(err, errEvidenceSignatureRequired) {
+				h.sugar.Errorw("Failed to verify evidence signature", "uuid", input[i].UUID, "error", err)
+				return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+			}
+			results[i].Status = EvidenceBatchStatusInvalid
+			results[i].Errors = api.NewError(err).Errors
+		}
+	}
+
+	for start := 0; start < len(input); start += chunkSize {
+		end := min(start+chunkSize, len(input))
+		h.createEvidenceChunk(input[start:end], results[start:end])
+	}
+
+	status := http.StatusCreated
+	for _, result := range results {
+		if result.Status != EvidenceBatchStatusCreated && result.Status != EvidenceBatchStatusUnchanged {
+			status = http.StatusMultiStatus
+			break
+		}
+	}
+
+	return ctx.JSON(status, GenericDataListResponse[EvidenceBatchItemResult]{Data: results})
+}
+
+// createEvidenceChunk writes every item of a chunk which passed validation in a single transaction.
+// Items conflicting with existing evidence, or with an earlier item in the chunk, are skipped.
+// If any write fails the whole chunk is rolled back, and every item which would have been created, or which only
+// conflicted with an earlier item in the chunk, is marked as failed.
+func (h *EvidenceHandler) createEvidenceChunk(input []EvidenceCreateRequest, results []EvidenceBatchItemResult) {
+	streams := []uuid.UUID{}
+	ends := []time.Time{}
+	for i := range input {
+		if results[i].Status == "" {
+			streams = append(streams, input[i].UUID)
+			ends = append(ends, input[i].End)
+		}
+	}
+	if len(streams) == 0 {
+		return
+	}
+
+	events := []service.EvidenceEvent{}
+	exists := map[string]bool{}
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		existing := []struct {
+			UUID uuid.UUID
+			End  time.Time
+		}{}
+		if err := tx.Model(&relational.EvidenceSighting{}).
+			Select("evidences.uuid, evidence_sightings.end").
+			Joins("JOIN evidences ON evidences.id = evidence_sightings.evidence_id").
+			Where("evidences.uuid IN ?", streams).
+			Where("evidence_sightings.end IN ?", ends).
+			Scan(&existing).Error; err != nil {
+			return err
+		}
+		for _, e := range existing {
+			exists[evidenceConflictKey(e.UUID, e.End)] = true
+		}
+		seen := map[string]bool{}
+
+		for i := range input {
+			if results[i].Status != "" {
+				continue
+			}
+			key := evidenceConflictKey(input[i].UUID, input[i].End)
+			if exists[key] || seen[key] {
+				results[i].Status = EvidenceBatchStatusConflict
+				results[i].Errors = api.NewError(errors.New("evidence already exists for this stream and end time")).Errors
+				continue
+			}
+			seen[key] = true
+
+			evidence, itemEvents, err := h.createEvidence(tx, &input[i])
+			if err != nil {
+				results[i].Status = EvidenceBatchStatusFailed
+				results[i].Errors = api.NewError(err).Errors
+				return err
+			}
+			results[i].ID = evidence.ID
+			results[i].Status = EvidenceBatchStatusCreated
+			if len(itemEvents) == 0 {
+				results[i].Status = EvidenceBatchStatusUnchanged
+			}
+			events = append(events, itemEvents...)
+		}
+		return nil
+	})
+	if err == nil {
+		h.broadcaster.Publish(events...)
+	}
+
+	if err != nil {
+		h.sugar.Warnw("Failed to write evidence batch chunk", "error", err)
+		for i := range results {
+			// Items conflicting with an earlier item in the chunk may be written now that it has been rolled back.
+			chunkConflict := results[i].Status == EvidenceBatchStatusConflict && !exists[evidenceConflictKey(input[i].UUID, input[i].End)]
+			if results[i].Status == EvidenceBatchStatusCreated || results[i].Status == EvidenceBatchStatusUnchanged || results[i].Status == "" || chunkConflict {
+				results[i].ID = nil
+				results[i].Status = EvidenceBatchStatusFailed
+				results[i].Errors = api.NewError(fmt.Errorf("batch chunk rolled back: %w", err)).Errors
+			}
+		}
+	}
+}
+
+// applyVerification records the outcome of verifying the signature of the input on evidence.
+func (r *EvidenceCreateRequest) applyVerification(evidence *relational.Evidence) {
+	if r.verification == nil {
+		return
+	}
+	evidence.Verification = r.verification.Verification
+	evidence.SigningKeyID = r.verification.KeyID
+}
+
+// errEvidenceSignatureRequired is returned by verifyEvidence when evidence is rejected for its signature.
+var errEvidenceSignatureRequired = errors.New("a valid signature is required")
+
+// verifyEvidence verifies the signature of the input against the JSON document it was decoded from, recording the
+// outcome on the input. Under the require policy, evidence which is not verified is rejected with an error wrapping
+// errEvidenceSignatureRequired. Otherwise it is accepted, and flagged as unsigned or invalid.
+func (h *EvidenceHandler) verifyEvidence(ctx context.Context, input *EvidenceCreateRequest, document []byte) error {
+	agents := []uuid.UUID{}
+	for _, origin := range input.Origins {
+		for _, actor := range origin.Actors {
+			if id, err := uuid.Parse(actor.ActorUuid); err == nil {
+				agents = append(agents, id)
+			}
+		}
+	}
+
+	result, err := h.verifier.Verify(ctx, document, input.Signature, agents, time.Now())
+	if err != nil {
+		return err
+	}
+	if result.Verification != relational.EvidenceVerificationVerified {
+		if h.config.EvidenceSignaturePolicy == config.SignaturePolicyRequire {
+			return fmt.Errorf("%w: %w", errEvidenceSignatureRequired, result.Reason)
+		}
+		if result.Verification == relational.EvidenceVerificationInvalid {
+			h.sugar.Warnw("Accepting evidence with an invalid signature", "uuid", input.UUID, "reason", result.Reason)
+		}
+	}
+	input.verification = result
+	return nil
+}
+
+// evidenceWriteErrorStatus maps an error raised while writing evidence to an HTTP status code.
+// Errors caused by the submitted data, such as constraint violations or invalid values, are client errors,
+// anything else is treated as a server error.
+func evidenceWriteErrorStatus(err error) int {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return http.StatusInternalServerError
+	}
+	switch {
+	case pgErr.Code == "23505":
+		// unique_violation
+		return http.StatusConflict
+	case strings.HasPrefix(pgErr.Code, "23"):
+		// Class 23 - Integrity Constraint Violation
+		return http.StatusUnprocessableEntity
+	case strings.HasPrefix(pgErr.Code, "22"):
+		// Class 22 - Data Exception
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+func evidenceConflictKey(stream uuid.UUID, end time.Time) string {
+	return stream.String() + "/" + strconv.FormatInt(end.UnixMicro(), 10)
+}
+
+// createEvidence writes the evidence graph described by input, including its activities, inventory items,
+// components, subjects and labels, using the provided database handle.
+// It should be called within a transaction, so that a failure part way through does not leave a partial graph behind.
+// When the input repeats the latest evidence of its stream, no new evidence is created. The existing evidence is
+// extended with a new sighting instead. New evidence which changes the state of its stream is recorded as a transition.
+// The returned events describe the evidence which was created, and are empty when only a sighting was recorded.
+// They should be published once the transaction commits.
+func (h *EvidenceHandler) createEvidence(db *gorm.DB, input *EvidenceCreateRequest) (*relational.Evidence, []service.EvidenceEvent, error) {
+	attachments, err := h.storeAttachments(db, input)
+	if err != nil {
+		return nil, nil, err
+	}
+	latest, unchanged, err := h.findUnchangedEvidence(db, input, attachments)
+	if err != nil {
+		return nil, nil, err
+	}
+	if unchanged {
+		if err := h.recordSighting(db, latest, input); err != nil {
+			return nil, nil, err
+		}
+		return latest, nil, nil
+	}
+
+	evidence, err := h.insertEvidence(db, input, attachments)
+	if err != nil {
+		return nil, nil, err
+	}
+	transition := relational.NewEvidenceTransition(evidence, latest)
+	if transition != nil {
+		if err := db.Create(transition).Error; err != nil {
+			return nil, nil, fmt.Errorf("failed to record evidence transition: %w", err)
+		}
+		if !transition.IsInitial() {
+			if err := service.EnqueueWebhookEvent(db, relational.WebhookEventEvidenceTransitioned, EvidenceTransitionWebhook{
+				EvidenceTransition: *transition,
+				Title:              evidence.Title,
+				Labels:             input.Labels,
+			}); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	return evidence, evidenceEvents(evidence, transition), nil
+}
+
+// EvidenceTransitionWebhook is the data of evidence.transitioned webhook events.
+type EvidenceTransitionWebhook struct {
+	relational.EvidenceTransition
+	Title  string            `json:"title"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// evidenceEvents describes newly created evidence, and the change of state it made to its stream, if any.
+// The initial state of a stream is not published as a transition.
+func evidenceEvents(evidence *relational.Evidence, transition *relational.EvidenceTransition) []service.EvidenceEvent {
+	now := time.Now()
+	events := []service.EvidenceEvent{{
+		Type:     service.EvidenceCreated,
+		Time:     now,
+		State:    evidence.Status.Data().State,
+		Evidence: evidence,
+	}}
+	if transition != nil && !transition.IsInitial() {
+		events = append(events, service.EvidenceEvent{
+			Type:          service.EvidenceTransitioned,
+			Time:          now,
+			PreviousState: transition.PreviousState,
+			State:         transition.State,
+			Evidence:      evidence,
+		})
+	}
+	return events
+}
+
+// findUnchangedEvidence returns the latest evidence of the input's stream, or nil when the stream has no evidence,
+// and whether the input makes the same observation at a later time.
+func (h *EvidenceHandler) findUnchangedEvidence(db *gorm.DB, input *EvidenceCreateRequest, attachments []relational.EvidenceAttachment) (*relational.Evidence, bool, error) {
+	latest := &relational.Evidence{}
+	err := db.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("uuid = ?", input.UUID).
+		Order("evidences.end DESC").
+		First(latest).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to load latest evidence: %w", err)
+	}
+
+	lastSeen := latest.End
+	if latest.LastSeenAt != nil {
+		lastSeen = *latest.LastSeenAt
+	}
+	if !input.End.After(lastSeen) {
+		return latest, false, nil
+	}
+
+	if err := db.
+		Preload("Labels").
+		Preload("Components").
+		Preload("Subjects.IncludeSubjects").
+		Preload("Attachments").
+		First(latest, "id = ?", latest.ID).Error; err != nil {
+		return nil, false, fmt.Errorf("failed to load latest evidence: %w", err)
+	}
+
+	candidate := &relational.Evidence{
+		Props:       relational.ConvertOscalToProps(&input.Props),
+		Status:      datatypes.NewJSONType(input.Status),
+		Attachments: attachments,
+	}
+	input.applyVerification(candidate)
+	for name, value := range input.Labels {
+		candidate.Labels = append(candidate.Labels, relational.Labels{Name: name, Value: value})
+	}
+	for _, i := range input.Components {
+		id, err := internal.SeededUUID(map[string]string{
+			"identifier": i.Identifier,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		candidate.Components = append(candidate.Components, relational.SystemComponent{
+			UUIDModel: relational.UUIDModel{ID: &id},
+		})
+	}
+	for _, i := range input.Subjects {
+		id, err := internal.SeededUUID(map[string]string{
+			"identifier": i.Identifier,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		candidate.Subjects = append(candidate.Subjects, relational.AssessmentSubject{
+			Type:            i.Type,
+			IncludeSubjects: []relational.SelectSubjectById{{SubjectUUID: id}},
+		})
+	}
+
+	if !latest.SameObservation(candidate) {
+		return latest, false, nil
+	}
+	return latest, true, nil
+}
+
+// recordSighting extends existing evidence with a repeated observation described by input.
+// The end of the evidence is left untouched, so that status history is unaffected.
+func (h *EvidenceHandler) recordSighting(db *gorm.DB, evidence *relational.Evidence, input *EvidenceCreateRequest) error {
+	if err := db.Create(&relational.EvidenceSighting{
+		EvidenceID: *evidence.ID,
+		Start:      input.Start,
+		End:        input.End,
+		Expires:    input.Expires,
+	}).Error; err != nil {
+		return fmt.Errorf("failed to create evidence sighting: %w", err)
+	}
+
+	// Evidence keeps its expiry when the sighting does not set one.
+	evidence.LastSeenAt = &input.End
+	updates := map[string]any{"last_seen_at": evidence.LastSeenAt}
+	if input.Expires != nil {
+		evidence.Expires = input.Expires
+		updates["expires"] = evidence.Expires
+	}
+	if err := db.Model(evidence).Updates(updates).Error; err != nil {
+		return fmt.Errorf("failed to update evidence: %w", err)
+	}
+	return nil
+}
+
+// storeAttachments writes the content of the input's attachments to the blob store, returning the attachments to
+// record with the evidence. Blobs are keyed by their content, so content repeated by later evidence is stored once.
+// Stores which keep blobs in the database write them using db, so that they are rolled back with the evidence.
+func (h *EvidenceHandler) storeAttachments(db *gorm.DB, input *EvidenceCreateRequest) ([]relational.EvidenceAttachment, error) {
+	blobs := h.blobs
+	if store, ok := blobs.(service.TransactionalBlobStore); ok {
+		blobs = store.WithTx(db)
+	}
+	attachments := []relational.EvidenceAttachment{}
+	for _, attachment := range input.Attachments {
+		info, err := blobs.Put(db.Statement.Context, bytes.NewReader(attachment.Content))
+		if err != nil {
+			return nil, fmt.Errorf("failed to store attachment %q: %w", attachment.Name, err)
+		}
+		mediaType := attachment.MediaType
+		if mediaType == "" {
+			mediaType = http.DetectContentType(attachment.Content)
+		}
+		attachments = append(attachments, relational.EvidenceAttachment{
+			Name:        attachment.Name,
+			Description: attachment.Description,
+			MediaType:   mediaType,
+			Size:        info.Size,
+			Hashes:      datatypes.NewJSONSlice(info.Hashes),
+			BlobKey:     info.Key,
+		})
+	}
+	return attachments, nil
+}
+
+// insertEvidence writes a new evidence row and the graph it references.
+func (h *EvidenceHandler) insertEvidence(db *gorm.DB, input *EvidenceCreateRequest, attachments []relational.EvidenceAttachment) (*relational.Evidence, error) {
 	components := []relational.SystemComponent{}
 	// First, Inventory
 	for _, i := range input.Components {
@@ -187,7 +722,7 @@ func (h *EvidenceHandler) Create(ctx echo.Context) error {
 			"identifier": i.Identifier,
 		})
 		if err != nil {
-			return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+			return nil, err
 		}
 		model := relational.SystemComponent{
 			UUIDModel: relational.UUIDModel{
@@ -207,7 +742,9 @@ func (h *EvidenceHandler) Create(ctx echo.Context) error {
 			Links: relational.ConvertOscalToLinks(&input.Links),
 		}
 		components = append(components, model)
-		h.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model)
+		if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model).Error; err != nil {
+			return nil, fmt.Errorf("failed to create component %q: %w", i.Identifier, err)
+		}
 	}
 
 	inventoryItems := []relational.InventoryItem{}
@@ -217,7 +754,7 @@ func (h *EvidenceHandler) Create(ctx echo.Context) error {
 			"identifier": i.Identifier,
 		})
 		if err != nil {
-			return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+			return nil, err
 		}
 		model := relational.InventoryItem{
 			UUIDModel: relational.UUIDModel{
@@ -237,7 +774,9 @@ func (h *EvidenceHandler) Create(ctx echo.Context) error {
 			})
 		}
 		inventoryItems = append(inventoryItems, model)
-		h.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model)
+		if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model).Error; err != nil {
+			return nil, fmt.Errorf("failed to create inventory item %q: %w", i.Identifier, err)
+		}
 	}
 
 	activities := []relational.Activity{}
@@ -266,7 +805,9 @@ func (h *EvidenceHandler) Create(ctx echo.Context) error {
 			})
 		}
 		activities = append(activities, model)
-		h.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model)
+		if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model).Error; err != nil {
+			return nil, fmt.Errorf("failed to create activity %q: %w", i.UUID, err)
+		}
 	}
 
 	subjects := []relational.AssessmentSubject{}
@@ -275,7 +816,7 @@ func (h *EvidenceHandler) Create(ctx echo.Context) error {
 			"identifier": i.Identifier,
 		})
 		if err != nil {
-			return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+			return nil, err
 		}
 		model := relational.AssessmentSubject{
 			Type: i.Type,
@@ -290,7 +831,9 @@ func (h *EvidenceHandler) Create(ctx echo.Context) error {
 			Links:       relational.ConvertOscalToLinks(&input.Links),
 		}
 		subjects = append(subjects, model)
-		h.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model)
+		if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model).Error; err != nil {
+			return nil, fmt.Errorf("failed to create subject %q: %w", i.Identifier, err)
+		}
 	}
 
 	labels := []relational.Labels{}
@@ -300,7 +843,9 @@ func (h *EvidenceHandler) Create(ctx echo.Context) error {
 			Value: value,
 		}
 		labels = append(labels, model)
-		h.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model)
+		if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model).Error; err != nil {
+			return nil, fmt.Errorf("failed to create label %q: %w", name, err)
+		}
 	}
 
 	evidence := relational.Evidence{
@@ -321,48 +866,58 @@ func (h *EvidenceHandler) Create(ctx echo.Context) error {
 			out.UnmarshalOscal(ol)
 			return out
 		}),
-		Status: datatypes.NewJSONType(input.Status),
+		Status:      datatypes.NewJSONType(input.Status),
+		Attachments: attachments,
 	}
+	input.applyVerification(&evidence)
 
-	if err := h.db.Create(&evidence).Error; err != nil {
-		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	if err := db.Create(&evidence).Error; err != nil {
+		return nil, fmt.Errorf("failed to create evidence: %w", err)
 	}
 
-	if err = h.db.Model(&evidence).Association("Activities").Append(activities); err != nil {
-		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	if err := db.Model(&evidence).Association("Activities").Append(activities); err != nil {
+		return nil, fmt.Errorf("failed to associate activities: %w", err)
 	}
 
-	if err = h.db.Model(&evidence).Association("InventoryItems").Append(inventoryItems); err != nil {
-		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	if err := db.Model(&evidence).Association("InventoryItems").Append(inventoryItems); err != nil {
+		return nil, fmt.Errorf("failed to associate inventory items: %w", err)
 	}
 
-	if err = h.db.Model(&evidence).Association("Components").Append(components); err != nil {
-		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	if err := db.Model(&evidence).Association("Components").Append(components); err != nil {
+		return nil, fmt.Errorf("failed to associate components: %w", err)
 	}
 
-	if err = h.db.Model(&evidence).Association("Subjects").Append(subjects); err != nil {
-		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	if err := db.Model(&evidence).Association("Subjects").Append(subjects); err != nil {
+		return nil, fmt.Errorf("failed to associate subjects: %w", err)
 	}
 
-	if err = h.db.Model(&evidence).Association("Labels").Append(labels); err != nil {
-		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	if err := db.Model(&evidence).Association("Labels").Append(labels); err != nil {
+		return nil, fmt.Errorf("failed to associate labels: %w", err)
 	}
 
-	// Return a 201 Created response with no content.
-	return ctx.NoContent(http.StatusCreated)
+	return &evidence, nil
 }
 
 // Search godoc
 //
 //	@Summary		Search Evidence
-//	@Description	Searches Evidence records by label filters.
+//	@Description	Searches the latest Evidence of each stream by label filters, one page at a time.
+//	@Description	Pages are selected by page number, or by the cursor returned with the previous page when sorting by end.
 //	@Tags			Evidence
 //	@Accept			json
 //	@Produce		json
 //	@Param			filter	body		labelfilter.Filter	true	"Label filter"
-//	@Success		200		{object}	GenericDataListResponse[relational.Evidence]
+//	@Param			q		query		string				false	"Label filter query, such as 'provider=aws AND NOT env=dev', replacing the filter in the body"
+//	@Param			page	query		int					false	"Page number, starting at 1"
+//	@Param			limit	query		int					false	"Number of items per page"
+//	@Param			cursor	query		string				false	"Cursor returned as nextCursor by the previous page"
+//	@Param			sort	query		string				false	"Sort by end, status or title, prefixed with - for descending order. Defaults to -end"
+//	@Param			fields	query		string				false	"Comma-separated list of fields to return for each item"
+//	@Success		200		{object}	service.ListResponse[relational.Evidence]
+//	@Failure		400		{object}	api.Error
 //	@Failure		422		{object}	api.Error
 //	@Failure		500		{object}	api.Error
+//	@Security		OAuth2Password
 //	@Router			/evidence/search [post]
 func (h *EvidenceHandler) Search(ctx echo.Context) error {
 	var err error
@@ -373,18 +928,47 @@ func (h *EvidenceHandler) Search(ctx echo.Context) error {
 	if err = req.bind(ctx, filter); err != nil {
 		return ctx.JSON(http.StatusUnprocessableEntity, api.NewError(err))
 	}
+	params, err := parseEvidenceListParams(ctx, h.pagination)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
 
-	results := []relational.Evidence{}
-	query := h.db.Session(&gorm.Session{})
-	query, err = relational.GetEvidenceSearchByFilterQuery(relational.GetLatestEvidenceStreamsQuery(h.db), h.db, *filter)
+	query, err := relational.GetEvidenceSearchByFilterQuery(relational.GetLatestEvidenceStreamsQuery(h.db), h.db, *filter)
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
 	}
-	if err = query.Preload("Labels").Find(&results).Error; err != nil {
-		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	if err = query.Count(&total).Error; err != nil {
+		return ctx.JSON(filterQueryErrorStatus(err), api.NewError(err))
+	}
+
+	results := []relational.Evidence{}
+	page := params.apply(query, "l", "l")
+	for _, preload := range params.preloads() {
+		page = page.Preload(preload)
+	}
+	if err = page.Find(&results).Error; err != nil {
+		return ctx.JSON(filterQueryErrorStatus(err), api.NewError(err))
+	}
+
+	next := ""
+	if params.hasMore(len(results)) {
+		results = results[:params.Limit]
+		last := results[len(results)-1]
+		next = params.nextCursor(last.End, last.ID)
+	}
+	items := make([]any, 0, len(results))
+	for _, result := range results {
+		items = append(items, result)
 	}
 
-	return ctx.JSON(http.StatusOK, GenericDataListResponse[relational.Evidence]{results})
+	response, err := params.response(items, total, next)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+	return ctx.JSON(http.StatusOK, response)
 }
 
 type OscalLikeEvidence struct {
@@ -397,6 +981,10 @@ type OscalLikeEvidence struct {
 	Components     []oscalTypes_1_1_3.SystemComponent   `json:"components,omitempty"`
 	Subjects       []oscalTypes_1_1_3.AssessmentSubject `json:"subjects,omitempty"`
 	Status         oscalTypes_1_1_3.ObjectiveStatus     `json:"status"`
+	// RelevantEvidence references the attachments of the evidence, where they can be downloaded.
+	RelevantEvidence []oscalTypes_1_1_3.RelevantEvidence `json:"relevant-evidence,omitempty"`
+	// Stale is set when the evidence has expired, or has not been seen within the staleness window.
+	Stale bool `json:"stale,omitempty"`
 }
 
 func (o *OscalLikeEvidence) FromEvidence(evidence *relational.Evidence) error {
@@ -408,6 +996,7 @@ func (o *OscalLikeEvidence) FromEvidence(evidence *relational.Evidence) error {
 	o.Start = evidence.Start
 	o.End = evidence.End
 	o.Expires = evidence.Expires
+	o.LastSeenAt = evidence.LastSeenAt
 	o.Labels = evidence.Labels
 	o.Props = *relational.ConvertPropsToOscal(evidence.Props)
 	o.Links = *relational.ConvertLinksToOscal(evidence.Links)
@@ -431,6 +1020,14 @@ func (o *OscalLikeEvidence) FromEvidence(evidence *relational.Evidence) error {
 		return out
 	}()
 	o.Status = evidence.Status.Data()
+	o.Attachments = evidence.Attachments
+	o.Verification = evidence.Verification
+	o.SigningKeyID = evidence.SigningKeyID
+	if evidence.ID != nil {
+		for _, attachment := range evidence.Attachments {
+			o.RelevantEvidence = append(o.RelevantEvidence, attachment.MarshalOscal(*evidence.ID))
+		}
+	}
 	return nil
 }
 
@@ -445,6 +1042,7 @@ func (o *OscalLikeEvidence) FromEvidence(evidence *relational.Evidence) error {
 //	@Failure		400	{object}	api.Error
 //	@Failure		404	{object}	api.Error
 //	@Failure		500	{object}	api.Error
+//	@Security		OAuth2Password
 //	@Router			/evidence/{id} [get]
 func (h *EvidenceHandler) Get(ctx echo.Context) error {
 	idParam := ctx.Param("id")
@@ -461,6 +1059,7 @@ func (h *EvidenceHandler) Get(ctx echo.Context) error {
 		Preload("InventoryItems").
 		Preload("Components").
 		Preload("Subjects").
+		Preload("Attachments").
 		First(&evidence, "id = ?", id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return ctx.JSON(http.StatusNotFound, api.NewError(err))
@@ -474,21 +1073,164 @@ func (h *EvidenceHandler) Get(ctx echo.Context) error {
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
 	}
+	output.Stale = evidence.IsStale(time.Now(), h.config.EvidenceStalenessWindow)
 
 	return ctx.JSON(http.StatusOK, GenericDataResponse[*OscalLikeEvidence]{Data: output})
 }
 
+// Diff godoc
+//
+//	@Summary		Compare two Evidence records of a stream
+//	@Description	Compares the status, labels, props, links, subjects, components, inventory items and activity steps of two Evidence records of the same stream.
+//	@Description	Without to, the latest evidence of the stream is compared. Without from, the evidence preceding to is compared.
+//	@Tags			Evidence
+//	@Produce		json
+//	@Param			id		path		string	true	"Evidence stream UUID"
+//	@Param			from	query		string	false	"ID of the earlier evidence"
+//	@Param			to		query		string	false	"ID of the later evidence"
+//	@Success		200		{object}	GenericDataResponse[relational.EvidenceDiff]
+//	@Failure		400		{object}	api.Error
+//	@Failure		404		{object}	api.Error
+//	@Failure		500		{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/evidence/{id}/diff [get]
+func (h *EvidenceHandler) Diff(ctx echo.Context) error {
+	idParam := ctx.Param("id")
+	stream, err := uuid.Parse(idParam)
+	if err != nil {
+		h.sugar.Warnw("Invalid evidence uuid", "uuid", idParam, "error", err)
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+	ids := map[string]*uuid.UUID{}
+	for _, name := range []string{"from", "to"} {
+		if value := ctx.QueryParam(name); value != "" {
+			id, err := uuid.Parse(value)
+			if err != nil {
+				return ctx.JSON(http.StatusBadRequest, api.NewError(fmt.Errorf("invalid %s parameter: %w", name, err)))
+			}
+			ids[name] = &id
+		}
+	}
+
+	to, err := h.findDiffEvidence(stream, ids["to"], nil)
+	if err != nil {
+		return h.diffError(ctx, err)
+	}
+	from, err := h.findDiffEvidence(stream, ids["from"], to)
+	if err != nil {
+		return h.diffError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, GenericDataResponse[relational.EvidenceDiff]{Data: relational.DiffEvidence(from, to)})
+}
+
+// Attachment godoc
+//
+//	@Summary		Download an Evidence attachment
+//	@Description	Downloads the raw content of an attachment of an Evidence record. The ETag is the SHA-256 hash of the content.
+//	@Tags			Evidence
+//	@Produce		octet-stream
+//	@Param			id		path		string	true	"Evidence ID"
+//	@Param			name	path		string	true	"Attachment name"
+//	@Success		200		{file}		file
+//	@Failure		400		{object}	api.Error
+//	@Failure		404		{object}	api.Error
+//	@Failure		500		{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/evidence/{id}/attachments/{name} [get]
+func (h *EvidenceHandler) Attachment(ctx echo.Context) error {
+	idParam := ctx.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.sugar.Warnw("Invalid evidence id", "id", idParam, "error", err)
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+	name, err := pathParam(ctx, "name")
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+
+	attachment := &relational.EvidenceAttachment{}
+	if err := h.db.First(attachment, "evidence_id = ? AND name = ?", id, name).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, api.NewError(err))
+		}
+		h.sugar.Warnw("Failed to load evidence attachment", "id", idParam, "name", name, "error", err)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	content, err := h.blobs.Get(ctx.Request().Context(), attachment.BlobKey)
+	if errors.Is(err, service.ErrBlobNotFound) {
+		return ctx.JSON(http.StatusNotFound, api.NewError(err))
+	}
+	if err != nil {
+		h.sugar.Errorw("Failed to read evidence attachment", "id", idParam, "name", name, "error", err)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+	defer content.Close()
+
+	response := ctx.Response()
+	response.Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Name}))
+	response.Header().Set(echo.HeaderContentLength, strconv.FormatInt(attachment.Size, 10))
+	response.Header().Set("ETag", strconv.Quote(attachment.BlobKey))
+	mediaType := attachment.MediaType
+	if mediaType == "" {
+		mediaType = echo.MIMEOctetStream
+	}
+	return ctx.Stream(http.StatusOK, mediaType, content)
+}
+
+// findDiffEvidence loads the evidence of the stream with the given ID, with the associations compared by a diff.
+// Without an ID, it loads the latest evidence of the stream ending before the given evidence, or the latest evidence
+// of the stream when no evidence is given.
+func (h *EvidenceHandler) findDiffEvidence(stream uuid.UUID, id *uuid.UUID, before *relational.Evidence) (*relational.Evidence, error) {
+	query := h.db.
+		Preload("Labels").
+		Preload("Activities.Steps").
+		Preload("InventoryItems").
+		Preload("Components").
+		Preload("Subjects.IncludeSubjects").
+		Where("uuid = ?", stream)
+	switch {
+	case id != nil:
+		query = query.Where("id = ?", *id)
+	case before != nil:
+		query = query.Where(`evidences."end" < ?`, before.End)
+	}
+
+	evidence := &relational.Evidence{}
+	if err := query.Order("evidences.end DESC").First(evidence).Error; err != nil {
+		return nil, err
+	}
+	return evidence, nil
+}
+
+func (h *EvidenceHandler) diffError(ctx echo.Context, err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ctx.JSON(http.StatusNotFound, api.NewError(errors.New("evidence not found in stream")))
+	}
+	h.sugar.Warnw("Failed to load evidence for diff", "error", err)
+	return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+}
+
 // History godoc
 //
 //	@Summary		Get Evidence history by UUID
 //	@Description	Retrieves a the history for a Evidence record by its UUID, including associated activities, inventory items, components, subjects, and labels.
+//	@Description	Each sighting of the evidence is a separate entry, and entries are returned one page at a time.
 //	@Tags			Evidence
 //	@Produce		json
-//	@Param			id	path		string	true	"Evidence ID"
-//	@Success		200	{object}	GenericDataListResponse[OscalLikeEvidence]
-//	@Failure		400	{object}	api.Error
-//	@Failure		404	{object}	api.Error
-//	@Failure		500	{object}	api.Error
+//	@Param			id		path		string	true	"Evidence ID"
+//	@Param			page	query		int		false	"Page number, starting at 1"
+//	@Param			limit	query		int		false	"Number of items per page"
+//	@Param			cursor	query		string	false	"Cursor returned as nextCursor by the previous page"
+//	@Param			sort	query		string	false	"Sort by end, status or title, prefixed with - for descending order. Defaults to -end"
+//	@Param			fields	query		string	false	"Comma-separated list of fields to return for each item"
+//	@Success		200		{object}	service.ListResponse[OscalLikeEvidence]
+//	@Failure		400		{object}	api.Error
+//	@Failure		404		{object}	api.Error
+//	@Failure		500		{object}	api.Error
+//	@Security		OAuth2Password
 //	@Router			/evidence/history/{id} [get]
 func (h *EvidenceHandler) History(ctx echo.Context) error {
 	idParam := ctx.Param("id")
@@ -497,48 +1239,184 @@ func (h *EvidenceHandler) History(ctx echo.Context) error {
 		h.sugar.Warnw("Invalid evidence id", "id", idParam, "error", err)
 		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
 	}
+	params, err := parseEvidenceListParams(ctx, h.pagination)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+
+	// Each sighting is returned as a separate entry, so repeated observations appear in the history
+	// just as they would had they been stored individually.
+	query := h.db.
+		Model(&relational.EvidenceSighting{}).
+		Joins("JOIN evidences ON evidences.id = evidence_sightings.evidence_id").
+		Where("evidences.uuid = ?", id).
+		Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		h.sugar.Warnw("Failed to count evidence sightings", "id", idParam, "error", err)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	var sightings []relational.EvidenceSighting
+	if err := params.apply(query, "evidence_sightings", "evidences").Find(&sightings).Error; err != nil {
+		h.sugar.Warnw("Failed to load evidence sightings", "id", idParam, "error", err)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+	next := ""
+	if params.hasMore(len(sightings)) {
+		sightings = sightings[:params.Limit]
+		last := sightings[len(sightings)-1]
+		next = params.nextCursor(last.End, last.ID)
+	}
+
+	ids := []uuid.UUID{}
+	for _, sighting := range sightings {
+		ids = append(ids, sighting.EvidenceID)
+	}
 	var evidences []relational.Evidence
-	if err := h.db.
-		Preload("Labels").
-		Preload("Activities").
-		Preload("Activities.Steps").
-		Preload("InventoryItems").
-		Preload("Components").
-		Preload("Subjects").
-		Order("evidences.end DESC").
-		Find(&evidences, "uuid = ?", id).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return ctx.JSON(http.StatusNotFound, api.NewError(err))
-		}
+	evidenceQuery := h.db.Session(&gorm.Session{})
+	for _, preload := range params.preloads() {
+		evidenceQuery = evidenceQuery.Preload(preload)
+	}
+	if err := evidenceQuery.Find(&evidences, "id IN ?", ids).Error; err != nil {
 		h.sugar.Warnw("Failed to load evidence", "id", idParam, "error", err)
 		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
 	}
 
-	output := []*OscalLikeEvidence{}
-
+	byID := map[uuid.UUID]*OscalLikeEvidence{}
 	for _, e := range evidences {
 		out := &OscalLikeEvidence{}
 		err = out.FromEvidence(&e)
 		if err != nil {
 			return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
 		}
-		output = append(output, out)
+		byID[*e.ID] = out
+	}
+
+	output := []any{}
+	for _, sighting := range sightings {
+		evidence, ok := byID[sighting.EvidenceID]
+		if !ok {
+			continue
+		}
+		out := *evidence
+		out.Start = sighting.Start
+		out.End = sighting.End
+		out.Expires = sighting.Expires
+		output = append(output, &out)
 	}
 
-	return ctx.JSON(http.StatusOK, GenericDataListResponse[*OscalLikeEvidence]{Data: output})
+	response, err := params.response(output, total, next)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// StaleEvidenceStream summarises the latest evidence of a stream which has gone stale.
+type StaleEvidenceStream struct {
+	ID         *uuid.UUID          `json:"id"`
+	UUID       uuid.UUID           `json:"uuid"`
+	Title      string              `json:"title"`
+	Status     string              `json:"status"`
+	End        time.Time           `json:"end"`
+	LastSeenAt *time.Time          `json:"last-seen-at,omitempty"`
+	Expires    *time.Time          `json:"expires,omitempty"`
+	Labels     []relational.Labels `json:"labels"`
+}
+
+// StaleEvidenceGroup lists the stale evidence streams sharing the same value for the grouping label.
+type StaleEvidenceGroup struct {
+	Value   string                `json:"value"`
+	Count   int                   `json:"count"`
+	Streams []StaleEvidenceStream `json:"streams"`
+}
+
+// Stale godoc
+//
+//	@Summary		List stale Evidence streams
+//	@Description	Lists evidence streams whose latest evidence has expired, or has not been seen within the configured staleness window, grouped by the value of a label. Streams without the label are grouped under an empty value.
+//	@Tags			Evidence
+//	@Produce		json
+//	@Param			label	query		string	false	"Label to group streams by"
+//	@Success		200		{object}	GenericDataListResponse[StaleEvidenceGroup]
+//	@Failure		500		{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/evidence/stale [get]
+func (h *EvidenceHandler) Stale(ctx echo.Context) error {
+	label := ctx.QueryParam("label")
+	now := time.Now()
+
+	q, err := relational.GetEvidenceSearchByFilterQuery(relational.GetLatestEvidenceStreamsQuery(h.db), h.db)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	evidence := []relational.Evidence{}
+	if err := q.
+		Where(relational.EvidenceStaleCondition(now, h.config.EvidenceStalenessWindow)).
+		Preload("Labels").
+		Order("l.end").
+		Find(&evidence).Error; err != nil {
+		h.sugar.Warnw("Failed to load stale evidence", "error", err)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	groups := map[string]*StaleEvidenceGroup{}
+	for _, e := range evidence {
+		value := ""
+		for _, l := range e.Labels {
+			if l.Name == label {
+				value = l.Value
+				break
+			}
+		}
+		group, ok := groups[value]
+		if !ok {
+			group = &StaleEvidenceGroup{Value: value, Streams: []StaleEvidenceStream{}}
+			groups[value] = group
+		}
+		group.Count++
+		group.Streams = append(group.Streams, StaleEvidenceStream{
+			ID:         e.ID,
+			UUID:       e.UUID,
+			Title:      e.Title,
+			Status:     e.Status.Data().State,
+			End:        e.End,
+			LastSeenAt: e.LastSeenAt,
+			Expires:    e.Expires,
+			Labels:     e.Labels,
+		})
+	}
+
+	results := make([]StaleEvidenceGroup, 0, len(groups))
+	for _, value := range slices.Sorted(maps.Keys(groups)) {
+		results = append(results, *groups[value])
+	}
+
+	return ctx.JSON(http.StatusOK, GenericDataListResponse[StaleEvidenceGroup]{Data: results})
 }
 
 // ForControl godoc
 //
 //	@Summary		List Evidence for a Control
 //	@Description	Retrieves Evidence records associated with a specific Control ID, including related activities, inventory items, components, subjects, and labels.
+//	@Description	Evidence is returned one page at a time, as for searches.
 //	@Tags			Evidence
 //	@Produce		json
-//	@Param			id	path		string	true	"Control ID"
-//	@Success		200	{object}	handler.ForControl.EvidenceDataListResponse
-//	@Failure		400	{object}	api.Error
-//	@Failure		404	{object}	api.Error
-//	@Failure		500	{object}	api.Error
+//	@Param			id		path		string	true	"Control ID"
+//	@Param			page	query		int		false	"Page number, starting at 1"
+//	@Param			limit	query		int		false	"Number of items per page"
+//	@Param			cursor	query		string	false	"Cursor returned as nextCursor by the previous page"
+//	@Param			sort	query		string	false	"Sort by end, status or title, prefixed with - for descending order. Defaults to -end"
+//	@Param			fields	query		string	false	"Comma-separated list of fields to return for each item"
+//	@Success		200		{object}	handler.ForControl.EvidenceDataListResponse
+//	@Failure		400		{object}	api.Error
+//	@Failure		404		{object}	api.Error
+//	@Failure		422		{object}	api.Error
+//	@Failure		500		{object}	api.Error
+//	@Security		OAuth2Password
 //	@Router			/evidence/for-control/{id} [get]
 func (h *EvidenceHandler) ForControl(ctx echo.Context) error {
 	type responseMetadata struct {
@@ -546,8 +1424,13 @@ func (h *EvidenceHandler) ForControl(ctx echo.Context) error {
 	}
 	type EvidenceDataListResponse struct {
 		Metadata responseMetadata `json:"metadata"`
-		// Items from the list response
-		Data []OscalLikeEvidence `json:"data" yaml:"data"`
+		// Items from the list response, along with the pagination details
+		*service.ListResponse[any]
+	}
+
+	params, err := parseEvidenceListParams(ctx, h.pagination)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
 	}
 
 	id := ctx.Param("id")
@@ -559,53 +1442,66 @@ func (h *EvidenceHandler) ForControl(ctx echo.Context) error {
 		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
 	}
 
-	response := EvidenceDataListResponse{
-		Metadata: responseMetadata{
-			Control: control.MarshalOscal(),
-		},
-	}
-
 	filters := []labelfilter.Filter{}
 	for _, filter := range control.Filters {
 		filters = append(filters, filter.Filter.Data())
 	}
 
-	type StatusCount struct {
-		Count  int64  `json:"count"`
-		Status string `json:"status"`
-	}
-
-	if len(filters) == 0 {
-		// If there are no filters assigned for the control, we should return nothing explicitly, otherwise we return everything implicitly
-		return ctx.JSON(http.StatusOK, GenericDataListResponse[StatusCount]{Data: []StatusCount{}})
-	}
+	items := []any{}
+	var total int64
+	next := ""
+	// If there are no filters assigned for the control, we should return nothing explicitly, otherwise we return everything implicitly
+	if len(filters) > 0 {
+		latestQuery := h.db.Session(&gorm.Session{})
+		latestQuery = relational.GetLatestEvidenceStreamsQuery(latestQuery)
+		q, err := relational.GetEvidenceSearchByFilterQuery(latestQuery, h.db, filters...)
+		if err != nil {
+			return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+		}
+		q = q.Session(&gorm.Session{})
 
-	latestQuery := h.db.Session(&gorm.Session{})
-	latestQuery = relational.GetLatestEvidenceStreamsQuery(latestQuery)
-	q, err := relational.GetEvidenceSearchByFilterQuery(latestQuery, h.db, filters...)
-	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
-	}
+		if err := q.Count(&total).Error; err != nil {
+			return ctx.JSON(filterQueryErrorStatus(err), api.NewError(err))
+		}
 
-	evidence := []relational.Evidence{}
-	if err := q.Model(&relational.Evidence{}).
-		Scan(&evidence).Error; err != nil {
-		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
-	}
+		evidence := []relational.Evidence{}
+		page := params.apply(q, "l", "l")
+		for _, preload := range params.preloads() {
+			page = page.Preload(preload)
+		}
+		if err := page.Find(&evidence).Error; err != nil {
+			return ctx.JSON(filterQueryErrorStatus(err), api.NewError(err))
+		}
+		if params.hasMore(len(evidence)) {
+			evidence = evidence[:params.Limit]
+			last := evidence[len(evidence)-1]
+			next = params.nextCursor(last.End, last.ID)
+		}
 
-	response.Data = []OscalLikeEvidence{}
-	for _, e := range evidence {
-		out := &OscalLikeEvidence{}
-		err = out.FromEvidence(&e)
-		if err != nil {
-			return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+		now := time.Now()
+		for _, e := range evidence {
+			out := &OscalLikeEvidence{}
+			err = out.FromEvidence(&e)
+			if err != nil {
+				return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+			}
+			out.Stale = e.IsStale(now, h.config.EvidenceStalenessWindow)
+			items = append(items, out)
 		}
-		response.Data = append(response.Data, *out)
 	}
 
-	return ctx.JSON(http.StatusOK, response)
+	list, err := params.response(items, total, next)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+	return ctx.JSON(http.StatusOK, EvidenceDataListResponse{
+		Metadata:     responseMetadata{Control: control.MarshalOscal()},
+		ListResponse: list,
+	})
 }
 
+// StatusCount is the number of evidence streams in a given objective state.
+// Streams whose latest evidence has expired, or has not been seen within the staleness window, are counted as "stale".
 type StatusCount struct {
 	Count  int64  `json:"count"`
 	Status string `json:"status"`
@@ -625,10 +1521,12 @@ type StatusInterval struct {
 //	@Produce		json
 //	@Param			filter		body		labelfilter.Filter	true	"Label filter"
 //	@Param			intervals	query		string				false	"Comma-separated list of duration intervals (e.g., '10m,1h,24h')"
+//	@Param			q			query		string				false	"Label filter query, such as 'provider=aws AND NOT env=dev', replacing the filter in the body"
 //	@Success		200			{object}	handler.GenericDataListResponse[StatusInterval]
 //	@Failure		400			{object}	api.Error
 //	@Failure		422			{object}	api.Error
 //	@Failure		500			{object}	api.Error
+//	@Security		OAuth2Password
 //	@Router			/evidence/status-over-time [post]
 func (h *EvidenceHandler) StatusOverTime(ctx echo.Context) error {
 	var err error
@@ -669,11 +1567,8 @@ func (h *EvidenceHandler) StatusOverTime(ctx echo.Context) error {
 				ch <- result{idx: i, err: err}
 				return
 			}
-			rows := []StatusCount{}
-			if err := q.Model(&relational.Evidence{}).
-				Select("count(*) as count, status->>'state' as status").
-				Group("status->>'state'").
-				Scan(&rows).Error; err != nil {
+			rows, err := h.countStatuses(q, now.Add(-d))
+			if err != nil {
 				ch <- result{idx: i, err: err}
 				return
 			}
@@ -685,7 +1580,7 @@ func (h *EvidenceHandler) StatusOverTime(ctx echo.Context) error {
 	for range intervals {
 		r := <-ch
 		if r.err != nil {
-			return ctx.JSON(http.StatusInternalServerError, api.NewError(r.err))
+			return ctx.JSON(filterQueryErrorStatus(r.err), api.NewError(r.err))
 		}
 		results[r.idx] = StatusInterval{Interval: r.interval, Statuses: r.data}
 	}
@@ -705,6 +1600,7 @@ func (h *EvidenceHandler) StatusOverTime(ctx echo.Context) error {
 //	@Failure		400			{object}	api.Error
 //	@Failure		422			{object}	api.Error
 //	@Failure		500			{object}	api.Error
+//	@Security		OAuth2Password
 //	@Router			/evidence/status-over-time/{id} [get]
 func (h *EvidenceHandler) StatusOverTimeByUUID(ctx echo.Context) error {
 	idParam := ctx.Param("id")
@@ -745,11 +1641,8 @@ func (h *EvidenceHandler) StatusOverTimeByUUID(ctx echo.Context) error {
 				ch <- result{idx: i, err: err}
 				return
 			}
-			rows := []StatusCount{}
-			if err := q.Model(&relational.Evidence{}).
-				Select("count(*) as count, status->>'state' as status").
-				Group("status->>'state'").
-				Scan(&rows).Error; err != nil {
+			rows, err := h.countStatuses(q, now.Add(-d))
+			if err != nil {
 				ch <- result{idx: i, err: err}
 				return
 			}
@@ -776,8 +1669,10 @@ func (h *EvidenceHandler) StatusOverTimeByUUID(ctx echo.Context) error {
 //	@Tags			Evidence
 //	@Produce		json
 //	@Param			id	path		string	true	"Control ID"
-//	@Success		200	{object}	GenericDataListResponse[handler.ComplianceByControl.StatusCount]
+//	@Success		200	{object}	GenericDataListResponse[StatusCount]
+//	@Failure		422	{object}	api.Error
 //	@Failure		500	{object}	api.Error
+//	@Security		OAuth2Password
 //	@Router			/evidence/compliance-by-control/{id} [get]
 func (h *EvidenceHandler) ComplianceByControl(ctx echo.Context) error {
 	id := ctx.Param("id")
@@ -794,11 +1689,6 @@ func (h *EvidenceHandler) ComplianceByControl(ctx echo.Context) error {
 		filters = append(filters, filter.Filter.Data())
 	}
 
-	type StatusCount struct {
-		Count  int64  `json:"count"`
-		Status string `json:"status"`
-	}
-
 	if len(filters) == 0 {
 		// If there are no filters assigned for the control, we should return nothing explicitly, otherwise we return everything implicitly
 		return ctx.JSON(http.StatusOK, GenericDataListResponse[StatusCount]{Data: []StatusCount{}})
@@ -811,13 +1701,23 @@ func (h *EvidenceHandler) ComplianceByControl(ctx echo.Context) error {
 		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
 	}
 
-	rows := []StatusCount{}
-	if err := q.Model(&relational.Evidence{}).
-		Select("count(*) as count, status->>'state' as status").
-		Group("status->>'state'").
-		Scan(&rows).Error; err != nil {
-		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	rows, err := h.countStatuses(q, time.Now())
+	if err != nil {
+		return ctx.JSON(filterQueryErrorStatus(err), api.NewError(err))
 	}
 
 	return ctx.JSON(http.StatusOK, GenericDataListResponse[StatusCount]{Data: rows})
 }
+
+// countStatuses counts the evidence selected by q, aliased as l, by its state at the given time.
+// Evidence which was stale at that time is counted under relational.EvidenceStatusStale.
+func (h *EvidenceHandler) countStatuses(q *gorm.DB, at time.Time) ([]StatusCount, error) {
+	states := q.Select("? as state", relational.EvidenceStateExpression(at, h.config.EvidenceStalenessWindow))
+
+	rows := []StatusCount{}
+	err := h.db.Table("(?) as states", states).
+		Select("count(*) as count, state as status").
+		Group("state").
+		Scan(&rows).Error
+	return rows, err
+}
@@ -3,20 +3,32 @@
 package handler
 
 import (
+	"bufio"
 	"bytes"
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/compliance-framework/api/internal"
 	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/config"
 	"github.com/compliance-framework/api/internal/converters/labelfilter"
+	"github.com/compliance-framework/api/internal/provenance"
+	"github.com/compliance-framework/api/internal/service"
 	"github.com/compliance-framework/api/internal/service/relational"
 	oscalTypes_1_1_3 "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
 	"gorm.io/datatypes"
+	"gorm.io/gorm"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -34,7 +46,10 @@ type EvidenceApiIntegrationSuite struct {
 }
 
 func (suite *EvidenceApiIntegrationSuite) TestCreate() {
-	err := suite.Migrator.Refresh()
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	err = suite.Migrator.Refresh()
 	suite.Require().NoError(err)
 
 	// Create two catalogs with the same group ID structure
@@ -159,6 +174,7 @@ func (suite *EvidenceApiIntegrationSuite) TestCreate() {
 	rec := httptest.NewRecorder()
 	reqBody, _ := json.Marshal(evidence)
 	req := httptest.NewRequest(http.MethodPost, "/api/evidence", bytes.NewReader(reqBody))
+	req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	server.E().ServeHTTP(rec, req)
 	assert.Equal(suite.T(), http.StatusCreated, rec.Code)
@@ -169,7 +185,503 @@ func (suite *EvidenceApiIntegrationSuite) TestCreate() {
 	suite.Equal(int64(1), count)
 }
 
+func (suite *EvidenceApiIntegrationSuite) TestCreateRollsBackOnFailure() {
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	newRequest := func() EvidenceCreateRequest {
+		return EvidenceCreateRequest{
+			UUID:  uuid.New(),
+			Title: "Evidence which should be rolled back",
+			Start: time.Now().Add(-time.Hour),
+			End:   time.Now().Add(-time.Hour).Add(time.Minute),
+			Labels: map[string]string{
+				"provider": "aws",
+			},
+			Activities: []EvidenceActivity{
+				{
+					UUID:  uuid.New(),
+					Title: "Collect evidence",
+					Steps: []EvidenceActivityStep{
+						{
+							UUID:  uuid.New(),
+							Title: "Run CLI to collect configuration",
+						},
+					},
+				},
+			},
+			Components: []EvidenceComponent{
+				{
+					Identifier: "components/common/ssh",
+					Type:       "software",
+					Title:      "Secure Shell (SSH)",
+				},
+			},
+			InventoryItems: []EvidenceInventoryItem{
+				{
+					Identifier: "web-server/ec2/i-12345",
+					Type:       "web-server",
+				},
+			},
+			Subjects: []EvidenceSubject{
+				{
+					Identifier: "web-server/ec2/i-12345",
+					Type:       "inventory-item",
+				},
+			},
+			Status: oscalTypes_1_1_3.ObjectiveStatus{State: "satisfied"},
+		}
+	}
+
+	assertNothingWritten := func() {
+		for _, model := range []any{
+			&relational.Evidence{},
+			&relational.EvidenceSighting{},
+			&relational.SystemComponent{},
+			&relational.InventoryItem{},
+			&relational.Activity{},
+			&relational.Step{},
+			&relational.AssessmentSubject{},
+			&relational.Labels{},
+		} {
+			var count int64
+			suite.Require().NoError(suite.DB.Model(model).Count(&count).Error)
+			suite.Equal(int64(0), count, "expected no rows for %T", model)
+		}
+		for _, table := range []string{"evidence_activities", "evidence_components", "evidence_inventory_items", "evidence_subjects", "evidence_labels"} {
+			var count int64
+			suite.Require().NoError(suite.DB.Table(table).Count(&count).Error)
+			suite.Equal(int64(0), count, "expected no rows in %s", table)
+		}
+	}
+
+	post := func(evidence EvidenceCreateRequest) *httptest.ResponseRecorder {
+		logger, _ := zap.NewDevelopment()
+		server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+		RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+		rec := httptest.NewRecorder()
+		reqBody, _ := json.Marshal(evidence)
+		req := httptest.NewRequest(http.MethodPost, "/api/evidence", bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		server.E().ServeHTTP(rec, req)
+		return rec
+	}
+
+	for _, table := range []string{"labels", "evidences", "evidence_subjects"} {
+		suite.Run("Failure writing "+table, func() {
+			err := suite.Migrator.Refresh()
+			suite.Require().NoError(err)
+
+			callback := "test:fail_" + table
+			err = suite.DB.Callback().Create().Before("gorm:create").Register(callback, func(tx *gorm.DB) {
+				if tx.Statement.Table == table {
+					tx.AddError(errors.New("injected failure"))
+				}
+			})
+			suite.Require().NoError(err)
+			defer suite.DB.Callback().Create().Remove(callback)
+
+			rec := post(newRequest())
+			suite.Equal(http.StatusInternalServerError, rec.Code)
+			suite.Contains(rec.Body.String(), "injected failure")
+			assertNothingWritten()
+		})
+	}
+
+	suite.Run("Invalid data is rejected as a client error", func() {
+		err := suite.Migrator.Refresh()
+		suite.Require().NoError(err)
+
+		evidence := newRequest()
+		// Postgres rejects NUL characters in text columns
+		evidence.Labels["invalid"] = "nul\x00value"
+
+		rec := post(evidence)
+		suite.Equal(http.StatusBadRequest, rec.Code)
+		assertNothingWritten()
+	})
+}
+
+func (suite *EvidenceApiIntegrationSuite) TestCreateBatch() {
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	err = suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	stream := uuid.New()
+	end := time.Now().Add(-time.Hour).Add(time.Minute).UTC().Truncate(time.Microsecond)
+	existing := relational.Evidence{
+		UUID:  stream,
+		Title: "Existing",
+		Start: end.Add(-time.Minute),
+		End:   end,
+	}
+	suite.Require().NoError(suite.DB.Create(&existing).Error)
+
+	evidence := []EvidenceCreateRequest{
+		{
+			UUID:   uuid.New(),
+			Title:  "Valid",
+			Start:  time.Now().Add(-time.Hour),
+			End:    time.Now().Add(-time.Hour).Add(time.Minute),
+			Labels: map[string]string{"provider": "aws"},
+			Status: oscalTypes_1_1_3.ObjectiveStatus{State: "satisfied"},
+		},
+		{
+			// Missing UUID, Start and End
+			Title: "Invalid",
+		},
+		{
+			UUID:  stream,
+			Title: "Conflicts with existing evidence",
+			Start: end.Add(-time.Minute),
+			End:   end,
+		},
+		{
+			UUID:   stream,
+			Title:  "Newer evidence for the existing stream",
+			Start:  end,
+			End:    end.Add(time.Minute),
+			Labels: map[string]string{"provider": "aws"},
+		},
+	}
+
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+	rec := httptest.NewRecorder()
+	reqBody, _ := json.Marshal(evidence)
+	req := httptest.NewRequest(http.MethodPost, "/api/evidence/batch", bytes.NewReader(reqBody))
+	req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	server.E().ServeHTTP(rec, req)
+	suite.Equal(http.StatusMultiStatus, rec.Code)
+
+	response := &GenericDataListResponse[EvidenceBatchItemResult]{}
+	suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+	suite.Require().Len(response.Data, 4)
+	suite.Equal(EvidenceBatchStatusCreated, response.Data[0].Status)
+	suite.NotNil(response.Data[0].ID)
+	suite.Equal(EvidenceBatchStatusInvalid, response.Data[1].Status)
+	suite.Contains(response.Data[1].Errors, "UUID")
+	suite.Equal(EvidenceBatchStatusConflict, response.Data[2].Status)
+	suite.Equal(EvidenceBatchStatusCreated, response.Data[3].Status)
+
+	var count int64
+	suite.DB.Model(&relational.Evidence{}).Count(&count)
+	suite.Equal(int64(3), count)
+
+	suite.Run("Chunks are written independently", func() {
+		evidence := []EvidenceCreateRequest{
+			{
+				UUID:  uuid.New(),
+				Title: "First chunk",
+				Start: time.Now().Add(-time.Hour),
+				End:   time.Now().Add(-time.Hour).Add(time.Minute),
+			},
+			{
+				UUID:  uuid.New(),
+				Title: "Second chunk",
+				Start: time.Now().Add(-time.Hour),
+				End:   time.Now().Add(-time.Hour).Add(time.Minute),
+			},
+		}
+		rec := httptest.NewRecorder()
+		reqBody, _ := json.Marshal(evidence)
+		req := httptest.NewRequest(http.MethodPost, "/api/evidence/batch?chunk=1", bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		server.E().ServeHTTP(rec, req)
+		suite.Equal(http.StatusCreated, rec.Code)
+
+		var count int64
+		suite.DB.Model(&relational.Evidence{}).Count(&count)
+		suite.Equal(int64(5), count)
+	})
+
+	suite.Run("Invalid chunk sizes are rejected", func() {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/api/evidence/batch?chunk=0", bytes.NewReader([]byte("[]")))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		server.E().ServeHTTP(rec, req)
+		suite.Equal(http.StatusBadRequest, rec.Code)
+	})
+
+	suite.Run("Conflicts within a chunk which is rolled back fail", func() {
+		duplicate := EvidenceCreateRequest{
+			UUID:  uuid.New(),
+			Title: "Duplicated",
+			Start: time.Now().Add(-time.Hour),
+			End:   time.Now().Add(-time.Hour).Add(time.Minute),
+		}
+		evidence := []EvidenceCreateRequest{
+			duplicate,
+			duplicate,
+			{
+				UUID:  uuid.New(),
+				Title: "Invalid data",
+				Start: time.Now().Add(-time.Hour),
+				End:   time.Now().Add(-time.Hour).Add(time.Minute),
+				// Postgres rejects NUL characters in text columns
+				Labels: map[string]string{"invalid": "nul\x00value"},
+			},
+		}
+		rec := httptest.NewRecorder()
+		reqBody, _ := json.Marshal(evidence)
+		req := httptest.NewRequest(http.MethodPost, "/api/evidence/batch", bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		server.E().ServeHTTP(rec, req)
+		suite.Equal(http.StatusMultiStatus, rec.Code)
+
+		response := &GenericDataListResponse[EvidenceBatchItemResult]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		suite.Require().Len(response.Data, 3)
+		for _, result := range response.Data {
+			suite.Equal(EvidenceBatchStatusFailed, result.Status)
+		}
+	})
+
+	suite.Run("Batches of too many items are rejected", func() {
+		maxItems := suite.Config.EvidenceBatchMaxItems
+		suite.Config.EvidenceBatchMaxItems = 1
+		defer func() { suite.Config.EvidenceBatchMaxItems = maxItems }()
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/api/evidence/batch", bytes.NewReader([]byte("[{},{}]")))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		server.E().ServeHTTP(rec, req)
+		suite.Equal(http.StatusRequestEntityTooLarge, rec.Code)
+	})
+}
+
+func (suite *EvidenceApiIntegrationSuite) TestCreateDeduplicatesUnchangedEvidence() {
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	err = suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+
+	stream := uuid.New()
+	first := time.Now().Add(-4 * time.Hour).UTC().Truncate(time.Microsecond)
+	newRequest := func(end time.Time, state string) EvidenceCreateRequest {
+		return EvidenceCreateRequest{
+			UUID:    stream,
+			Title:   "Periodic evidence",
+			Start:   end.Add(-time.Minute),
+			End:     end,
+			Expires: internal.Pointer(end.Add(time.Hour)),
+			Labels: map[string]string{
+				"provider": "aws",
+				"service":  "EC2",
+			},
+			Components: []EvidenceComponent{
+				{Identifier: "common-components/aws-ec2", Type: "service", Title: "EC2"},
+			},
+			Subjects: []EvidenceSubject{
+				{Identifier: "i-12345", Type: "inventory-item"},
+			},
+			Status: oscalTypes_1_1_3.ObjectiveStatus{State: state},
+		}
+	}
+	post := func(evidence ...EvidenceCreateRequest) GenericDataListResponse[EvidenceBatchItemResult] {
+		rec := httptest.NewRecorder()
+		reqBody, _ := json.Marshal(evidence)
+		req := httptest.NewRequest(http.MethodPost, "/api/evidence/batch", bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		server.E().ServeHTTP(rec, req)
+		suite.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+		response := GenericDataListResponse[EvidenceBatchItemResult]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+		return response
+	}
+
+	response := post(
+		newRequest(first, "satisfied"),
+		newRequest(first.Add(time.Hour), "satisfied"),
+		newRequest(first.Add(2*time.Hour), "satisfied"),
+		newRequest(first.Add(3*time.Hour), "not-satisfied"),
+	)
+	suite.Require().Len(response.Data, 4)
+	suite.Equal(EvidenceBatchStatusCreated, response.Data[0].Status)
+	suite.Equal(EvidenceBatchStatusUnchanged, response.Data[1].Status)
+	suite.Equal(EvidenceBatchStatusUnchanged, response.Data[2].Status)
+	suite.Equal(EvidenceBatchStatusCreated, response.Data[3].Status)
+	suite.Equal(response.Data[0].ID, response.Data[1].ID)
+
+	var evidence []relational.Evidence
+	suite.Require().NoError(suite.DB.Order(`"end"`).Find(&evidence, "uuid = ?", stream).Error)
+	suite.Require().Len(evidence, 2)
+	suite.True(evidence[0].End.Equal(first), "the end of deduplicated evidence is not moved")
+	suite.Require().NotNil(evidence[0].LastSeenAt)
+	suite.True(evidence[0].LastSeenAt.Equal(first.Add(2 * time.Hour)))
+	suite.Require().NotNil(evidence[0].Expires)
+	suite.True(evidence[0].Expires.Equal(first.Add(3 * time.Hour)))
+
+	suite.Run("History returns every sighting", func() {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/evidence/history/"+stream.String(), nil)
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		server.E().ServeHTTP(rec, req)
+		suite.Require().Equal(http.StatusOK, rec.Code)
+
+		history := GenericDataListResponse[OscalLikeEvidence]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &history))
+		suite.Require().Len(history.Data, 4)
+		for i, state := range []string{"not-satisfied", "satisfied", "satisfied", "satisfied"} {
+			suite.Equal(state, history.Data[i].Status.State)
+			suite.True(history.Data[i].End.Equal(first.Add(time.Duration(3-i)*time.Hour)), "entry %d", i)
+		}
+	})
+
+	suite.Run("Status over time is unaffected", func() {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/evidence/status-over-time/"+stream.String()+"?intervals=0,90m,150m", nil)
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		server.E().ServeHTTP(rec, req)
+		suite.Require().Equal(http.StatusOK, rec.Code)
+
+		statuses := GenericDataListResponse[StatusInterval]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &statuses))
+		suite.Require().Len(statuses.Data, 3)
+		suite.Equal([]StatusCount{{Count: 1, Status: "not-satisfied"}}, statuses.Data[0].Statuses)
+		suite.Equal([]StatusCount{{Count: 1, Status: "satisfied"}}, statuses.Data[1].Statuses)
+		suite.Equal([]StatusCount{{Count: 1, Status: "satisfied"}}, statuses.Data[2].Statuses)
+	})
+
+	suite.Run("Changed labels create new evidence", func() {
+		changed := newRequest(first.Add(4*time.Hour), "not-satisfied")
+		changed.Labels["region"] = "eu-west-1"
+		response := post(changed)
+		suite.Equal(EvidenceBatchStatusCreated, response.Data[0].Status)
+
+		var count int64
+		suite.DB.Model(&relational.Evidence{}).Where("uuid = ?", stream).Count(&count)
+		suite.Equal(int64(3), count)
+	})
+
+	suite.Run("Sightings without an expiry keep the expiry of the evidence", func() {
+		sighting := newRequest(first.Add(5*time.Hour), "not-satisfied")
+		sighting.Labels["region"] = "eu-west-1"
+		sighting.Expires = nil
+		response := post(sighting)
+		suite.Equal(EvidenceBatchStatusUnchanged, response.Data[0].Status)
+
+		var evidence relational.Evidence
+		suite.Require().NoError(suite.DB.First(&evidence, "id = ?", response.Data[0].ID).Error)
+		suite.Require().NotNil(evidence.Expires)
+		suite.True(evidence.Expires.Equal(first.Add(5 * time.Hour)))
+		suite.True(evidence.LastSeenAt.Equal(first.Add(5 * time.Hour)))
+	})
+}
+
+func (suite *EvidenceApiIntegrationSuite) TestStaleEvidence() {
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	err = suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	window := suite.Config.EvidenceStalenessWindow
+	suite.Config.EvidenceStalenessWindow = 24 * time.Hour
+	defer func() { suite.Config.EvidenceStalenessWindow = window }()
+
+	now := time.Now()
+	create := func(title string, provider string, end time.Time, expires *time.Time) relational.Evidence {
+		evidence := relational.Evidence{
+			UUID:    uuid.New(),
+			Title:   title,
+			Start:   end.Add(-time.Minute),
+			End:     end,
+			Expires: expires,
+			Labels: []relational.Labels{
+				{Name: "provider", Value: provider},
+			},
+			Status: datatypes.NewJSONType(oscalTypes_1_1_3.ObjectiveStatus{State: "satisfied"}),
+		}
+		suite.Require().NoError(suite.DB.Create(&evidence).Error)
+		return evidence
+	}
+
+	current := create("Current", "aws", now.Add(-time.Hour), internal.Pointer(now.Add(time.Hour)))
+	expired := create("Expired", "aws", now.Add(-2*time.Hour), internal.Pointer(now.Add(-time.Hour)))
+	unseen := create("Unseen", "gcp", now.Add(-48*time.Hour), nil)
+
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		server.E().ServeHTTP(rec, req)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		return rec
+	}
+
+	suite.Run("Status over time reports stale evidence", func() {
+		for _, tc := range []struct {
+			evidence relational.Evidence
+			statuses []string
+		}{
+			{current, []string{"satisfied", "satisfied"}},
+			// The expired evidence was still valid 90 minutes ago
+			{expired, []string{relational.EvidenceStatusStale, "satisfied"}},
+			{unseen, []string{relational.EvidenceStatusStale, relational.EvidenceStatusStale}},
+		} {
+			rec := get("/api/evidence/status-over-time/" + tc.evidence.UUID.String() + "?intervals=0,90m")
+			response := GenericDataListResponse[StatusInterval]{}
+			suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+			suite.Require().Len(response.Data, 2)
+			for i, status := range tc.statuses {
+				suite.Equal([]StatusCount{{Count: 1, Status: status}}, response.Data[i].Statuses, "%s at interval %d", tc.evidence.Title, i)
+			}
+		}
+	})
+
+	suite.Run("Evidence reports whether it is stale", func() {
+		for _, tc := range []struct {
+			evidence relational.Evidence
+			stale    bool
+		}{
+			{current, false},
+			{expired, true},
+			{unseen, true},
+		} {
+			rec := get("/api/evidence/" + tc.evidence.ID.String())
+			response := GenericDataResponse[OscalLikeEvidence]{}
+			suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+			suite.Equal(tc.stale, response.Data.Stale, tc.evidence.Title)
+		}
+	})
+
+	suite.Run("Stale streams are grouped by label", func() {
+		rec := get("/api/evidence/stale?label=provider")
+		response := GenericDataListResponse[StaleEvidenceGroup]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+		suite.Require().Len(response.Data, 2)
+		suite.Equal("aws", response.Data[0].Value)
+		suite.Equal(1, response.Data[0].Count)
+		suite.Equal(expired.UUID, response.Data[0].Streams[0].UUID)
+		suite.Equal("gcp", response.Data[1].Value)
+		suite.Equal(unseen.UUID, response.Data[1].Streams[0].UUID)
+	})
+}
+
 func (suite *EvidenceApiIntegrationSuite) TestSearch() {
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
 	suite.Run("Returns the single latest evidence for a stream", func() {
 		err := suite.Migrator.Refresh()
 		suite.Require().NoError(err)
@@ -213,6 +725,7 @@ func (suite *EvidenceApiIntegrationSuite) TestSearch() {
 			Filter labelfilter.Filter
 		}{})
 		req := httptest.NewRequest(http.MethodPost, "/api/evidence/search", bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		server.E().ServeHTTP(rec, req)
 		assert.Equal(suite.T(), http.StatusOK, rec.Code)
@@ -265,6 +778,7 @@ func (suite *EvidenceApiIntegrationSuite) TestSearch() {
 			Filter labelfilter.Filter
 		}{})
 		req := httptest.NewRequest(http.MethodPost, "/api/evidence/search", bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		server.E().ServeHTTP(rec, req)
 		assert.Equal(suite.T(), http.StatusOK, rec.Code)
@@ -327,6 +841,7 @@ func (suite *EvidenceApiIntegrationSuite) TestSearch() {
 			},
 		})
 		req := httptest.NewRequest(http.MethodPost, "/api/evidence/search", bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		server.E().ServeHTTP(rec, req)
 		assert.Equal(suite.T(), http.StatusOK, rec.Code)
@@ -390,6 +905,7 @@ func (suite *EvidenceApiIntegrationSuite) TestSearch() {
 			},
 		})
 		req := httptest.NewRequest(http.MethodPost, "/api/evidence/search", bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		server.E().ServeHTTP(rec, req)
 		assert.Equal(suite.T(), http.StatusOK, rec.Code)
@@ -489,6 +1005,7 @@ func (suite *EvidenceApiIntegrationSuite) TestSearch() {
 			},
 		})
 		req := httptest.NewRequest(http.MethodPost, "/api/evidence/search", bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		server.E().ServeHTTP(rec, req)
 		assert.Equal(suite.T(), http.StatusOK, rec.Code)
@@ -502,8 +1019,343 @@ func (suite *EvidenceApiIntegrationSuite) TestSearch() {
 	})
 }
 
+func (suite *EvidenceApiIntegrationSuite) TestSearchOperators() {
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	err = suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	streams := map[string]map[string]string{
+		"web-1": {"provider": "AWS", "env": "prod", "port": "443", "version": "v1.10.0"},
+		"web-2": {"provider": "aws", "env": "staging", "port": "8080", "version": "1.9"},
+		"db-1":  {"provider": "GCP", "env": "prod_eu", "port": "5432", "version": "2.0.0-rc1"},
+		"job-1": {"provider": "azure", "port": "none"},
+	}
+	for title, labels := range streams {
+		evidence := relational.Evidence{
+			UUID:  uuid.New(),
+			Title: title,
+			Start: time.Now().Add(-time.Hour),
+			End:   time.Now().Add(-time.Hour).Add(time.Minute),
+		}
+		for name, value := range labels {
+			evidence.Labels = append(evidence.Labels, relational.Labels{Name: name, Value: value})
+		}
+		suite.Require().NoError(suite.DB.Create(&evidence).Error)
+	}
+
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+
+	search := func(condition labelfilter.Condition) *httptest.ResponseRecorder {
+		reqBody, _ := json.Marshal(struct {
+			Filter labelfilter.Filter
+		}{
+			Filter: labelfilter.Filter{
+				Scope: &labelfilter.Scope{Condition: &condition},
+			},
+		})
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/api/evidence/search", bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		server.E().ServeHTTP(rec, req)
+		return rec
+	}
+
+	cases := []struct {
+		name      string
+		condition labelfilter.Condition
+		expected  []string
+	}{
+		{"in", labelfilter.Condition{Label: "Provider", Operator: "in", Values: []string{"aws", "gcp"}}, []string{"db-1", "web-1", "web-2"}},
+		{"not in", labelfilter.Condition{Label: "provider", Operator: "not in", Values: []string{"AWS"}}, []string{"db-1", "job-1"}},
+		{"exists", labelfilter.Condition{Label: "env", Operator: "exists"}, []string{"db-1", "web-1", "web-2"}},
+		{"not exists", labelfilter.Condition{Label: "env", Operator: "not exists"}, []string{"job-1"}},
+		{"prefix", labelfilter.Condition{Label: "env", Operator: "prefix", Value: "PROD"}, []string{"db-1", "web-1"}},
+		{"prefix escapes wildcards", labelfilter.Condition{Label: "env", Operator: "prefix", Value: "prod_"}, []string{"db-1"}},
+		{"suffix", labelfilter.Condition{Label: "env", Operator: "suffix", Value: "ing"}, []string{"web-2"}},
+		{"like", labelfilter.Condition{Label: "env", Operator: "like", Value: "%o%"}, []string{"db-1", "web-1"}},
+		{"regex", labelfilter.Condition{Label: "provider", Operator: "~", Value: "^[a-z]+$"}, []string{"job-1", "web-2"}},
+		{"not regex", labelfilter.Condition{Label: "provider", Operator: "!~", Value: "^A"}, []string{"db-1", "job-1", "web-2"}},
+		{"numeric", labelfilter.Condition{Label: "port", Operator: ">=", Value: "5432"}, []string{"db-1", "web-2"}},
+		{"numeric ignores non numbers", labelfilter.Condition{Label: "port", Operator: "<", Value: "1000"}, []string{"web-1"}},
+		{"semver", labelfilter.Condition{Label: "version", Operator: "semver>", Value: "1.9.0"}, []string{"db-1", "web-1"}},
+		{"semver equals", labelfilter.Condition{Label: "version", Operator: "semver=", Value: "v1.9"}, []string{"web-2"}},
+	}
+	for _, test := range cases {
+		suite.Run(test.name, func() {
+			rec := search(test.condition)
+			suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+			response := &GenericDataListResponse[relational.Evidence]{}
+			suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+			titles := []string{}
+			for _, evidence := range response.Data {
+				titles = append(titles, evidence.Title)
+			}
+			suite.ElementsMatch(test.expected, titles)
+		})
+	}
+
+	suite.Run("Query language", func() {
+		q := url.QueryEscape(`provider in (aws, gcp) AND NOT (env=staging OR port >= 5000)`)
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/api/evidence/search?q="+q, nil)
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		server.E().ServeHTTP(rec, req)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		response := &GenericDataListResponse[relational.Evidence]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+		suite.Require().Len(response.Data, 1)
+		suite.Equal("web-1", response.Data[0].Title)
+
+		rec = httptest.NewRecorder()
+		req = httptest.NewRequest(http.MethodPost, "/api/evidence/search?q="+url.QueryEscape("provider in aws"), nil)
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		server.E().ServeHTTP(rec, req)
+		suite.Equal(http.StatusUnprocessableEntity, rec.Code)
+	})
+
+	suite.Run("Invalid conditions are rejected", func() {
+		for _, condition := range []labelfilter.Condition{
+			{Label: "provider", Operator: "contains", Value: "aws"},
+			{Label: "provider", Operator: "in"},
+			{Label: "provider", Operator: "~", Value: "("},
+			{Label: "port", Operator: ">", Value: "high"},
+			{Label: "version", Operator: "semver>", Value: "latest"},
+		} {
+			rec := search(condition)
+			suite.Equal(http.StatusUnprocessableEntity, rec.Code, condition.Operator)
+		}
+	})
+}
+
+func (suite *EvidenceApiIntegrationSuite) TestSearchFields() {
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	err = suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+
+	scanner := uuid.New()
+	create := func(title string, state string, age time.Duration, subject string, owner string) {
+		evidence := EvidenceCreateRequest{
+			UUID:   uuid.New(),
+			Title:  title,
+			Start:  time.Now().Add(-age - time.Minute),
+			End:    time.Now().Add(-age),
+			Labels: map[string]string{"provider": "aws"},
+			Props:  []oscalTypes_1_1_3.Property{{Name: "owner", Value: owner}},
+			Origins: []oscalTypes_1_1_3.Origin{
+				{Actors: []oscalTypes_1_1_3.OriginActor{{Type: "tool", ActorUuid: scanner.String()}}},
+			},
+			Components: []EvidenceComponent{
+				{Identifier: "components/common/ssh", Type: "software", Title: "SSH"},
+			},
+			Subjects: []EvidenceSubject{
+				{Identifier: subject, Type: "inventory-item"},
+			},
+			Status: oscalTypes_1_1_3.ObjectiveStatus{State: state},
+		}
+		rec := httptest.NewRecorder()
+		reqBody, _ := json.Marshal(evidence)
+		req := httptest.NewRequest(http.MethodPost, "/api/evidence", bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		server.E().ServeHTTP(rec, req)
+		suite.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	}
+	create("SSH password login", "not-satisfied", time.Hour, "web-server/ec2/i-1", "platform")
+	create("SSH root login", "satisfied", time.Hour, "web-server/ec2/i-2", "platform")
+	create("SSH banner", "not-satisfied", 48*time.Hour, "web-server/ec2/i-1", "security")
+
+	search := func(q string) []string {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/api/evidence/search?q="+url.QueryEscape(q), nil)
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		server.E().ServeHTTP(rec, req)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		response := &GenericDataListResponse[relational.Evidence]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+		titles := []string{}
+		for _, evidence := range response.Data {
+			titles = append(titles, evidence.Title)
+		}
+		return titles
+	}
+
+	suite.ElementsMatch([]string{"SSH password login", "SSH banner"}, search("@status=not-satisfied"))
+	suite.ElementsMatch([]string{"SSH root login", "SSH password login"}, search(`@title ~ "login$"`))
+	suite.ElementsMatch([]string{"SSH banner"}, search("@prop:owner=security"))
+	suite.ElementsMatch([]string{"SSH password login", "SSH root login"}, search("@end >= 24h"))
+	suite.ElementsMatch([]string{"SSH password login", "SSH banner"}, search("@subject=web-server/ec2/i-1"))
+	suite.ElementsMatch([]string{"SSH root login"}, search("@subject not in (web-server/ec2/i-1)"))
+	suite.Len(search("@component=components/common/ssh"), 3)
+	suite.Empty(search("@inventory-item exists"))
+	suite.ElementsMatch(
+		[]string{"SSH password login"},
+		search(fmt.Sprintf("@status=not-satisfied AND @origin=%s AND @subject=web-server/ec2/i-1 AND @end >= 24h AND provider=aws", scanner)),
+	)
+
+	suite.Require().NoError(suite.DB.Model(&relational.Evidence{}).Where("title = ?", "SSH banner").Update("last_seen_at", time.Now()).Error)
+	suite.ElementsMatch([]string{"SSH password login", "SSH root login", "SSH banner"}, search("@end >= 24h"), "Expected evidence seen again to end when it was last seen")
+
+	// Go accepts Unicode classes, which Postgres rejects when evaluating the expression.
+	rec := httptest.NewRecorder()
+	reqBody, _ := json.Marshal(filteredSearchRequest{Filter: labelfilter.Filter{Scope: &labelfilter.Scope{
+		Condition: &labelfilter.Condition{Field: labelfilter.FieldTitle, Operator: labelfilter.OperatorRegex, Value: `\pL`},
+	}}})
+	req := httptest.NewRequest(http.MethodPost, "/api/evidence/search", bytes.NewReader(reqBody))
+	req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	server.E().ServeHTTP(rec, req)
+	suite.Equal(http.StatusUnprocessableEntity, rec.Code, "Expected expressions rejected by the database to be the client's error")
+}
+
+func (suite *EvidenceApiIntegrationSuite) TestSearchPagination() {
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	err = suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	// Five streams, ending a minute apart, with the oldest stream having three pieces of evidence.
+	now := time.Now().Truncate(time.Second)
+	stream := uuid.New()
+	for i := 0; i < 5; i++ {
+		state := "satisfied"
+		if i%2 == 0 {
+			state = "not-satisfied"
+		}
+		evidence := relational.Evidence{
+			UUID:   uuid.New(),
+			Title:  fmt.Sprintf("Evidence %d", i),
+			Start:  now.Add(-time.Duration(i+1) * time.Minute),
+			End:    now.Add(-time.Duration(i) * time.Minute),
+			Labels: []relational.Labels{{Name: "provider", Value: "aws"}},
+			Status: datatypes.NewJSONType(oscalTypes_1_1_3.ObjectiveStatus{State: state}),
+		}
+		if i == 4 {
+			evidence.UUID = stream
+		}
+		suite.Require().NoError(suite.DB.Create(&evidence).Error)
+	}
+	for i := 1; i <= 2; i++ {
+		suite.Require().NoError(suite.DB.Create(&relational.Evidence{
+			UUID:  stream,
+			Title: "Evidence 4",
+			Start: now.Add(-time.Duration(10+i) * time.Minute),
+			End:   now.Add(-time.Duration(10+i) * time.Minute),
+		}).Error)
+	}
+
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+
+	request := func(method string, path string) (int, *service.ListResponse[map[string]any]) {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		server.E().ServeHTTP(rec, req)
+		response := &service.ListResponse[map[string]any]{}
+		if rec.Code == http.StatusOK {
+			suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		}
+		return rec.Code, response
+	}
+	titles := func(response *service.ListResponse[map[string]any]) []string {
+		result := []string{}
+		for _, item := range response.Data {
+			result = append(result, item["title"].(string))
+		}
+		return result
+	}
+
+	suite.Run("Pages by number", func() {
+		code, response := request(http.MethodPost, "/api/evidence/search?limit=2&page=2")
+		suite.Require().Equal(http.StatusOK, code)
+		suite.Equal(int64(5), response.Total)
+		suite.Equal(3, response.TotalPages)
+		suite.Equal([]string{"Evidence 2", "Evidence 3"}, titles(response))
+	})
+
+	suite.Run("Pages by cursor", func() {
+		seen := []string{}
+		path := "/api/evidence/search?limit=2"
+		for pages := 0; pages < 5; pages++ {
+			code, response := request(http.MethodPost, path)
+			suite.Require().Equal(http.StatusOK, code)
+			seen = append(seen, titles(response)...)
+			if response.NextCursor == "" {
+				break
+			}
+			path = "/api/evidence/search?limit=2&cursor=" + response.NextCursor
+		}
+		suite.Equal([]string{"Evidence 0", "Evidence 1", "Evidence 2", "Evidence 3", "Evidence 4"}, seen)
+	})
+
+	suite.Run("Sorts", func() {
+		code, response := request(http.MethodPost, "/api/evidence/search?sort=end")
+		suite.Require().Equal(http.StatusOK, code)
+		suite.Equal([]string{"Evidence 4", "Evidence 3", "Evidence 2", "Evidence 1", "Evidence 0"}, titles(response))
+
+		code, response = request(http.MethodPost, "/api/evidence/search?sort=-title&limit=1")
+		suite.Require().Equal(http.StatusOK, code)
+		suite.Equal([]string{"Evidence 4"}, titles(response))
+		suite.Empty(response.NextCursor)
+
+		code, response = request(http.MethodPost, "/api/evidence/search?sort=status")
+		suite.Require().Equal(http.StatusOK, code)
+		suite.Require().Len(response.Data, 5)
+		suite.ElementsMatch([]string{"Evidence 0", "Evidence 2", "Evidence 4"}, titles(response)[:3])
+		suite.ElementsMatch([]string{"Evidence 1", "Evidence 3"}, titles(response)[3:])
+	})
+
+	suite.Run("Selects fields", func() {
+		code, response := request(http.MethodPost, "/api/evidence/search?fields=id,title&limit=1")
+		suite.Require().Equal(http.StatusOK, code)
+		suite.Require().Len(response.Data, 1)
+		suite.Len(response.Data[0], 2)
+		suite.Contains(response.Data[0], "id")
+		suite.Equal("Evidence 0", response.Data[0]["title"])
+	})
+
+	suite.Run("Rejects invalid parameters", func() {
+		for _, query := range []string{"sort=colour", "cursor=not-a-cursor", "sort=title&cursor=e30", "page=0"} {
+			code, _ := request(http.MethodPost, "/api/evidence/search?"+query)
+			suite.Equal(http.StatusBadRequest, code, query)
+		}
+	})
+
+	suite.Run("Pages through history", func() {
+		code, response := request(http.MethodGet, fmt.Sprintf("/api/evidence/history/%s?limit=2", stream))
+		suite.Require().Equal(http.StatusOK, code)
+		suite.Equal(int64(3), response.Total)
+		suite.Len(response.Data, 2)
+		suite.Require().NotEmpty(response.NextCursor)
+
+		code, response = request(http.MethodGet, fmt.Sprintf("/api/evidence/history/%s?limit=2&cursor=%s", stream, response.NextCursor))
+		suite.Require().Equal(http.StatusOK, code)
+		suite.Len(response.Data, 1)
+		suite.Empty(response.NextCursor)
+	})
+}
+
 func (suite *EvidenceApiIntegrationSuite) TestStatusOverTime() {
-	err := suite.Migrator.Refresh()
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	err = suite.Migrator.Refresh()
 	suite.Require().NoError(err)
 
 	stream := uuid.New()
@@ -549,6 +1401,7 @@ func (suite *EvidenceApiIntegrationSuite) TestStatusOverTime() {
 		Filter labelfilter.Filter
 	}{})
 	req := httptest.NewRequest(http.MethodPost, "/api/evidence/status-over-time", bytes.NewReader(reqBody))
+	req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	server.E().ServeHTTP(rec, req)
 	assert.Equal(suite.T(), http.StatusOK, rec.Code)
@@ -595,3 +1448,587 @@ func (suite *EvidenceApiIntegrationSuite) TestStatusOverTime() {
 	suite.Equal(int64(0), counts["satisfied"])
 	suite.Equal(int64(1), counts["not-satisfied"])
 }
+
+func (suite *EvidenceApiIntegrationSuite) TestStream() {
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	err = suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+	httpServer := httptest.NewServer(server.E())
+	defer httpServer.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, httpServer.URL+"/api/evidence/stream?q="+url.QueryEscape("provider=aws"), nil)
+	suite.Require().NoError(err)
+	req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+	res, err := http.DefaultClient.Do(req)
+	suite.Require().NoError(err)
+	defer res.Body.Close()
+	suite.Require().Equal(http.StatusOK, res.StatusCode)
+	suite.Equal("text/event-stream", res.Header.Get(echo.HeaderContentType))
+
+	stream := uuid.New()
+	create := func(provider string, state string, end time.Time) {
+		evidence := EvidenceCreateRequest{
+			UUID:   stream,
+			Title:  "Stream",
+			Start:  end.Add(-time.Minute),
+			End:    end,
+			Labels: map[string]string{"provider": provider},
+			Status: oscalTypes_1_1_3.ObjectiveStatus{State: state},
+		}
+		reqBody, _ := json.Marshal(evidence)
+		req := httptest.NewRequest(http.MethodPost, "/api/evidence", bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		server.E().ServeHTTP(rec, req)
+		suite.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	}
+	now := time.Now()
+	create("gcp", "satisfied", now.Add(-3*time.Hour))
+	create("aws", "satisfied", now.Add(-2*time.Hour))
+	// Unchanged evidence is recorded as a sighting, and is not streamed.
+	create("aws", "satisfied", now.Add(-90*time.Minute))
+	create("aws", "not-satisfied", now.Add(-time.Hour))
+
+	reader := bufio.NewReader(res.Body)
+	next := func() (string, service.EvidenceEvent) {
+		name := ""
+		event := service.EvidenceEvent{}
+		for {
+			line, err := reader.ReadString('\n')
+			suite.Require().NoError(err)
+			line = strings.TrimSuffix(line, "\n")
+			switch {
+			case line == "" && name != "":
+				return name, event
+			case strings.HasPrefix(line, "event: "):
+				name = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				suite.Require().NoError(json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event))
+			}
+		}
+	}
+
+	name, event := next()
+	suite.Equal("evidence.created", name)
+	suite.Equal("satisfied", event.State)
+	suite.Equal("aws", event.Evidence.Labels[0].Value)
+
+	name, event = next()
+	suite.Equal("evidence.created", name)
+	suite.Equal("not-satisfied", event.State)
+
+	name, event = next()
+	suite.Equal("evidence.transitioned", name)
+	suite.Equal("satisfied", event.PreviousState)
+	suite.Equal("not-satisfied", event.State)
+	suite.Equal(stream, event.Evidence.UUID)
+
+	suite.Run("Invalid filter", func() {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/evidence/stream?q="+url.QueryEscape("provider"), nil)
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		server.E().ServeHTTP(rec, req)
+		suite.Equal(http.StatusUnprocessableEntity, rec.Code)
+	})
+}
+
+func (suite *EvidenceApiIntegrationSuite) TestTransitions() {
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	err = suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+
+	create := func(stream uuid.UUID, provider string, state string, end time.Time) {
+		evidence := EvidenceCreateRequest{
+			UUID:   stream,
+			Title:  "Transitions",
+			Start:  end.Add(-time.Minute),
+			End:    end,
+			Labels: map[string]string{"provider": provider},
+			Status: oscalTypes_1_1_3.ObjectiveStatus{State: state},
+		}
+		reqBody, _ := json.Marshal(evidence)
+		req := httptest.NewRequest(http.MethodPost, "/api/evidence", bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		server.E().ServeHTTP(rec, req)
+		suite.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	}
+	get := func(path string, response any) {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		server.E().ServeHTTP(rec, req)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+	}
+
+	aws, gcp := uuid.New(), uuid.New()
+	start := time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+	create(aws, "aws", "satisfied", start)
+	create(aws, "aws", "not-satisfied", start.Add(time.Hour))
+	create(aws, "aws", "not-satisfied", start.Add(2*time.Hour))
+	create(aws, "aws", "satisfied", start.Add(4*time.Hour))
+	create(gcp, "gcp", "not-satisfied", start)
+
+	suite.Run("List", func() {
+		response := &service.ListResponse[relational.EvidenceTransition]{}
+		get("/api/evidence/transitions?uuid="+aws.String(), response)
+		suite.Equal(int64(3), response.Total)
+		suite.Require().Len(response.Data, 3)
+		suite.Equal("not-satisfied", response.Data[0].PreviousState)
+		suite.Equal("satisfied", response.Data[0].State)
+		suite.Equal("satisfied", response.Data[1].PreviousState)
+		suite.Equal("not-satisfied", response.Data[1].State)
+		suite.Equal("", response.Data[2].PreviousState)
+		suite.Nil(response.Data[2].PreviousEvidenceID)
+	})
+
+	suite.Run("Filter and range", func() {
+		response := &service.ListResponse[relational.EvidenceTransition]{}
+		get("/api/evidence/transitions?q="+url.QueryEscape("@status=not-satisfied"), response)
+		suite.Equal(int64(2), response.Total)
+
+		get("/api/evidence/transitions?from="+url.QueryEscape(start.Add(30*time.Minute).Format(time.RFC3339))+"&to="+url.QueryEscape(start.Add(3*time.Hour).Format(time.RFC3339)), response)
+		suite.Require().Len(response.Data, 1)
+		suite.Equal(aws, response.Data[0].UUID)
+		suite.Equal("not-satisfied", response.Data[0].State)
+	})
+
+	suite.Run("MTTR", func() {
+		response := &GenericDataListResponse[relational.EvidenceRecovery]{}
+		get("/api/evidence/transitions/mttr?q="+url.QueryEscape("provider=aws"), response)
+		suite.Require().Len(response.Data, 1)
+		suite.Equal(aws, response.Data[0].UUID)
+		suite.Equal(1, response.Data[0].Failures)
+		suite.Equal(1, response.Data[0].Recoveries)
+		suite.Equal((3 * time.Hour).Seconds(), response.Data[0].MeanTimeToRecover)
+
+		get("/api/evidence/transitions/mttr?uuid="+gcp.String(), response)
+		suite.Require().Len(response.Data, 1)
+		suite.Equal(1, response.Data[0].Failures)
+		suite.Equal(0, response.Data[0].Recoveries)
+		suite.NotNil(response.Data[0].FailingSince)
+	})
+
+	suite.Run("MTTR range", func() {
+		a, b := uuid.New(), uuid.New()
+		at := func(hours int) time.Time {
+			return start.Add(time.Duration(hours) * time.Hour)
+		}
+		transition := func(stream uuid.UUID, previous string, state string, hours int) relational.EvidenceTransition {
+			return relational.EvidenceTransition{UUID: stream, PreviousState: previous, State: state, Timestamp: at(hours), EvidenceID: uuid.New()}
+		}
+		suite.Require().NoError(suite.DB.Create(&[]relational.EvidenceTransition{
+			transition(a, "", "satisfied", 0),
+			transition(a, "satisfied", "not-satisfied", 1),
+			transition(a, "not-satisfied", "other", 2),
+			transition(a, "other", "satisfied", 3),
+			transition(a, "satisfied", "not-satisfied", 10),
+			transition(a, "not-satisfied", "satisfied", 11),
+			transition(b, "", "not-satisfied", 0),
+			transition(b, "not-satisfied", "satisfied", 4),
+			transition(b, "satisfied", "not-satisfied", 20),
+		}).Error)
+		recovery := func(stream uuid.UUID, query string) relational.EvidenceRecovery {
+			response := &GenericDataListResponse[relational.EvidenceRecovery]{}
+			get("/api/evidence/transitions/mttr?uuid="+stream.String()+query, response)
+			suite.Require().Len(response.Data, 1)
+			return response.Data[0]
+		}
+
+		all := recovery(a, "")
+		suite.Equal(2, all.Failures)
+		suite.Equal(2, all.Recoveries)
+		suite.Equal((90 * time.Minute).Seconds(), all.MeanTimeToRecover)
+		suite.Nil(all.FailingSince)
+
+		all = recovery(b, "")
+		suite.Equal(2, all.Failures)
+		suite.Equal(1, all.Recoveries)
+		suite.Equal((4 * time.Hour).Seconds(), all.MeanTimeToRecover)
+		suite.Require().NotNil(all.FailingSince)
+		suite.True(at(20).Equal(*all.FailingSince))
+
+		between := "&from=" + url.QueryEscape(at(2).Format(time.RFC3339)) + "&to=" + url.QueryEscape(at(12).Format(time.RFC3339))
+		// The failure at 1h is before the range, but the recovery from it at 3h is timed from it.
+		ranged := recovery(a, between)
+		suite.Equal(1, ranged.Failures)
+		suite.Equal(2, ranged.Recoveries)
+		suite.Equal((90 * time.Minute).Seconds(), ranged.MeanTimeToRecover)
+
+		ranged = recovery(b, between)
+		suite.Equal(0, ranged.Failures)
+		suite.Equal(1, ranged.Recoveries)
+		suite.Nil(ranged.FailingSince, "failures after the range are ignored")
+	})
+
+	suite.Run("Invalid range", func() {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/evidence/transitions?from=yesterday", nil)
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		server.E().ServeHTTP(rec, req)
+		suite.Equal(http.StatusBadRequest, rec.Code)
+	})
+
+	suite.Run("Invalid filter", func() {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/evidence/transitions?q="+url.QueryEscape("provider ="), nil)
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		server.E().ServeHTTP(rec, req)
+		suite.Equal(http.StatusUnprocessableEntity, rec.Code)
+	})
+}
+
+func (suite *EvidenceApiIntegrationSuite) TestDiff() {
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	err = suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+
+	stream := uuid.New()
+	create := func(env string, state string, end time.Time) {
+		evidence := EvidenceCreateRequest{
+			UUID:   stream,
+			Title:  "Diff",
+			Start:  end.Add(-time.Minute),
+			End:    end,
+			Labels: map[string]string{"provider": "aws", "env": env},
+			Components: []EvidenceComponent{
+				{Identifier: "components/common/ssh", Type: "software", Title: "SSH"},
+			},
+			Status: oscalTypes_1_1_3.ObjectiveStatus{State: state},
+		}
+		reqBody, _ := json.Marshal(evidence)
+		req := httptest.NewRequest(http.MethodPost, "/api/evidence", bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		server.E().ServeHTTP(rec, req)
+		suite.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	}
+	diff := func(query string) (int, relational.EvidenceDiff) {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/evidence/"+stream.String()+"/diff"+query, nil)
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		server.E().ServeHTTP(rec, req)
+		response := GenericDataResponse[relational.EvidenceDiff]{}
+		if rec.Code == http.StatusOK {
+			suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+		}
+		return rec.Code, response.Data
+	}
+
+	now := time.Now()
+	create("prod", "satisfied", now.Add(-2*time.Hour))
+
+	code, _ := diff("")
+	suite.Equal(http.StatusNotFound, code, "a single evidence has nothing to compare against")
+
+	create("staging", "satisfied", now.Add(-time.Hour))
+	create("staging", "not-satisfied", now)
+
+	code, latest := diff("")
+	suite.Require().Equal(http.StatusOK, code)
+	suite.Equal("satisfied", latest.From.State)
+	suite.Equal("not-satisfied", latest.To.State)
+	suite.NotNil(latest.Status)
+	suite.Empty(latest.Labels.Changed)
+	suite.Empty(latest.Components.Added)
+	suite.Empty(latest.Components.Removed)
+
+	history := []relational.Evidence{}
+	suite.Require().NoError(suite.DB.Where("uuid = ?", stream).Order(`"end"`).Find(&history).Error)
+	suite.Require().Len(history, 3)
+
+	code, explicit := diff(fmt.Sprintf("?from=%s&to=%s", history[0].ID, history[1].ID))
+	suite.Require().Equal(http.StatusOK, code)
+	suite.Nil(explicit.Status)
+	suite.Require().Len(explicit.Labels.Changed, 1)
+	suite.Equal("env", explicit.Labels.Changed[0].Key)
+
+	code, _ = diff("?from=" + uuid.New().String())
+	suite.Equal(http.StatusNotFound, code)
+
+	code, _ = diff("?to=invalid")
+	suite.Equal(http.StatusBadRequest, code)
+}
+
+func (suite *EvidenceApiIntegrationSuite) TestAttachments() {
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	err = suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+
+	stream := uuid.New()
+	create := func(content string, end time.Time) {
+		evidence := EvidenceCreateRequest{
+			UUID:   stream,
+			Title:  "Attachments",
+			Start:  end.Add(-time.Minute),
+			End:    end,
+			Labels: map[string]string{"provider": "aws"},
+			Status: oscalTypes_1_1_3.ObjectiveStatus{State: "satisfied"},
+			Attachments: []EvidenceAttachment{
+				{Name: "sshd config.txt", Description: "SSH daemon configuration", Content: []byte(content)},
+			},
+		}
+		reqBody, _ := json.Marshal(evidence)
+		req := httptest.NewRequest(http.MethodPost, "/api/evidence", bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		server.E().ServeHTTP(rec, req)
+		suite.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+	}
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		server.E().ServeHTTP(rec, req)
+		return rec
+	}
+
+	now := time.Now()
+	create("PermitRootLogin no\n", now.Add(-time.Hour))
+
+	latest := relational.Evidence{}
+	suite.Require().NoError(suite.DB.Preload("Attachments").First(&latest, "uuid = ?", stream).Error)
+	suite.Require().Len(latest.Attachments, 1)
+	attachment := latest.Attachments[0]
+	suite.Equal("text/plain; charset=utf-8", attachment.MediaType)
+	suite.Equal(int64(19), attachment.Size)
+	suite.Require().Len(attachment.Hashes, 1)
+	suite.Equal(relational.HashAlgorithmSHA_256, attachment.Hashes[0].Algorithm)
+
+	rec := get(attachment.Href(*latest.ID))
+	suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	suite.Equal("PermitRootLogin no\n", rec.Body.String())
+	suite.Equal(fmt.Sprintf("%q", attachment.Hashes[0].Value), rec.Header().Get("ETag"))
+	suite.Contains(rec.Header().Get(echo.HeaderContentDisposition), "sshd config.txt")
+
+	rec = get("/api/evidence/" + latest.ID.String())
+	suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	response := GenericDataResponse[OscalLikeEvidence]{}
+	suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	suite.Require().Len(response.Data.RelevantEvidence, 1)
+	suite.Equal(attachment.Href(*latest.ID), response.Data.RelevantEvidence[0].Href)
+	suite.Equal("SSH daemon configuration", response.Data.RelevantEvidence[0].Description)
+
+	// The same attachment is only a sighting of the evidence, a changed one is new evidence.
+	create("PermitRootLogin no\n", now.Add(-30*time.Minute))
+	create("PermitRootLogin yes\n", now)
+	var count int64
+	suite.Require().NoError(suite.DB.Model(&relational.Evidence{}).Where("uuid = ?", stream).Count(&count).Error)
+	suite.Equal(int64(2), count)
+
+	suite.Equal(http.StatusNotFound, get("/api/evidence/"+latest.ID.String()+"/attachments/missing.txt").Code)
+	suite.Equal(http.StatusBadRequest, get("/api/evidence/invalid/attachments/missing.txt").Code)
+}
+
+func (suite *EvidenceApiIntegrationSuite) TestSignatures() {
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	err = suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+
+	agent := uuid.New()
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	suite.Require().NoError(err)
+	key := &relational.AgentSigningKey{
+		Name:      "agent",
+		AgentUUID: agent,
+		Algorithm: provenance.AlgorithmEd25519,
+		PublicKey: base64.StdEncoding.EncodeToString(public),
+	}
+	suite.Require().NoError(suite.DB.Create(key).Error)
+
+	newEvidence := func(end time.Time) EvidenceCreateRequest {
+		return EvidenceCreateRequest{
+			UUID:   uuid.New(),
+			Title:  "Signed",
+			Start:  end.Add(-time.Minute),
+			End:    end,
+			Labels: map[string]string{"provider": "aws"},
+			Origins: []oscalTypes_1_1_3.Origin{
+				{Actors: []oscalTypes_1_1_3.OriginActor{{Type: "tool", ActorUuid: agent.String()}}},
+			},
+			Status: oscalTypes_1_1_3.ObjectiveStatus{State: "satisfied"},
+		}
+	}
+	sign := func(evidence *EvidenceCreateRequest) {
+		document, err := json.Marshal(evidence)
+		suite.Require().NoError(err)
+		evidence.Signature, err = provenance.Sign(document, *key.ID, private)
+		suite.Require().NoError(err)
+	}
+	create := func(evidence EvidenceCreateRequest) *httptest.ResponseRecorder {
+		reqBody, _ := json.Marshal(evidence)
+		req := httptest.NewRequest(http.MethodPost, "/api/evidence", bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		server.E().ServeHTTP(rec, req)
+		return rec
+	}
+	verification := func(stream uuid.UUID) relational.Evidence {
+		evidence := relational.Evidence{}
+		suite.Require().NoError(suite.DB.First(&evidence, "uuid = ?", stream).Error)
+		return evidence
+	}
+
+	now := time.Now()
+	signed := newEvidence(now)
+	sign(&signed)
+	suite.Require().Equal(http.StatusCreated, create(signed).Code)
+	stored := verification(signed.UUID)
+	suite.Equal(relational.EvidenceVerificationVerified, stored.Verification)
+	suite.Equal(key.ID, stored.SigningKeyID)
+
+	unsigned := newEvidence(now)
+	suite.Require().Equal(http.StatusCreated, create(unsigned).Code)
+	suite.Equal(relational.EvidenceVerificationUnsigned, verification(unsigned.UUID).Verification)
+
+	tampered := newEvidence(now)
+	sign(&tampered)
+	tampered.Status.State = "not-satisfied"
+	suite.Require().Equal(http.StatusCreated, create(tampered).Code)
+	suite.Equal(relational.EvidenceVerificationInvalid, verification(tampered.UUID).Verification)
+
+	impersonated := newEvidence(now)
+	impersonated.Origins[0].Actors[0].ActorUuid = uuid.New().String()
+	sign(&impersonated)
+	suite.Require().Equal(http.StatusCreated, create(impersonated).Code)
+	suite.Equal(relational.EvidenceVerificationInvalid, verification(impersonated.UUID).Verification)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/evidence/search?q="+url.QueryEscape("@verification=verified"), nil)
+	req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+	server.E().ServeHTTP(rec, req)
+	suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+	results := service.ListResponse[map[string]any]{}
+	suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &results))
+	suite.Require().Len(results.Data, 1)
+	suite.Equal(signed.UUID.String(), results.Data[0]["uuid"])
+	suite.Equal("verified", results.Data[0]["verification"])
+
+	suite.Run("Required", func() {
+		suite.Config.EvidenceSignaturePolicy = config.SignaturePolicyRequire
+		defer func() { suite.Config.EvidenceSignaturePolicy = "" }()
+
+		suite.Equal(http.StatusBadRequest, create(newEvidence(now)).Code)
+
+		signed := newEvidence(now)
+		sign(&signed)
+		suite.Equal(http.StatusCreated, create(signed).Code)
+
+		revokedAt := now.Add(-time.Minute)
+		suite.Require().NoError(suite.DB.Model(key).Update("revoked_at", revokedAt).Error)
+		revoked := newEvidence(now)
+		sign(&revoked)
+		suite.Equal(http.StatusBadRequest, create(revoked).Code)
+	})
+}
+
+func (suite *EvidenceApiIntegrationSuite) TestLabelCatalog() {
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	err = suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	// Three streams on aws with a hostname each, and one on gcp. The first stream used to be on azure, and the last on
+	// aws has the provider in upper case.
+	now := time.Now()
+	streams := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+	create := func(stream uuid.UUID, end time.Time, labels ...relational.Labels) {
+		suite.Require().NoError(suite.DB.Create(&relational.Evidence{
+			UUID:   stream,
+			Title:  "Labels",
+			Start:  end.Add(-time.Minute),
+			End:    end,
+			Labels: labels,
+		}).Error)
+	}
+	create(streams[0], now.Add(-time.Hour), relational.Labels{Name: "provider", Value: "azure"})
+	for i, stream := range streams[:3] {
+		provider := "aws"
+		if i == 2 {
+			provider = "AWS"
+		}
+		create(stream, now, relational.Labels{Name: "provider", Value: provider}, relational.Labels{Name: "hostname", Value: fmt.Sprintf("web-%d", i)})
+	}
+	create(streams[3], now, relational.Labels{Name: "Provider", Value: "gcp"})
+
+	cardinalityLimit := suite.Config.EvidenceLabelCardinalityLimit
+	suite.Config.EvidenceLabelCardinalityLimit = 2
+	defer func() { suite.Config.EvidenceLabelCardinalityLimit = cardinalityLimit }()
+
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+
+	get := func(path string, response any) int {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		server.E().ServeHTTP(rec, req)
+		if rec.Code == http.StatusOK {
+			suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		}
+		return rec.Code
+	}
+
+	names := GenericDataListResponse[relational.EvidenceLabelName]{}
+	suite.Require().Equal(http.StatusOK, get("/api/evidence/labels", &names))
+	suite.Equal([]relational.EvidenceLabelName{
+		{Name: "hostname", ValueCount: 3, Streams: 3, HighCardinality: true},
+		{Name: "provider", ValueCount: 2, Streams: 4},
+	}, names.Data, "only the latest evidence of each stream is counted, and names and values are grouped regardless of case")
+
+	filtered := GenericDataListResponse[relational.EvidenceLabelName]{}
+	suite.Require().Equal(http.StatusOK, get("/api/evidence/labels?q="+url.QueryEscape("provider=gcp"), &filtered))
+	suite.Equal([]relational.EvidenceLabelName{{Name: "provider", ValueCount: 1, Streams: 1}}, filtered.Data)
+
+	values := service.ListResponse[relational.EvidenceLabelValue]{}
+	suite.Require().Equal(http.StatusOK, get("/api/evidence/labels/provider/values", &values))
+	suite.Equal(int64(2), values.Total)
+	suite.Equal([]relational.EvidenceLabelValue{{Value: "aws", Streams: 3}, {Value: "gcp", Streams: 1}}, values.Data)
+
+	prefixed := service.ListResponse[relational.EvidenceLabelValue]{}
+	suite.Require().Equal(http.StatusOK, get("/api/evidence/labels/hostname/values?prefix=WEB-&limit=2&page=2", &prefixed))
+	suite.Equal(int64(3), prefixed.Total)
+	suite.Equal([]relational.EvidenceLabelValue{{Value: "web-2", Streams: 1}}, prefixed.Data)
+
+	suite.Equal(http.StatusUnprocessableEntity, get("/api/evidence/labels?q="+url.QueryEscape("provider ="), &names))
+}
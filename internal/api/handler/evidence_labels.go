@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/converters/labelfilter"
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// latestEvidenceQuery selects the latest evidence of each stream matching the q query parameter, or of every stream
+// when it is not set.
+func (h *EvidenceHandler) latestEvidenceQuery(ctx echo.Context) (*gorm.DB, error) {
+	filter := labelfilter.Filter{}
+	if err := bindFilterQuery(ctx, &filter); err != nil {
+		return nil, err
+	}
+	return relational.GetEvidenceSearchByFilterQuery(relational.GetLatestEvidenceStreamsQuery(h.db), h.db, filter)
+}
+
+// Labels godoc
+//
+//	@Summary		List Evidence label names
+//	@Description	Lists the label names used by the latest evidence of each stream, with the number of distinct values and streams for each.
+//	@Description	Labels with more distinct values than the configured cardinality limit are flagged, as they are rarely useful to filter on.
+//	@Description	Names are grouped regardless of case, as filters match them, and are listed in lower case.
+//	@Tags			Evidence
+//	@Produce		json
+//	@Param			q	query		string	false	"Label filter query, selecting the streams whose labels are listed"
+//	@Success		200	{object}	GenericDataListResponse[relational.EvidenceLabelName]
+//	@Failure		422	{object}	api.Error
+//	@Failure		500	{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/evidence/labels [get]
+func (h *EvidenceHandler) Labels(ctx echo.Context) error {
+	latest, err := h.latestEvidenceQuery(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusUnprocessableEntity, api.NewError(err))
+	}
+
+	names := []relational.EvidenceLabelName{}
+	if err := relational.GetEvidenceLabelNamesQuery(h.db, latest).
+		Order("name").
+		Scan(&names).Error; err != nil {
+		h.sugar.Errorw("Failed to list evidence labels", "error", err)
+		return ctx.JSON(filterQueryErrorStatus(err), api.NewError(err))
+	}
+	for i := range names {
+		if names[i].ValueCount > int64(h.config.EvidenceLabelCardinalityLimit) {
+			names[i].HighCardinality = true
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, GenericDataListResponse[relational.EvidenceLabelName]{Data: names})
+}
+
+// LabelValues godoc
+//
+//	@Summary		List Evidence label values
+//	@Description	Lists the values of a label used by the latest evidence of each stream, with the number of streams using each, most used first.
+//	@Tags			Evidence
+//	@Produce		json
+//	@Param			name	path		string	true	"Label name"
+//	@Param			prefix	query		string	false	"Only list values starting with this prefix, regardless of case"
+//	@Param			q		query		string	false	"Label filter query, selecting the streams whose label values are listed"
+//	@Param			page	query		int		false	"Page number, starting at 1"
+//	@Param			limit	query		int		false	"Number of items per page"
+//	@Success		200		{object}	service.ListResponse[relational.EvidenceLabelValue]
+//	@Failure		400		{object}	api.Error
+//	@Failure		422		{object}	api.Error
+//	@Failure		500		{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/evidence/labels/{name}/values [get]
+func (h *EvidenceHandler) LabelValues(ctx echo.Context) error {
+	latest, err := h.latestEvidenceQuery(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusUnprocessableEntity, api.NewError(err))
+	}
+	page, err := h.pagination.ParseParams(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+
+	name, err := pathParam(ctx, "name")
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+	query := relational.GetEvidenceLabelValuesQuery(h.db, latest, name, ctx.QueryParam("prefix")).Session(&gorm.Session{})
+
+	var total int64
+	if err := h.db.Table("(?) AS v", query).Count(&total).Error; err != nil {
+		h.sugar.Errorw("Failed to count evidence label values", "error", err)
+		return ctx.JSON(filterQueryErrorStatus(err), api.NewError(err))
+	}
+	values := []relational.EvidenceLabelValue{}
+	if err := query.
+		Order("streams DESC").
+		Order("value").
+		Limit(page.Limit).
+		Offset(page.Offset).
+		Scan(&values).Error; err != nil {
+		h.sugar.Errorw("Failed to list evidence label values", "error", err)
+		return ctx.JSON(filterQueryErrorStatus(err), api.NewError(err))
+	}
+
+	return ctx.JSON(http.StatusOK, service.NewListResponse(values, total, page.Page, page.Limit))
+}
@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// evidenceSortColumns maps the sort keys accepted by evidence list endpoints to the expressions they order by.
+// The end time is relative to the table being listed, and the other columns to the evidence table.
+var evidenceSortColumns = map[string]string{
+	"end":    `%s."end"`,
+	"status": `%s.status->>'state'`,
+	"title":  `%s.title`,
+}
+
+// evidencePreloads maps the JSON fields of evidence to the associations which must be loaded to populate them.
+var evidencePreloads = map[string][]string{
+	"labels":          {"Labels"},
+	"activities":      {"Activities", "Activities.Steps"},
+	"inventory-items": {"InventoryItems"},
+	"components":      {"Components"},
+	"subjects":        {"Subjects", "Subjects.IncludeSubjects"},
+	"attachments":     {"Attachments"},
+}
+
+// evidenceCursor is the position of the last item of a page, for keyset pagination ordered by end time.
+type evidenceCursor struct {
+	End time.Time `json:"end"`
+	ID  uuid.UUID `json:"id"`
+}
+
+func (c evidenceCursor) encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeEvidenceCursor(value string) (*evidenceCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+	cursor := &evidenceCursor{}
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+	return cursor, nil
+}
+
+// evidenceListParams holds the pagination, sorting and field selection of a request listing evidence.
+//
+// Pages are selected either by page number, or by the cursor returned with the previous page. Cursors are only
+// available when sorting by end time, and avoid the cost of large offsets when walking through every page.
+type evidenceListParams struct {
+	*service.PaginationParams
+	Sort       string
+	Descending bool
+	Cursor     *evidenceCursor
+	Fields     []string
+}
+
+// parseEvidenceListParams reads the page, limit, cursor, sort and fields query parameters.
+// Evidence is sorted by descending end time unless requested otherwise.
+func parseEvidenceListParams(ctx echo.Context, pagination *service.PaginationConfig) (*evidenceListParams, error) {
+	page, err := pagination.ParseParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params := &evidenceListParams{
+		PaginationParams: page,
+		Sort:             "end",
+		Descending:       true,
+	}
+
+	if sort := ctx.QueryParam("sort"); sort != "" {
+		column, descending := strings.CutPrefix(sort, "-")
+		if _, ok := evidenceSortColumns[column]; !ok {
+			return nil, fmt.Errorf("invalid sort parameter %q: expected end, status or title, optionally prefixed with -", sort)
+		}
+		params.Sort = column
+		params.Descending = descending
+	}
+
+	if cursor := ctx.QueryParam("cursor"); cursor != "" {
+		if params.Sort != "end" {
+			return nil, fmt.Errorf("cursor pagination requires sorting by end")
+		}
+		params.Cursor, err = decodeEvidenceCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		params.Page = 0
+		params.Offset = 0
+	}
+
+	if fields := ctx.QueryParam("fields"); fields != "" {
+		for _, field := range strings.Split(fields, ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				params.Fields = append(params.Fields, field)
+			}
+		}
+	}
+
+	return params, nil
+}
+
+// apply orders and limits query, which lists evidence or sightings aliased as table, of evidence aliased as evidence.
+// One row more than the limit is selected, so that hasMore can tell whether another page follows.
+func (p *evidenceListParams) apply(query *gorm.DB, table string, evidence string) *gorm.DB {
+	direction, comparison := "ASC", ">"
+	if p.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	if p.Cursor != nil {
+		query = query.Where(fmt.Sprintf(`(%s."end", %s.id) %s (?, ?)`, table, table, comparison), p.Cursor.End, p.Cursor.ID)
+	}
+	alias := evidence
+	if p.Sort == "end" {
+		alias = table
+	}
+	return query.
+		Order(fmt.Sprintf(evidenceSortColumns[p.Sort], alias) + " " + direction).
+		Order(fmt.Sprintf("%s.id %s", table, direction)).
+		Limit(p.Limit + 1).
+		Offset(p.Offset)
+}
+
+// hasMore reports whether a page selected with apply is followed by another page.
+func (p *evidenceListParams) hasMore(count int) bool {
+	return count > p.Limit
+}
+
+// preloads lists the associations needed to populate the selected fields, or every association when all fields
+// are returned.
+func (p *evidenceListParams) preloads() []string {
+	preloads := []string{}
+	for field, associations := range evidencePreloads {
+		if len(p.Fields) == 0 || containsFold(p.Fields, field) {
+			preloads = append(preloads, associations...)
+		}
+	}
+	return preloads
+}
+
+// nextCursor returns the cursor for the page following the item, when sorting allows cursor pagination.
+func (p *evidenceListParams) nextCursor(end time.Time, id *uuid.UUID) string {
+	if p.Sort != "end" || id == nil {
+		return ""
+	}
+	return evidenceCursor{End: end, ID: *id}.encode()
+}
+
+// response builds the list response for a page of items, keeping only the selected fields of each item.
+func (p *evidenceListParams) response(items []any, total int64, next string) (*service.ListResponse[any], error) {
+	data := make([]any, 0, len(items))
+	for _, item := range items {
+		if len(p.Fields) == 0 {
+			data = append(data, item)
+			continue
+		}
+		selected, err := selectFields(item, p.Fields)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, selected)
+	}
+
+	response := service.NewListResponse(data, total, p.Page, p.Limit)
+	response.NextCursor = next
+	return response, nil
+}
+
+// selectFields keeps only the given top level JSON fields of item.
+func selectFields(item any, fields []string) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+	all := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+	selected := map[string]json.RawMessage{}
+	for key, value := range all {
+		if containsFold(fields, key) {
+			selected[key] = value
+		}
+	}
+	return selected, nil
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/converters/labelfilter"
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// evidenceStreamBuffer is the number of events buffered for each stream subscriber. Events arriving while the
+	// buffer is full are dropped, and the subscriber is told how many were missed.
+	evidenceStreamBuffer = 64
+	// evidenceStreamKeepalive is how often a comment is sent on idle streams, so that proxies keep them open.
+	evidenceStreamKeepalive = 15 * time.Second
+)
+
+// evidenceStreamDropped is sent to stream subscribers which fell behind, before the next event delivered to them.
+type evidenceStreamDropped struct {
+	Dropped uint64 `json:"dropped"`
+}
+
+// Stream godoc
+//
+//	@Summary		Stream Evidence events
+//	@Description	Streams newly created evidence, and changes of state in evidence streams, as server-sent events.
+//	@Description	Events are named evidence.created and evidence.transitioned, and carry a service.EvidenceEvent as data.
+//	@Description	Subscribers which fall behind miss events, and receive a dropped event with the number missed.
+//	@Tags			Evidence
+//	@Produce		text/event-stream
+//	@Param			q		query		string	false	"Label filter query selecting the evidence to stream, such as 'provider=aws AND @status=not-satisfied'"
+//	@Param			types	query		string	false	"Comma-separated event types to stream: created, transitioned. Defaults to all"
+//	@Success		200		{object}	service.EvidenceEvent
+//	@Failure		400		{object}	api.Error
+//	@Failure		422		{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/evidence/stream [get]
+func (h *EvidenceHandler) Stream(ctx echo.Context) error {
+	filter := labelfilter.Filter{}
+	if err := bindFilterQuery(ctx, &filter); err != nil {
+		return ctx.JSON(http.StatusUnprocessableEntity, api.NewError(err))
+	}
+	types, err := parseEvidenceEventTypes(ctx.QueryParam("types"))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+
+	subscription, err := h.broadcaster.Subscribe(filter, evidenceStreamBuffer, types...)
+	if err != nil {
+		return ctx.JSON(http.StatusUnprocessableEntity, api.NewError(err))
+	}
+	defer subscription.Close()
+
+	response := ctx.Response()
+	response.Header().Set(echo.HeaderContentType, "text/event-stream")
+	response.Header().Set(echo.HeaderCacheControl, "no-cache")
+	response.Header().Set(echo.HeaderConnection, "keep-alive")
+	// Stops nginx from buffering the stream.
+	response.Header().Set("X-Accel-Buffering", "no")
+	response.WriteHeader(http.StatusOK)
+	response.Flush()
+
+	keepalive := time.NewTicker(evidenceStreamKeepalive)
+	defer keepalive.Stop()
+
+	for {
+		select {
+		case <-ctx.Request().Context().Done():
+			return nil
+		case <-keepalive.C:
+			if _, err := io.WriteString(response, ": keepalive\n\n"); err != nil {
+				return nil
+			}
+		case event, ok := <-subscription.Events():
+			if !ok {
+				return nil
+			}
+			if dropped := subscription.Dropped(); dropped > 0 {
+				if err := writeServerSentEvent(response, "", "dropped", evidenceStreamDropped{Dropped: dropped}); err != nil {
+					return nil
+				}
+			}
+			if err := writeServerSentEvent(response, strconv.FormatUint(event.Sequence, 10), string(event.Type), event); err != nil {
+				return nil
+			}
+		}
+		response.Flush()
+	}
+}
+
+// parseEvidenceEventTypes reads a comma separated list of event types, with or without the evidence. prefix.
+func parseEvidenceEventTypes(value string) ([]service.EvidenceEventType, error) {
+	types := []service.EvidenceEventType{}
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		eventType := service.EvidenceEventType("evidence." + strings.TrimPrefix(name, "evidence."))
+		if eventType != service.EvidenceCreated && eventType != service.EvidenceTransitioned {
+			return nil, fmt.Errorf("invalid event type %q: expected created or transitioned", name)
+		}
+		types = append(types, eventType)
+	}
+	return types, nil
+}
+
+// writeServerSentEvent writes a single server-sent event, with data encoded as JSON on one line.
+func writeServerSentEvent(w io.Writer, id string, event string, data any) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, encoded)
+	return err
+}
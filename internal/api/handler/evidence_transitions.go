@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/converters/labelfilter"
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// evidenceTransitionParams holds the stream and time range selecting evidence transitions.
+type evidenceTransitionParams struct {
+	Stream *uuid.UUID
+	From   time.Time
+	To     time.Time
+}
+
+// parseEvidenceTransitionParams reads the uuid, from and to query parameters. Times are either RFC3339
+// timestamps, or durations such as 24h or 7d before now.
+func parseEvidenceTransitionParams(ctx echo.Context) (*evidenceTransitionParams, error) {
+	params := &evidenceTransitionParams{}
+	if stream := ctx.QueryParam("uuid"); stream != "" {
+		id, err := uuid.Parse(stream)
+		if err != nil {
+			return nil, fmt.Errorf("invalid uuid parameter: %w", err)
+		}
+		params.Stream = &id
+	}
+
+	now := time.Now()
+	for name, target := range map[string]*time.Time{"from": &params.From, "to": &params.To} {
+		value := ctx.QueryParam(name)
+		if value == "" {
+			continue
+		}
+		at, err := labelfilter.ResolveTime(value, now)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s parameter: %w", name, err)
+		}
+		*target = at
+	}
+	return params, nil
+}
+
+// Transitions godoc
+//
+//	@Summary		List Evidence transitions
+//	@Description	Lists changes in the state of evidence streams, newest first. The first evidence of a stream is listed as a transition from an empty state.
+//	@Tags			Evidence
+//	@Produce		json
+//	@Param			q		query		string	false	"Label filter query, selecting transitions to evidence matching the filter"
+//	@Param			uuid	query		string	false	"Evidence stream UUID"
+//	@Param			from	query		string	false	"Earliest transition, as an RFC3339 timestamp or a duration before now such as 7d"
+//	@Param			to		query		string	false	"Latest transition, as an RFC3339 timestamp or a duration before now such as 24h"
+//	@Param			page	query		int		false	"Page number, starting at 1"
+//	@Param			limit	query		int		false	"Number of items per page"
+//	@Success		200		{object}	service.ListResponse[relational.EvidenceTransition]
+//	@Failure		400		{object}	api.Error
+//	@Failure		422		{object}	api.Error
+//	@Failure		500		{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/evidence/transitions [get]
+func (h *EvidenceHandler) Transitions(ctx echo.Context) error {
+	params, err := parseEvidenceTransitionParams(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+	filter := labelfilter.Filter{}
+	if err := bindFilterQuery(ctx, &filter); err != nil {
+		return ctx.JSON(http.StatusUnprocessableEntity, api.NewError(err))
+	}
+	page, err := h.pagination.ParseParams(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+
+	query := h.db.Model(&relational.EvidenceTransition{})
+	if params.Stream != nil {
+		query = query.Where("evidence_transitions.uuid = ?", *params.Stream)
+	}
+	if !params.From.IsZero() {
+		query = query.Where(`evidence_transitions."timestamp" >= ?`, params.From)
+	}
+	if !params.To.IsZero() {
+		query = query.Where(`evidence_transitions."timestamp" <= ?`, params.To)
+	}
+	if filter.Scope != nil {
+		matching, err := relational.GetEvidenceSearchByFilterQuery(h.db.Table("evidences"), h.db, filter)
+		if err != nil {
+			return ctx.JSON(http.StatusUnprocessableEntity, api.NewError(err))
+		}
+		query = query.Where("evidence_transitions.evidence_id IN (?)", matching.Select("l.id"))
+	}
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return ctx.JSON(filterQueryErrorStatus(err), api.NewError(err))
+	}
+	transitions := []relational.EvidenceTransition{}
+	if err := query.
+		Order(`evidence_transitions."timestamp" DESC`).
+		Order("evidence_transitions.id DESC").
+		Limit(page.Limit).
+		Offset(page.Offset).
+		Find(&transitions).Error; err != nil {
+		return ctx.JSON(filterQueryErrorStatus(err), api.NewError(err))
+	}
+
+	return ctx.JSON(http.StatusOK, service.NewListResponse(transitions, total, page.Page, page.Limit))
+}
+
+// Recoveries godoc
+//
+//	@Summary		Evidence recovery statistics
+//	@Description	Counts how often each evidence stream failed and recovered between from and to, and the mean time to recover in seconds.
+//	@Description	Any state other than satisfied is a failure. Recoveries are timed from the failure preceding them, even when it happened before from.
+//	@Tags			Evidence
+//	@Produce		json
+//	@Param			q		query		string	false	"Label filter query, selecting streams whose latest evidence matches the filter"
+//	@Param			uuid	query		string	false	"Evidence stream UUID"
+//	@Param			from	query		string	false	"Start of the period, as an RFC3339 timestamp or a duration before now such as 30d"
+//	@Param			to		query		string	false	"End of the period, as an RFC3339 timestamp or a duration before now such as 24h"
+//	@Success		200		{object}	GenericDataListResponse[relational.EvidenceRecovery]
+//	@Failure		400		{object}	api.Error
+//	@Failure		422		{object}	api.Error
+//	@Failure		500		{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/evidence/transitions/mttr [get]
+func (h *EvidenceHandler) Recoveries(ctx echo.Context) error {
+	params, err := parseEvidenceTransitionParams(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+	filter := labelfilter.Filter{}
+	if err := bindFilterQuery(ctx, &filter); err != nil {
+		return ctx.JSON(http.StatusUnprocessableEntity, api.NewError(err))
+	}
+
+	query := h.db.Model(&relational.EvidenceTransition{})
+	if params.Stream != nil {
+		query = query.Where("evidence_transitions.uuid = ?", *params.Stream)
+	}
+	if filter.Scope != nil {
+		latest, err := relational.GetEvidenceSearchByFilterQuery(relational.GetLatestEvidenceStreamsQuery(h.db), h.db, filter)
+		if err != nil {
+			return ctx.JSON(http.StatusUnprocessableEntity, api.NewError(err))
+		}
+		query = query.Where("evidence_transitions.uuid IN (?)", latest.Select("l.uuid"))
+	}
+
+	recoveries := []relational.EvidenceRecovery{}
+	if err := relational.GetEvidenceRecoveriesQuery(h.db, query, params.From, params.To).
+		Scan(&recoveries).Error; err != nil {
+		return ctx.JSON(filterQueryErrorStatus(err), api.NewError(err))
+	}
+
+	return ctx.JSON(http.StatusOK, GenericDataListResponse[relational.EvidenceRecovery]{
+		Data: recoveries,
+	})
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"errors"
 	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/api/middleware"
 	"github.com/compliance-framework/api/internal/service/relational"
 	oscalTypes_1_1_3 "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 	"github.com/google/uuid"
@@ -28,11 +29,14 @@ func NewFilterHandler(sugar *zap.SugaredLogger, db *gorm.DB) *FilterHandler {
 
 // Register registers the filter endpoints.
 func (h *FilterHandler) Register(api *echo.Group) {
-	api.GET("", h.List)
-	api.GET("/:id", h.Get)
-	api.POST("", h.Create)
-	api.PUT("/:id", h.Update)
-	api.DELETE("/:id", h.Delete)
+	read := middleware.RequirePermission(relational.PermissionEvidenceRead)
+	write := middleware.RequirePermission(relational.PermissionFilterWrite)
+
+	api.GET("", h.List, read)
+	api.GET("/:id", h.Get, read)
+	api.POST("", h.Create, write)
+	api.PUT("/:id", h.Update, write)
+	api.DELETE("/:id", h.Delete, write)
 }
 
 type FilterWithControlsResponse struct {
@@ -51,6 +55,7 @@ type FilterWithControlsResponse struct {
 //	@Failure		400	{object}	api.Error
 //	@Failure		404	{object}	api.Error
 //	@Failure		500	{object}	api.Error
+//	@Security		OAuth2Password
 //	@Router			/filters/{id} [get]
 func (h *FilterHandler) Get(ctx echo.Context) error {
 	idParam := ctx.Param("id")
@@ -90,6 +95,7 @@ func (h *FilterHandler) Get(ctx echo.Context) error {
 //	@Produce		json
 //	@Success		200	{object}	GenericDataListResponse[FilterWithControlsResponse]
 //	@Failure		500	{object}	api.Error
+//	@Security		OAuth2Password
 //	@Router			/filters [get]
 func (h *FilterHandler) List(ctx echo.Context) error {
 	var filters []relational.Filter
@@ -125,19 +131,27 @@ func (h *FilterHandler) List(ctx echo.Context) error {
 //	@Accept			json
 //	@Produce		json
 //	@Param			filter	body		createFilterRequest	true	"Filter to add"
+//	@Param			q		query		string				false	"Label filter query, such as 'provider=aws AND NOT env=dev', replacing the filter in the body"
 //	@Success		201		{object}	GenericDataResponse[relational.Filter]
 //	@Failure		400		{object}	api.Error
 //	@Failure		422		{object}	api.Error
 //	@Failure		500		{object}	api.Error
+//	@Security		OAuth2Password
 //	@Router			/filters [post]
 func (h *FilterHandler) Create(ctx echo.Context) error {
 	var req createFilterRequest
 	if err := ctx.Bind(&req); err != nil {
 		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
 	}
+	if err := bindFilterQuery(ctx, &req.Filter); err != nil {
+		return ctx.JSON(http.StatusUnprocessableEntity, api.NewError(err))
+	}
 	if err := ctx.Validate(req); err != nil {
 		return ctx.JSON(http.StatusBadRequest, api.Validator(err))
 	}
+	if err := req.Filter.Validate(); err != nil {
+		return ctx.JSON(http.StatusUnprocessableEntity, api.NewError(err))
+	}
 
 	filter := relational.Filter{
 		Name:   req.Name,
@@ -172,10 +186,13 @@ func (h *FilterHandler) Create(ctx echo.Context) error {
 //	@Produce		json
 //	@Param			id		path		string				true	"Filter ID"
 //	@Param			filter	body		createFilterRequest	true	"Filter to update"
+//	@Param			q		query		string				false	"Label filter query, such as 'provider=aws AND NOT env=dev', replacing the filter in the body"
 //	@Success		200		{object}	GenericDataResponse[relational.Filter]
 //	@Failure		400		{object}	api.Error
 //	@Failure		404		{object}	api.Error
+//	@Failure		422		{object}	api.Error
 //	@Failure		500		{object}	api.Error
+//	@Security		OAuth2Password
 //	@Router			/filters/{id} [put]
 func (h *FilterHandler) Update(ctx echo.Context) error {
 	idParam := ctx.Param("id")
@@ -189,9 +206,15 @@ func (h *FilterHandler) Update(ctx echo.Context) error {
 	if err := ctx.Bind(&req); err != nil {
 		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
 	}
+	if err := bindFilterQuery(ctx, &req.Filter); err != nil {
+		return ctx.JSON(http.StatusUnprocessableEntity, api.NewError(err))
+	}
 	if err := ctx.Validate(req); err != nil {
 		return ctx.JSON(http.StatusBadRequest, api.Validator(err))
 	}
+	if err := req.Filter.Validate(); err != nil {
+		return ctx.JSON(http.StatusUnprocessableEntity, api.NewError(err))
+	}
 
 	var filter relational.Filter
 	if err := h.db.First(&filter, "id = ?", id).Error; err != nil {
@@ -221,6 +244,7 @@ func (h *FilterHandler) Update(ctx echo.Context) error {
 //	@Failure		400	{object}	api.Error
 //	@Failure		404	{object}	api.Error
 //	@Failure		500	{object}	api.Error
+//	@Security		OAuth2Password
 //	@Router			/filters/{id} [delete]
 func (h *FilterHandler) Delete(ctx echo.Context) error {
 	idParam := ctx.Param("id")
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/compliance-framework/api/internal/api"
 	"github.com/compliance-framework/api/internal/converters/labelfilter"
 	"github.com/compliance-framework/api/internal/service/relational"
@@ -16,6 +17,7 @@ import (
 	"go.uber.org/zap"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 )
 
@@ -28,6 +30,9 @@ type FilterApiIntegrationSuite struct {
 }
 
 func (suite *FilterApiIntegrationSuite) TestCreate() {
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
 	suite.Run("Simple", func() {
 		err := suite.Migrator.Refresh()
 		suite.Require().NoError(err)
@@ -51,11 +56,40 @@ func (suite *FilterApiIntegrationSuite) TestCreate() {
 		rec := httptest.NewRecorder()
 		reqBody, _ := json.Marshal(createReq)
 		req := httptest.NewRequest(http.MethodPost, "/api/filters", bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		server.E().ServeHTTP(rec, req)
 		assert.Equal(suite.T(), http.StatusCreated, rec.Code)
 	})
 
+	suite.Run("From a query", func() {
+		err := suite.Migrator.Refresh()
+		suite.Require().NoError(err)
+
+		logger, _ := zap.NewDevelopment()
+		server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+		RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+		rec := httptest.NewRecorder()
+		reqBody, _ := json.Marshal(createFilterRequest{Name: "Query Filter"})
+		q := url.QueryEscape("provider=aws AND NOT env=dev")
+		req := httptest.NewRequest(http.MethodPost, "/api/filters?q="+q, bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		server.E().ServeHTTP(rec, req)
+		suite.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+		response := GenericDataResponse[relational.Filter]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+		suite.Equal("provider=aws AND NOT env=dev", response.Data.Filter.Data().String())
+
+		rec = httptest.NewRecorder()
+		req = httptest.NewRequest(http.MethodPost, "/api/filters?q="+url.QueryEscape("provider=aws AND"), bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		server.E().ServeHTTP(rec, req)
+		suite.Equal(http.StatusUnprocessableEntity, rec.Code)
+	})
+
 	suite.Run("With Controls", func() {
 		err := suite.Migrator.Refresh()
 		suite.Require().NoError(err)
@@ -94,6 +128,7 @@ func (suite *FilterApiIntegrationSuite) TestCreate() {
 		rec := httptest.NewRecorder()
 		reqBody, _ := json.Marshal(createReq)
 		req := httptest.NewRequest(http.MethodPost, "/api/filters", bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		server.E().ServeHTTP(rec, req)
 		assert.Equal(suite.T(), http.StatusCreated, rec.Code)
@@ -2,11 +2,15 @@ package handler
 
 import (
 	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/api/middleware"
 	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/service/relational"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"net/http"
 	"time"
 )
@@ -24,19 +28,39 @@ func NewHeartbeatHandler(sugar *zap.SugaredLogger, db *gorm.DB) *HeartbeatHandle
 }
 
 func (h *HeartbeatHandler) Register(api *echo.Group) {
-	api.POST("", h.Create)
-	api.GET("/over-time", h.OverTime)
+	api.POST("", h.Create, middleware.RequirePermission(relational.PermissionEvidenceWrite))
+	api.GET("/over-time", h.OverTime, middleware.RequirePermission(relational.PermissionEvidenceRead))
 }
 
 type HeartbeatCreateRequest struct {
 	UUID      uuid.UUID `json:"uuid,omitempty" validate:"required"`
 	CreatedAt time.Time `json:"created_at,omitempty" validate:"required"`
+
+	// Agent details, registered in the agent registry. Details left out keep their last reported value.
+	Name     string                   `json:"name,omitempty"`
+	Version  string                   `json:"version,omitempty"`
+	Hostname string                   `json:"hostname,omitempty"`
+	Plugins  []relational.AgentPlugin `json:"plugins,omitempty" validate:"dive"`
+	Labels   map[string]string        `json:"labels,omitempty"`
+}
+
+func (r *HeartbeatCreateRequest) details() relational.AgentDetails {
+	details := relational.AgentDetails{
+		Name:     r.Name,
+		Version:  r.Version,
+		Hostname: r.Hostname,
+		Labels:   datatypes.NewJSONType(r.Labels),
+	}
+	if r.Plugins != nil {
+		details.Plugins = datatypes.NewJSONSlice(r.Plugins)
+	}
+	return details
 }
 
 // Create godoc
 //
 //	@Summary		Create Heartbeat
-//	@Description	Creates a new heartbeat record for monitoring.
+//	@Description	Creates a new heartbeat record for monitoring, and registers the agent and the details it reports in the agent registry.
 //	@Tags			Heartbeat
 //	@Accept			json
 //	@Produce		json
@@ -44,6 +68,7 @@ type HeartbeatCreateRequest struct {
 //	@Success		201			"Created"
 //	@Failure		400			{object}	api.Error
 //	@Failure		500			{object}	api.Error
+//	@Security		OAuth2Password
 //	@Router			/agent/heartbeat [post]
 func (h *HeartbeatHandler) Create(ctx echo.Context) error {
 	// Bind the incoming JSON payload into a slice of SDK findings.
@@ -57,10 +82,17 @@ func (h *HeartbeatHandler) Create(ctx echo.Context) error {
 		return ctx.JSON(http.StatusBadRequest, api.Validator(err))
 	}
 
-	if err := h.db.Create(&service.Heartbeat{
-		UUID:      heartbeat.UUID,
-		CreatedAt: heartbeat.CreatedAt,
-	}).Error; err != nil {
+	err = h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&service.Heartbeat{
+			UUID:      heartbeat.UUID,
+			CreatedAt: heartbeat.CreatedAt,
+		}).Error; err != nil {
+			return err
+		}
+		return h.observeAgent(tx, &heartbeat)
+	})
+	if err != nil {
+		h.sugar.Errorw("Failed to record heartbeat", "uuid", heartbeat.UUID, "error", err)
 		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
 	}
 
@@ -68,37 +100,65 @@ func (h *HeartbeatHandler) Create(ctx echo.Context) error {
 	return ctx.NoContent(http.StatusCreated)
 }
 
+// observeAgent registers the agent sending a heartbeat, or updates it when it is already registered. The agent is
+// locked while it is updated, as agents may send heartbeats concurrently. Agents are seen at the time the heartbeat
+// is received rather than the time the agent reports, so that agents with skewed clocks are not shown as offline.
+func (h *HeartbeatHandler) observeAgent(tx *gorm.DB, heartbeat *HeartbeatCreateRequest) error {
+	now := time.Now()
+	// New agents are registered without labels or plugins rather than null ones, as they are until reported.
+	details := heartbeat.details()
+	if details.Labels.Data() == nil {
+		details.Labels = datatypes.NewJSONType(map[string]string{})
+	}
+	if details.Plugins == nil {
+		details.Plugins = datatypes.NewJSONSlice([]relational.AgentPlugin{})
+	}
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&relational.Agent{
+		UUID:         heartbeat.UUID,
+		AgentDetails: details,
+		FirstSeenAt:  now,
+		LastSeenAt:   now,
+	}).Error; err != nil {
+		return err
+	}
+
+	agent := &relational.Agent{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(agent, "uuid = ?", heartbeat.UUID).Error; err != nil {
+		return err
+	}
+	agent.Observe(now, heartbeat.details())
+	return tx.Save(agent).Error
+}
+
 // OverTime godoc
 //
 //	@Summary		Get Heartbeat Metrics Over Time
-//	@Description	Retrieves heartbeat counts aggregated by 2-minute intervals.
+//	@Description	Retrieves the number of agents sending heartbeats within each bucket of a time range, oldest first. Buckets without heartbeats are left out.
+//	@Description	Buckets are aligned to midnight UTC on 1 January 2000. Once raw heartbeats are pruned, older buckets are counted from the rollups whose resolution the bucket size is a multiple of.
 //	@Tags			Heartbeat
 //	@Produce		json
-//	@Success		200	{object}	handler.GenericDataListResponse[handler.OverTime.HeartbeatInterval]
-//	@Failure		500	{object}	api.Error
+//	@Param			bucket	query		string	false	"Bucket size, such as 5m, 1h or 1d. Defaults to 2m"
+//	@Param			from	query		string	false	"Start of the time range, as an RFC3339 timestamp or a duration before now such as 7d. Defaults to 24 hours before to"
+//	@Param			to		query		string	false	"End of the time range, as an RFC3339 timestamp or a duration before now such as 1h. Defaults to now"
+//	@Success		200		{object}	handler.GenericDataListResponse[service.HeartbeatInterval]
+//	@Failure		400		{object}	api.Error
+//	@Failure		500		{object}	api.Error
+//	@Security		OAuth2Password
 //	@Router			/agent/heartbeat/over-time [get]
 func (h *HeartbeatHandler) OverTime(ctx echo.Context) error {
-
-	type HeartbeatInterval struct {
-		Interval time.Time `json:"interval"`
-		Total    int64     `json:"total"`
+	window, err := service.ParseHeartbeatWindow(ctx.QueryParam("bucket"), ctx.QueryParam("from"), ctx.QueryParam("to"), time.Now())
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
 	}
 
-	var results []HeartbeatInterval
-	if err := h.db.Raw(`
-		select count(*) as total, "interval"
-		from (
-			select distinct on (uuid, date_bin('2 min', created_at, now())) uuid, date_bin('2 min', created_at, now()) as "interval"
-			from heartbeats
-			order by date_bin('2 min', created_at, now())
-		) as heartbeat_intervalled
-		group by "interval"
-	`).Scan(&results).Error; err != nil {
+	results := []service.HeartbeatInterval{}
+	if err := service.GetHeartbeatOverTimeQuery(h.db, *window).Scan(&results).Error; err != nil {
+		h.sugar.Errorw("Failed to count heartbeats", "error", err)
 		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
 	}
 
 	// Wrap the result in GenericDataResponse.
-	return ctx.JSON(http.StatusOK, GenericDataListResponse[HeartbeatInterval]{
+	return ctx.JSON(http.StatusOK, GenericDataListResponse[service.HeartbeatInterval]{
 		Data: results,
 	})
 }
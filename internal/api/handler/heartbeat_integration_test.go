@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"github.com/compliance-framework/api/internal/api"
 	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/service/relational"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
@@ -31,7 +32,10 @@ type HeartbeatApiIntegrationSuite struct {
 }
 
 func (suite *HeartbeatApiIntegrationSuite) TestHeartbeatCreateValidation() {
-	err := suite.Migrator.Refresh()
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	err = suite.Migrator.Refresh()
 	suite.Require().NoError(err)
 
 	// Create two catalogs with the same group ID structure
@@ -42,13 +46,17 @@ func (suite *HeartbeatApiIntegrationSuite) TestHeartbeatCreateValidation() {
 	rec := httptest.NewRecorder()
 	reqBody, _ := json.Marshal(heartbeat)
 	req := httptest.NewRequest(http.MethodPost, "/api/agent/heartbeat", bytes.NewReader(reqBody))
+	req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	server.E().ServeHTTP(rec, req)
 	assert.Equal(suite.T(), http.StatusBadRequest, rec.Code)
 }
 
 func (suite *HeartbeatApiIntegrationSuite) TestHeartbeatCreate() {
-	err := suite.Migrator.Refresh()
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	err = suite.Migrator.Refresh()
 	suite.Require().NoError(err)
 
 	// Create two catalogs with the same group ID structure
@@ -63,6 +71,7 @@ func (suite *HeartbeatApiIntegrationSuite) TestHeartbeatCreate() {
 	rec := httptest.NewRecorder()
 	reqBody, _ := json.Marshal(heartbeat)
 	req := httptest.NewRequest(http.MethodPost, "/api/agent/heartbeat", bytes.NewReader(reqBody))
+	req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	server.E().ServeHTTP(rec, req)
 	assert.Equal(suite.T(), http.StatusCreated, rec.Code)
@@ -74,47 +83,130 @@ func (suite *HeartbeatApiIntegrationSuite) TestHeartbeatCreate() {
 }
 
 func (suite *HeartbeatApiIntegrationSuite) TestHeartbeatOverTime() {
-	err := suite.Migrator.Refresh()
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	err = suite.Migrator.Refresh()
 	suite.Require().NoError(err)
 
-	// Seed some heartbeats
+	// Seed some heartbeats, over the ten minutes of 2 minute buckets before the current one.
+	start := service.HeartbeatBucket(time.Now(), 2*time.Minute).Add(-10 * time.Minute)
 	for range 3 {
 		id := uuid.New()
 		for i := range 10 {
 			suite.DB.Model(&service.Heartbeat{}).Create(&service.Heartbeat{
 				UUID:      id,
-				CreatedAt: time.Now().Add(time.Duration(i) * time.Minute),
+				CreatedAt: start.Add(time.Duration(i) * time.Minute),
 			})
 		}
 	}
 
-	// Create two catalogs with the same group ID structure
 	logger, _ := zap.NewDevelopment()
 	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
 	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
-	rec := httptest.NewRecorder()
-	req := httptest.NewRequest(http.MethodGet, "/api/agent/heartbeat/over-time/", nil)
-	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
-	server.E().ServeHTTP(rec, req)
-	assert.Equal(suite.T(), http.StatusOK, rec.Code)
-
-	response := struct {
-		Data []struct {
-			Interval time.Time
-			Total    int
-		} `json:"data"`
-	}{}
-	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	overTime := func(query string) (int, []service.HeartbeatInterval) {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/agent/heartbeat/over-time?"+query, nil)
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		server.E().ServeHTTP(rec, req)
+
+		response := GenericDataListResponse[service.HeartbeatInterval]{}
+		if rec.Code == http.StatusOK {
+			suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+		}
+		return rec.Code, response.Data
+	}
+
+	suite.Run("Heartbeats are counted in 2 minute buckets by default", func() {
+		code, intervals := overTime("")
+		suite.Require().Equal(http.StatusOK, code)
+		suite.Require().Len(intervals, 5) // There are 5 intervals
+		for i, interval := range intervals {
+			suite.Equal(int64(3), interval.Total) // Each interval should have 3 agents
+			suite.True(start.Add(time.Duration(i) * 2 * time.Minute).Equal(interval.Interval))
+		}
+	})
+
+	suite.Run("Heartbeats are counted within the time range", func() {
+		// The range starts part way through a bucket, which is counted in full.
+		code, intervals := overTime("bucket=2m&from=" + start.Add(5*time.Minute).Format(time.RFC3339) + "&to=" + start.Add(8*time.Minute).Format(time.RFC3339))
+		suite.Require().Equal(http.StatusOK, code)
+		suite.Require().Len(intervals, 2)
+		suite.True(start.Add(4 * time.Minute).Equal(intervals[0].Interval))
+		suite.Equal(int64(3), intervals[1].Total)
+	})
+
+	suite.Run("Invalid parameters", func() {
+		for _, query := range []string{"bucket=10s", "bucket=soon", "from=1h&to=2h", "bucket=1m&from=30d"} {
+			code, _ := overTime(query)
+			suite.Equal(http.StatusBadRequest, code, query)
+		}
+	})
+}
+
+func (suite *HeartbeatApiIntegrationSuite) TestHeartbeatAgentAuthentication() {
+	err := suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	ingest, ingestKey, err := relational.NewAgentCredential("ingest", relational.AgentCredentialScopeIngest, nil)
 	suite.Require().NoError(err)
+	suite.Require().NoError(suite.DB.Create(ingest).Error)
 
-	fmt.Println(response.Data)
+	read, readKey, err := relational.NewAgentCredential("read", relational.AgentCredentialScopeRead, nil)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.DB.Create(read).Error)
 
-	suite.Len(response.Data, 6)            // There are 6 intervals
-	suite.Equal(response.Data[0].Total, 3) // Each interval should have 3 agents
-	suite.Equal(response.Data[1].Total, 3) // Each interval should have 3 agents
-	suite.Equal(response.Data[2].Total, 3) // Each interval should have 3 agents
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+
+	send := func(key string) int {
+		heartbeat := HeartbeatCreateRequest{
+			UUID:      uuid.New(),
+			CreatedAt: time.Now(),
+		}
+		rec := httptest.NewRecorder()
+		reqBody, _ := json.Marshal(heartbeat)
+		req := httptest.NewRequest(http.MethodPost, "/api/agent/heartbeat", bytes.NewReader(reqBody))
+		if key != "" {
+			req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", key))
+		}
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		server.E().ServeHTTP(rec, req)
+		return rec.Code
+	}
 
-	// The interval gap should be 2 minutes
-	suite.Equal(response.Data[0].Interval.Sub(response.Data[1].Interval).Abs(), 2*time.Minute)
-	suite.Equal(response.Data[1].Interval.Sub(response.Data[2].Interval).Abs(), 2*time.Minute)
+	suite.Run("Missing credentials are rejected", func() {
+		suite.Equal(http.StatusUnauthorized, send(""))
+	})
+
+	suite.Run("Unknown keys are rejected", func() {
+		suite.Equal(http.StatusUnauthorized, send(ingestKey+"x"))
+	})
+
+	suite.Run("Ingest keys can send heartbeats", func() {
+		suite.Equal(http.StatusCreated, send(ingestKey))
+
+		var credential relational.AgentCredential
+		suite.Require().NoError(suite.DB.First(&credential, "id = ?", ingest.ID).Error)
+		suite.NotNil(credential.LastUsedAt)
+	})
+
+	suite.Run("Read keys cannot send heartbeats", func() {
+		suite.Equal(http.StatusForbidden, send(readKey))
+	})
+
+	suite.Run("Read keys can query heartbeats", func() {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/agent/heartbeat/over-time/", nil)
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", readKey))
+		server.E().ServeHTTP(rec, req)
+		suite.Equal(http.StatusOK, rec.Code)
+	})
+
+	suite.Run("Revoked keys are rejected", func() {
+		suite.Require().NoError(suite.DB.Model(ingest).Update("revoked_at", time.Now()).Error)
+		suite.Equal(http.StatusUnauthorized, send(ingestKey))
+	})
 }
@@ -11,6 +11,8 @@ import (
 
 	"github.com/compliance-framework/api/internal/api"
 	"github.com/compliance-framework/api/internal/api/handler"
+	"github.com/compliance-framework/api/internal/api/middleware"
+	"github.com/compliance-framework/api/internal/service"
 	"github.com/compliance-framework/api/internal/service/relational"
 	oscalTypes_1_1_3 "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 )
@@ -28,11 +30,15 @@ func NewActivityHandler(sugar *zap.SugaredLogger, db *gorm.DB) *ActivityHandler
 }
 
 func (h *ActivityHandler) Register(api *echo.Group) {
+	scope := middleware.RequireSystemAccess(h.db, &relational.Activity{}, (*service.SystemScope).Activities)
+	read := middleware.Chain(middleware.RequirePermission(relational.PermissionOSCALRead), scope)
+	write := middleware.Chain(middleware.RequirePermission(relational.PermissionAssessmentWrite), scope)
+
 	// Activities sub-resource management
-	api.POST("", h.CreateActivity)
-	api.GET("/:id", h.GetActivity)
-	api.PUT("/:id", h.UpdateActivity)
-	api.DELETE("/:id", h.DeleteActivity)
+	api.POST("", h.CreateActivity, write)
+	api.GET("/:id", h.GetActivity, read)
+	api.PUT("/:id", h.UpdateActivity, write)
+	api.DELETE("/:id", h.DeleteActivity, write)
 }
 
 // validateActivityInput validates activity input
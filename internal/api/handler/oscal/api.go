@@ -10,7 +10,7 @@ import (
 
 func RegisterHandlers(server *api.Server, logger *zap.SugaredLogger, db *gorm.DB, config *config.Config) {
 	oscalGroup := server.API().Group("/oscal")
-	oscalGroup.Use(middleware.JWTMiddleware(config.JWTPublicKey))
+	oscalGroup.Use(middleware.JWTMiddleware(db, config.JWTPublicKey), middleware.SystemScopeMiddleware(db))
 
 	catalogHandler := NewCatalogHandler(logger, db)
 	catalogHandler.Register(oscalGroup.Group("/catalogs"))
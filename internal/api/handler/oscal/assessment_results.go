@@ -15,6 +15,8 @@ import (
 
 	"github.com/compliance-framework/api/internal/api"
 	"github.com/compliance-framework/api/internal/api/handler"
+	"github.com/compliance-framework/api/internal/api/middleware"
+	"github.com/compliance-framework/api/internal/service"
 	"github.com/compliance-framework/api/internal/service/relational"
 	oscalTypes_1_1_3 "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 )
@@ -52,68 +54,72 @@ func (h *AssessmentResultsHandler) verifyAssessmentResultsExists(ctx echo.Contex
 
 // Register registers Assessment Results endpoints to the API group.
 func (h *AssessmentResultsHandler) Register(api *echo.Group) {
-	api.GET("", h.List)          // GET /oscal/assessment-results
-	api.POST("", h.Create)       // POST /oscal/assessment-results
-	api.GET("/:id", h.Get)       // GET /oscal/assessment-results/:id
-	api.PUT("/:id", h.Update)    // PUT /oscal/assessment-results/:id
-	api.DELETE("/:id", h.Delete) // DELETE /oscal/assessment-results/:id
-	api.GET("/:id/full", h.Full) // GET /oscal/assessment-results/:id/full
-	api.GET("/:id/metadata", h.GetMetadata)
-	api.PUT("/:id/metadata", h.UpdateMetadata)
-	api.GET("/:id/import-ap", h.GetImportAp)
-	api.PUT("/:id/import-ap", h.UpdateImportAp)
-	api.GET("/:id/local-definitions", h.GetLocalDefinitions)
-	api.PUT("/:id/local-definitions", h.UpdateLocalDefinitions)
-	api.GET("/:id/results", h.GetResults)
-	api.POST("/:id/results", h.CreateResult)
-	api.GET("/:id/results/:resultId", h.GetResult)
-	api.PUT("/:id/results/:resultId", h.UpdateResult)
-	api.DELETE("/:id/results/:resultId", h.DeleteResult)
-	api.GET("/:id/results/:resultId/observations", h.GetResultObservations)
-	api.POST("/:id/results/:resultId/observations", h.CreateResultObservation)
-	api.PUT("/:id/results/:resultId/observations/:obsId", h.UpdateResultObservation)
-	api.DELETE("/:id/results/:resultId/observations/:obsId", h.DeleteResultObservation)
-	api.GET("/:id/results/:resultId/risks", h.GetResultRisks)
-	api.POST("/:id/results/:resultId/risks", h.CreateResultRisk)
-	api.PUT("/:id/results/:resultId/risks/:riskId", h.UpdateResultRisk)
-	api.DELETE("/:id/results/:resultId/risks/:riskId", h.DeleteResultRisk)
-	api.GET("/:id/results/:resultId/findings", h.GetResultFindings)
-	api.POST("/:id/results/:resultId/findings", h.CreateResultFinding)
-	api.PUT("/:id/results/:resultId/findings/:findingId", h.UpdateResultFinding)
-	api.DELETE("/:id/results/:resultId/findings/:findingId", h.DeleteResultFinding)
-	api.GET("/:id/results/:resultId/attestations", h.GetResultAttestations)
-	api.POST("/:id/results/:resultId/attestations", h.CreateResultAttestation)
-	api.PUT("/:id/results/:resultId/attestations/:attestationId", h.UpdateResultAttestation)
-	api.DELETE("/:id/results/:resultId/attestations/:attestationId", h.DeleteResultAttestation)
+	scope := middleware.RequireSystemAccess(h.db, &relational.AssessmentResult{}, (*service.SystemScope).AssessmentResults)
+	read := middleware.Chain(middleware.RequirePermission(relational.PermissionOSCALRead), scope)
+	write := middleware.Chain(middleware.RequirePermission(relational.PermissionAssessmentWrite), scope)
+
+	api.GET("", h.List, read)           // GET /oscal/assessment-results
+	api.POST("", h.Create, write)       // POST /oscal/assessment-results
+	api.GET("/:id", h.Get, read)        // GET /oscal/assessment-results/:id
+	api.PUT("/:id", h.Update, write)    // PUT /oscal/assessment-results/:id
+	api.DELETE("/:id", h.Delete, write) // DELETE /oscal/assessment-results/:id
+	api.GET("/:id/full", h.Full, read)  // GET /oscal/assessment-results/:id/full
+	api.GET("/:id/metadata", h.GetMetadata, read)
+	api.PUT("/:id/metadata", h.UpdateMetadata, write)
+	api.GET("/:id/import-ap", h.GetImportAp, read)
+	api.PUT("/:id/import-ap", h.UpdateImportAp, write)
+	api.GET("/:id/local-definitions", h.GetLocalDefinitions, read)
+	api.PUT("/:id/local-definitions", h.UpdateLocalDefinitions, write)
+	api.GET("/:id/results", h.GetResults, read)
+	api.POST("/:id/results", h.CreateResult, write)
+	api.GET("/:id/results/:resultId", h.GetResult, read)
+	api.PUT("/:id/results/:resultId", h.UpdateResult, write)
+	api.DELETE("/:id/results/:resultId", h.DeleteResult, write)
+	api.GET("/:id/results/:resultId/observations", h.GetResultObservations, read)
+	api.POST("/:id/results/:resultId/observations", h.CreateResultObservation, write)
+	api.PUT("/:id/results/:resultId/observations/:obsId", h.UpdateResultObservation, write)
+	api.DELETE("/:id/results/:resultId/observations/:obsId", h.DeleteResultObservation, write)
+	api.GET("/:id/results/:resultId/risks", h.GetResultRisks, read)
+	api.POST("/:id/results/:resultId/risks", h.CreateResultRisk, write)
+	api.PUT("/:id/results/:resultId/risks/:riskId", h.UpdateResultRisk, write)
+	api.DELETE("/:id/results/:resultId/risks/:riskId", h.DeleteResultRisk, write)
+	api.GET("/:id/results/:resultId/findings", h.GetResultFindings, read)
+	api.POST("/:id/results/:resultId/findings", h.CreateResultFinding, write)
+	api.PUT("/:id/results/:resultId/findings/:findingId", h.UpdateResultFinding, write)
+	api.DELETE("/:id/results/:resultId/findings/:findingId", h.DeleteResultFinding, write)
+	api.GET("/:id/results/:resultId/attestations", h.GetResultAttestations, read)
+	api.POST("/:id/results/:resultId/attestations", h.CreateResultAttestation, write)
+	api.PUT("/:id/results/:resultId/attestations/:attestationId", h.UpdateResultAttestation, write)
+	api.DELETE("/:id/results/:resultId/attestations/:attestationId", h.DeleteResultAttestation, write)
 	
 	// Endpoints to list all observations, risks, and findings across all results
-	api.GET("/:id/observations", h.GetAllObservations)
-	api.GET("/:id/risks", h.GetAllRisks) 
-	api.GET("/:id/findings", h.GetAllFindings)
+	api.GET("/:id/observations", h.GetAllObservations, read)
+	api.GET("/:id/risks", h.GetAllRisks, read) 
+	api.GET("/:id/findings", h.GetAllFindings, read)
 	
 	// Control endpoints for findings
-	api.GET("/:id/available-controls", h.GetAvailableControls)
-	api.GET("/:id/control/:controlId", h.GetControlDetails)
+	api.GET("/:id/available-controls", h.GetAvailableControls, read)
+	api.GET("/:id/control/:controlId", h.GetControlDetails, read)
 	
 	// Association endpoints for existing observations, risks, and findings
-	api.GET("/:id/results/:resultId/associated-observations", h.GetResultAssociatedObservations)
-	api.POST("/:id/results/:resultId/associated-observations/:observationId", h.AssociateResultObservation)
-	api.DELETE("/:id/results/:resultId/associated-observations/:observationId", h.DisassociateResultObservation)
-	api.GET("/:id/results/:resultId/associated-risks", h.GetResultAssociatedRisks)
-	api.POST("/:id/results/:resultId/associated-risks/:riskId", h.AssociateResultRisk)
-	api.DELETE("/:id/results/:resultId/associated-risks/:riskId", h.DisassociateResultRisk)
-	api.GET("/:id/results/:resultId/associated-findings", h.GetResultAssociatedFindings)
-	api.POST("/:id/results/:resultId/associated-findings/:findingId", h.AssociateResultFinding)
-	api.DELETE("/:id/results/:resultId/associated-findings/:findingId", h.DisassociateResultFinding)
+	api.GET("/:id/results/:resultId/associated-observations", h.GetResultAssociatedObservations, read)
+	api.POST("/:id/results/:resultId/associated-observations/:observationId", h.AssociateResultObservation, write)
+	api.DELETE("/:id/results/:resultId/associated-observations/:observationId", h.DisassociateResultObservation, write)
+	api.GET("/:id/results/:resultId/associated-risks", h.GetResultAssociatedRisks, read)
+	api.POST("/:id/results/:resultId/associated-risks/:riskId", h.AssociateResultRisk, write)
+	api.DELETE("/:id/results/:resultId/associated-risks/:riskId", h.DisassociateResultRisk, write)
+	api.GET("/:id/results/:resultId/associated-findings", h.GetResultAssociatedFindings, read)
+	api.POST("/:id/results/:resultId/associated-findings/:findingId", h.AssociateResultFinding, write)
+	api.DELETE("/:id/results/:resultId/associated-findings/:findingId", h.DisassociateResultFinding, write)
 	
-	api.GET("/:id/back-matter", h.GetBackMatter)
-	api.POST("/:id/back-matter", h.CreateBackMatter)
-	api.PUT("/:id/back-matter", h.UpdateBackMatter)
-	api.DELETE("/:id/back-matter", h.DeleteBackMatter)
-	api.GET("/:id/back-matter/resources", h.GetBackMatterResources)
-	api.POST("/:id/back-matter/resources", h.CreateBackMatterResource)
-	api.PUT("/:id/back-matter/resources/:resourceId", h.UpdateBackMatterResource)
-	api.DELETE("/:id/back-matter/resources/:resourceId", h.DeleteBackMatterResource)
+	api.GET("/:id/back-matter", h.GetBackMatter, read)
+	api.POST("/:id/back-matter", h.CreateBackMatter, write)
+	api.PUT("/:id/back-matter", h.UpdateBackMatter, write)
+	api.DELETE("/:id/back-matter", h.DeleteBackMatter, write)
+	api.GET("/:id/back-matter/resources", h.GetBackMatterResources, read)
+	api.POST("/:id/back-matter/resources", h.CreateBackMatterResource, write)
+	api.PUT("/:id/back-matter/resources/:resourceId", h.UpdateBackMatterResource, write)
+	api.DELETE("/:id/back-matter/resources/:resourceId", h.DeleteBackMatterResource, write)
 }
 
 // validateAssessmentResultsInput validates Assessment Results input following OSCAL requirements
@@ -255,7 +261,7 @@ func (h *AssessmentResultsHandler) validateAttestationInput(attestation *oscalTy
 //	@Router			/oscal/assessment-results [get]
 func (h *AssessmentResultsHandler) List(ctx echo.Context) error {
 	var assessmentResults []relational.AssessmentResult
-	if err := h.db.Preload("Metadata").Preload("Metadata.Revisions").Find(&assessmentResults).Error; err != nil {
+	if err := h.db.Scopes(middleware.GetSystemScope(ctx).AssessmentResults).Preload("Metadata").Preload("Metadata.Revisions").Find(&assessmentResults).Error; err != nil {
 		h.sugar.Errorw("failed to list assessment results", "error", err)
 		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
 	}
@@ -1780,7 +1786,17 @@ func (h *AssessmentResultsHandler) CreateResultFinding(ctx echo.Context) error {
 	relFinding.UnmarshalOscal(oscalFinding)
 
 	// Create the finding through the association
-	if err := h.db.Model(&result).Association("Findings").Append(relFinding); err != nil {
+	err = h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&result).Association("Findings").Append(relFinding); err != nil {
+			return err
+		}
+		return service.EnqueueWebhookEvent(tx, relational.WebhookEventFindingCreated, FindingWebhook{
+			AssessmentResultsID: id,
+			ResultID:            resultId,
+			Finding:             *relFinding.MarshalOscal(),
+		})
+	})
+	if err != nil {
 		h.sugar.Errorf("Failed to create finding: %v", err)
 		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
 	}
@@ -1795,6 +1811,13 @@ func (h *AssessmentResultsHandler) CreateResultFinding(ctx echo.Context) error {
 	return ctx.JSON(http.StatusCreated, handler.GenericDataResponse[oscalTypes_1_1_3.Finding]{Data: *relFinding.MarshalOscal()})
 }
 
+// FindingWebhook is the data of finding.created webhook events.
+type FindingWebhook struct {
+	AssessmentResultsID uuid.UUID                `json:"assessment-results-id"`
+	ResultID            uuid.UUID                `json:"result-id"`
+	Finding             oscalTypes_1_1_3.Finding `json:"finding"`
+}
+
 // UpdateResultFinding godoc
 //
 //	@Summary		Update a finding
@@ -14,6 +14,8 @@ import (
 
 	"github.com/compliance-framework/api/internal/api"
 	"github.com/compliance-framework/api/internal/api/handler"
+	"github.com/compliance-framework/api/internal/api/middleware"
+	"github.com/compliance-framework/api/internal/service"
 	"github.com/compliance-framework/api/internal/service/relational"
 	oscalTypes_1_1_3 "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 )
@@ -32,42 +34,46 @@ func NewAssessmentPlanHandler(sugar *zap.SugaredLogger, db *gorm.DB) *Assessment
 
 // Register registers Assessment Plan endpoints to the API group.
 func (h *AssessmentPlanHandler) Register(api *echo.Group) {
+	scope := middleware.RequireSystemAccess(h.db, &relational.AssessmentPlan{}, (*service.SystemScope).AssessmentPlans)
+	read := middleware.Chain(middleware.RequirePermission(relational.PermissionOSCALRead), scope)
+	write := middleware.Chain(middleware.RequirePermission(relational.PermissionAssessmentWrite), scope)
+
 	// Core CRUD operations
-	api.GET("", h.List)          // GET /oscal/assessment-plans
-	api.POST("", h.Create)       // POST /oscal/assessment-plans
-	api.GET("/:id", h.Get)       // GET /oscal/assessment-plans/:id
-	api.PUT("/:id", h.Update)    // PUT /oscal/assessment-plans/:id
-	api.GET("/:id/full", h.Full) // GET /oscal/assessment-plans/:id/full
-	api.DELETE("/:id", h.Delete) // DELETE /oscal/assessment-plans/:id
-
-	api.GET("/:id/metadata", h.GetMetadata)
-	api.GET("/:id/import-ssp", h.GetImportSsp)
-	api.GET("/:id/local-definitions", h.GetLocalDefinitions)
-	api.GET("/:id/terms-and-conditions", h.GetTermsAndConditions)
-	api.GET("/:id/back-matter", h.GetBackMatter)
+	api.GET("", h.List, read)           // GET /oscal/assessment-plans
+	api.POST("", h.Create, write)       // POST /oscal/assessment-plans
+	api.GET("/:id", h.Get, read)        // GET /oscal/assessment-plans/:id
+	api.PUT("/:id", h.Update, write)    // PUT /oscal/assessment-plans/:id
+	api.GET("/:id/full", h.Full, read)  // GET /oscal/assessment-plans/:id/full
+	api.DELETE("/:id", h.Delete, write) // DELETE /oscal/assessment-plans/:id
+
+	api.GET("/:id/metadata", h.GetMetadata, read)
+	api.GET("/:id/import-ssp", h.GetImportSsp, read)
+	api.GET("/:id/local-definitions", h.GetLocalDefinitions, read)
+	api.GET("/:id/terms-and-conditions", h.GetTermsAndConditions, read)
+	api.GET("/:id/back-matter", h.GetBackMatter, read)
 
 	// Tasks sub-resource management
-	api.GET("/:id/tasks", h.GetTasks)
-	api.POST("/:id/tasks", h.CreateTask)
+	api.GET("/:id/tasks", h.GetTasks, read)
+	api.POST("/:id/tasks", h.CreateTask, write)
 
-	api.PUT("/:id/tasks/:taskId", h.UpdateTask)
-	api.DELETE("/:id/tasks/:taskId", h.DeleteTask)
+	api.PUT("/:id/tasks/:taskId", h.UpdateTask, write)
+	api.DELETE("/:id/tasks/:taskId", h.DeleteTask, write)
 
-	api.GET("/:id/tasks/:taskId/associated-activities", h.GetTaskActivities)
-	api.POST("/:id/tasks/:taskId/associated-activities/:activityId", h.AssociateTaskActivity)
-	api.DELETE("/:id/tasks/:taskId/associated-activities/:activityId", h.DisassociateTaskActivity)
+	api.GET("/:id/tasks/:taskId/associated-activities", h.GetTaskActivities, read)
+	api.POST("/:id/tasks/:taskId/associated-activities/:activityId", h.AssociateTaskActivity, write)
+	api.DELETE("/:id/tasks/:taskId/associated-activities/:activityId", h.DisassociateTaskActivity, write)
 
 	// Assessment Subjects sub-resource management
-	api.GET("/:id/assessment-subjects", h.GetAssessmentSubjects)
-	api.POST("/:id/assessment-subjects", h.CreateAssessmentSubject)
-	api.PUT("/:id/assessment-subjects/:subjectId", h.UpdateAssessmentSubject)
-	api.DELETE("/:id/assessment-subjects/:subjectId", h.DeleteAssessmentSubject)
+	api.GET("/:id/assessment-subjects", h.GetAssessmentSubjects, read)
+	api.POST("/:id/assessment-subjects", h.CreateAssessmentSubject, write)
+	api.PUT("/:id/assessment-subjects/:subjectId", h.UpdateAssessmentSubject, write)
+	api.DELETE("/:id/assessment-subjects/:subjectId", h.DeleteAssessmentSubject, write)
 
 	// Assessment Assets sub-resource management
-	api.GET("/:id/assessment-assets", h.GetAssessmentAssets)
-	api.POST("/:id/assessment-assets", h.CreateAssessmentAsset)
-	api.PUT("/:id/assessment-assets/:assetId", h.UpdateAssessmentAsset)
-	api.DELETE("/:id/assessment-assets/:assetId", h.DeleteAssessmentAsset)
+	api.GET("/:id/assessment-assets", h.GetAssessmentAssets, read)
+	api.POST("/:id/assessment-assets", h.CreateAssessmentAsset, write)
+	api.PUT("/:id/assessment-assets/:assetId", h.UpdateAssessmentAsset, write)
+	api.DELETE("/:id/assessment-assets/:assetId", h.DeleteAssessmentAsset, write)
 }
 
 // verifyAssessmentPlanExists checks if an assessment plan exists in the database
@@ -97,6 +103,7 @@ func (h *AssessmentPlanHandler) verifyAssessmentPlanExists(ctx echo.Context, pla
 func (h *AssessmentPlanHandler) List(ctx echo.Context) error {
 	var plans []relational.AssessmentPlan
 	if err := h.db.
+		Scopes(middleware.GetSystemScope(ctx).AssessmentPlans).
 		Preload("Metadata").
 		Preload("Metadata.Revisions").
 		Find(&plans).Error; err != nil {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/api/middleware"
 	"github.com/defenseunicorns/go-oscal/src/pkg/versioning"
 	oscalTypes_1_1_3 "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 
@@ -31,26 +32,29 @@ func NewCatalogHandler(l *zap.SugaredLogger, db *gorm.DB) *CatalogHandler {
 }
 
 func (h *CatalogHandler) Register(api *echo.Group) {
-	api.GET("", h.List)
-	api.POST("", h.Create)
-	api.GET("/:id", h.Get)
-	api.PUT("/:id", h.Update)
-	api.GET("/:id/full", h.Full)
-	api.GET("/:id/back-matter", h.GetBackMatter)
-	api.GET("/:id/groups", h.GetGroups)
-	api.POST("/:id/groups", h.CreateGroup)
-	api.GET("/:id/groups/:group", h.GetGroup)
-	api.PUT("/:id/groups/:group", h.UpdateGroup)
-	api.GET("/:id/groups/:group/groups", h.GetGroupSubGroups)
-	api.POST("/:id/groups/:group/groups", h.CreateGroupSubGroup)
-	api.GET("/:id/groups/:group/controls", h.GetGroupControls)
-	api.POST("/:id/groups/:group/controls", h.CreateGroupControl)
-	api.GET("/:id/controls", h.GetControls)
-	api.POST("/:id/controls", h.CreateControl)
-	api.GET("/:id/controls/:control", h.GetControl)
-	api.PUT("/:id/controls/:control", h.UpdateControl)
-	api.GET("/:id/controls/:control/controls", h.GetControlSubControls)
-	api.POST("/:id/controls/:control/controls", h.CreateControlSubControl)
+	read := middleware.RequirePermission(relational.PermissionOSCALRead)
+	write := middleware.RequirePermission(relational.PermissionCatalogWrite)
+
+	api.GET("", h.List, read)
+	api.POST("", h.Create, write)
+	api.GET("/:id", h.Get, read)
+	api.PUT("/:id", h.Update, write)
+	api.GET("/:id/full", h.Full, read)
+	api.GET("/:id/back-matter", h.GetBackMatter, read)
+	api.GET("/:id/groups", h.GetGroups, read)
+	api.POST("/:id/groups", h.CreateGroup, write)
+	api.GET("/:id/groups/:group", h.GetGroup, read)
+	api.PUT("/:id/groups/:group", h.UpdateGroup, write)
+	api.GET("/:id/groups/:group/groups", h.GetGroupSubGroups, read)
+	api.POST("/:id/groups/:group/groups", h.CreateGroupSubGroup, write)
+	api.GET("/:id/groups/:group/controls", h.GetGroupControls, read)
+	api.POST("/:id/groups/:group/controls", h.CreateGroupControl, write)
+	api.GET("/:id/controls", h.GetControls, read)
+	api.POST("/:id/controls", h.CreateControl, write)
+	api.GET("/:id/controls/:control", h.GetControl, read)
+	api.PUT("/:id/controls/:control", h.UpdateControl, write)
+	api.GET("/:id/controls/:control/controls", h.GetControlSubControls, read)
+	api.POST("/:id/controls/:control/controls", h.CreateControlSubControl, write)
 }
 
 // List godoc
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/api/middleware"
 	"github.com/defenseunicorns/go-oscal/src/pkg/versioning"
 	oscalTypes_1_1_3 "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 
@@ -33,37 +34,40 @@ func NewComponentDefinitionHandler(sugar *zap.SugaredLogger, db *gorm.DB) *Compo
 }
 
 func (h *ComponentDefinitionHandler) Register(api *echo.Group) {
-	api.GET("", h.List)                                                                                                                // manually tested
-	api.POST("", h.Create)                                                                                                             // manually tested
-	api.GET("/:id", h.Get)                                                                                                             // integration tested
-	api.PUT("/:id", h.Update)                                                                                                          // integration tested
-	api.GET("/:id/full", h.Full)                                                                                                       // manually tested
-	api.GET("/:id/import-component-definitions", h.GetImportComponentDefinitions)                                                      // manually tested
-	api.POST("/:id/import-component-definitions", h.CreateImportComponentDefinitions)                                                  // integration tested
-	api.PUT("/:id/import-component-definitions", h.UpdateImportComponentDefinitions)                                                   // to test
-	api.GET("/:id/components", h.GetComponents)                                                                                        // manually tested
-	api.POST("/:id/components", h.CreateComponents)                                                                                    // integration tested
-	api.PUT("/:id/components", h.UpdateComponents)                                                                                     // integration tested
-	api.GET("/:id/components/:defined-component", h.GetDefinedComponent)                                                               // manually tested
-	api.POST("/:id/components/:defined-component", h.CreateDefinedComponent)                                                           // integration tested
-	api.PUT("/:id/components/:defined-component", h.UpdateDefinedComponent)                                                            // integration tested
-	api.GET("/:id/components/:defined-component/control-implementations", h.GetControlImplementations)                                 // manually tested
-	api.POST("/:id/components/:defined-component/control-implementations", h.CreateControlImplementations)                             // integration tested
-	api.PUT("/:id/components/:defined-component/control-implementations", h.UpdateControlImplementations)                              // integration tested
-	api.PUT("/:id/components/:defined-component/control-implementations/:control-implementation", h.UpdateSingleControlImplementation) // integration tested
-	api.GET("/:id/components/:defined-component/control-implementations/implemented-requirements", h.GetImplementedRequirements)       // manually tested
+	read := middleware.RequirePermission(relational.PermissionOSCALRead)
+	write := middleware.RequirePermission(relational.PermissionCatalogWrite)
+
+	api.GET("", h.List, read)                                                                                                                 // manually tested
+	api.POST("", h.Create, write)                                                                                                             // manually tested
+	api.GET("/:id", h.Get, read)                                                                                                              // integration tested
+	api.PUT("/:id", h.Update, write)                                                                                                          // integration tested
+	api.GET("/:id/full", h.Full, read)                                                                                                        // manually tested
+	api.GET("/:id/import-component-definitions", h.GetImportComponentDefinitions, read)                                                       // manually tested
+	api.POST("/:id/import-component-definitions", h.CreateImportComponentDefinitions, write)                                                  // integration tested
+	api.PUT("/:id/import-component-definitions", h.UpdateImportComponentDefinitions, write)                                                   // to test
+	api.GET("/:id/components", h.GetComponents, read)                                                                                         // manually tested
+	api.POST("/:id/components", h.CreateComponents, write)                                                                                    // integration tested
+	api.PUT("/:id/components", h.UpdateComponents, write)                                                                                     // integration tested
+	api.GET("/:id/components/:defined-component", h.GetDefinedComponent, read)                                                                // manually tested
+	api.POST("/:id/components/:defined-component", h.CreateDefinedComponent, write)                                                           // integration tested
+	api.PUT("/:id/components/:defined-component", h.UpdateDefinedComponent, write)                                                            // integration tested
+	api.GET("/:id/components/:defined-component/control-implementations", h.GetControlImplementations, read)                                  // manually tested
+	api.POST("/:id/components/:defined-component/control-implementations", h.CreateControlImplementations, write)                             // integration tested
+	api.PUT("/:id/components/:defined-component/control-implementations", h.UpdateControlImplementations, write)                              // integration tested
+	api.PUT("/:id/components/:defined-component/control-implementations/:control-implementation", h.UpdateSingleControlImplementation, write) // integration tested
+	api.GET("/:id/components/:defined-component/control-implementations/implemented-requirements", h.GetImplementedRequirements, read)        // manually tested
 	// api.POST("/:id/components/:defined-component/control-implementations/implemented-requirements", h.CreateImplementedRequirements)
 	// api.PUT("/:id/components/:defined-component/control-implementations/implemented-requirements", h.UpdateImplementedRequirements)
-	api.GET("/:id/components/:defined-component/control-implementations/implemented-requirements/statements", h.GetStatements) // manually tested
+	api.GET("/:id/components/:defined-component/control-implementations/implemented-requirements/statements", h.GetStatements, read) // manually tested
 	// api.POST("/:id/components/:defined-component/control-implementations/:control-implementation/implemented-requirements/:implemented-requirement/statements", h.CreateStatements)
 	// api.PUT("/:id/components/:defined-component/control-implementations/:statement", h.UpdateSingleStatement)
-	api.GET("/:id/capabilities", h.GetCapabilities)                                       // manually tested
-	api.POST("/:id/capabilities", h.CreateCapabilities)                                   // integration tested
-	api.PUT("/:id/capabilities/:capability", h.UpdateCapability)                          // integration tested
-	api.GET("/:id/capabilities/incorporates-components", h.GetIncorporatesComponents)     // manually tested
-	api.POST("/:id/capabilities/incorporates-components", h.CreateIncorporatesComponents) // integration tested
-	api.GET("/:id/back-matter", h.GetBackMatter)                                          // manually tested
-	api.POST("/:id/back-matter", h.CreateBackMatter)                                      // integration tested
+	api.GET("/:id/capabilities", h.GetCapabilities, read)                                        // manually tested
+	api.POST("/:id/capabilities", h.CreateCapabilities, write)                                   // integration tested
+	api.PUT("/:id/capabilities/:capability", h.UpdateCapability, write)                          // integration tested
+	api.GET("/:id/capabilities/incorporates-components", h.GetIncorporatesComponents, read)      // manually tested
+	api.POST("/:id/capabilities/incorporates-components", h.CreateIncorporatesComponents, write) // integration tested
+	api.GET("/:id/back-matter", h.GetBackMatter, read)                                           // manually tested
+	api.POST("/:id/back-matter", h.CreateBackMatter, write)                                      // integration tested
 }
 
 // List godoc
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/api/middleware"
 	oscalTypes_1_1_3 "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -28,8 +29,10 @@ func NewPartyHandler(l *zap.SugaredLogger, db *gorm.DB) *PartyHandler {
 }
 
 func (h *PartyHandler) Register(api *echo.Group) {
-	api.GET("", h.List)
-	api.GET("/:id", h.Get)
+	read := middleware.RequirePermission(relational.PermissionOSCALRead)
+
+	api.GET("", h.List, read)
+	api.GET("/:id", h.Get, read)
 }
 
 // List godoc
@@ -0,0 +1,117 @@
+//go:build integration
+
+package oscal
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/compliance-framework/api/internal/tests"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+func TestOscalPermissionApi(t *testing.T) {
+	suite.Run(t, new(PermissionApiIntegrationSuite))
+}
+
+type PermissionApiIntegrationSuite struct {
+	tests.IntegrationTestSuite
+}
+
+func (suite *PermissionApiIntegrationSuite) TestPermissionMatrix() {
+	err := suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+
+	roles := []string{
+		relational.RoleAdmin,
+		relational.RoleComplianceOfficer,
+		relational.RoleAssessor,
+		relational.RoleReadOnly,
+		relational.RoleAgent,
+	}
+	tokens := map[string]string{}
+	for _, role := range roles {
+		token, err := suite.GetAuthTokenWithRoles(role)
+		suite.Require().NoError(err)
+		tokens[role] = *token
+	}
+
+	do := func(key string, method string, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(method, path, bytes.NewReader([]byte("{}")))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", key))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		server.E().ServeHTTP(rec, req)
+		return rec
+	}
+
+	id := uuid.New().String()
+	readers := []string{"admin", "compliance-officer", "assessor", "read-only"}
+	routes := []struct {
+		method  string
+		path    string
+		allowed []string
+	}{
+		{http.MethodGet, "/api/oscal/catalogs", readers},
+		{http.MethodPost, "/api/oscal/catalogs", []string{"admin", "compliance-officer"}},
+		{http.MethodGet, "/api/oscal/profiles/" + id, readers},
+		{http.MethodPost, "/api/oscal/profiles/" + id + "/resolve", []string{"admin", "compliance-officer"}},
+		{http.MethodPut, "/api/oscal/component-definitions/" + id, []string{"admin", "compliance-officer"}},
+		{http.MethodGet, "/api/oscal/system-security-plans", readers},
+		{http.MethodDelete, "/api/oscal/system-security-plans/" + id, []string{"admin", "compliance-officer"}},
+		{http.MethodGet, "/api/oscal/plan-of-action-and-milestones", readers},
+		{http.MethodPost, "/api/oscal/plan-of-action-and-milestones/" + id + "/poam-items", []string{"admin", "compliance-officer", "assessor"}},
+		{http.MethodGet, "/api/oscal/assessment-plans", readers},
+		{http.MethodPost, "/api/oscal/assessment-plans", []string{"admin", "assessor"}},
+		{http.MethodDelete, "/api/oscal/activities/" + id, []string{"admin", "assessor"}},
+		{http.MethodGet, "/api/oscal/assessment-results", readers},
+		{http.MethodPost, "/api/oscal/assessment-results/" + id + "/results", []string{"admin", "assessor"}},
+		{http.MethodGet, "/api/oscal/parties", readers},
+		{http.MethodGet, "/api/oscal/roles", readers},
+	}
+
+	suite.Run("Routes require the permission they declare", func() {
+		for _, route := range routes {
+			for _, role := range roles {
+				rec := do(tokens[role], route.method, route.path)
+
+				description := fmt.Sprintf("%s %s as %s", route.method, route.path, role)
+				suite.NotEqual(http.StatusUnauthorized, rec.Code, description)
+				if slices.Contains(route.allowed, role) {
+					suite.NotEqual(http.StatusForbidden, rec.Code, description)
+				} else {
+					suite.Equal(http.StatusForbidden, rec.Code, description)
+				}
+			}
+		}
+	})
+
+	suite.Run("Users without roles are forbidden", func() {
+		token, err := suite.GetAuthTokenWithRoles()
+		suite.Require().NoError(err)
+		for _, route := range routes {
+			suite.Equal(http.StatusForbidden, do(*token, route.method, route.path).Code, route.path)
+		}
+	})
+
+	suite.Run("Agent keys are not accepted", func() {
+		credential, key, err := relational.NewAgentCredential("agent", relational.AgentCredentialScopeRead, nil)
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.DB.Create(credential).Error)
+		suite.Equal(http.StatusUnauthorized, do(key, http.MethodGet, "/api/oscal/catalogs").Code)
+	})
+}
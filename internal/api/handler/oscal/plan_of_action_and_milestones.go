@@ -15,6 +15,8 @@ import (
 
 	"github.com/compliance-framework/api/internal/api"
 	"github.com/compliance-framework/api/internal/api/handler"
+	"github.com/compliance-framework/api/internal/api/middleware"
+	"github.com/compliance-framework/api/internal/service"
 	"github.com/compliance-framework/api/internal/service/relational"
 	oscalTypes_1_1_3 "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 )
@@ -52,45 +54,49 @@ func (h *PlanOfActionAndMilestonesHandler) verifyPoamExists(ctx echo.Context, po
 
 // Register registers POA&M endpoints to the API group.
 func (h *PlanOfActionAndMilestonesHandler) Register(api *echo.Group) {
-	api.GET("", h.List)          // GET /oscal/plan-of-action-and-milestones
-	api.POST("", h.Create)       // POST /oscal/plan-of-action-and-milestones
-	api.GET("/:id", h.Get)       // GET /oscal/plan-of-action-and-milestones/:id
-	api.PUT("/:id", h.Update)    // PUT /oscal/plan-of-action-and-milestones/:id
-	api.DELETE("/:id", h.Delete) // DELETE /oscal/plan-of-action-and-milestones/:id
-	api.GET("/:id/full", h.Full) // GET /oscal/plan-of-action-and-milestones/:id/full
-	api.GET("/:id/metadata", h.GetMetadata)
-	api.PUT("/:id/metadata", h.UpdateMetadata)
-	api.GET("/:id/import-ssp", h.GetImportSsp)
-	api.POST("/:id/import-ssp", h.CreateImportSsp)
-	api.PUT("/:id/import-ssp", h.UpdateImportSsp)
-	api.GET("/:id/system-id", h.GetSystemId)
-	api.POST("/:id/system-id", h.CreateSystemId)
-	api.PUT("/:id/system-id", h.UpdateSystemId)
-	api.GET("/:id/local-definitions", h.GetLocalDefinitions)
-	api.GET("/:id/back-matter", h.GetBackMatter)
-	api.POST("/:id/back-matter", h.CreateBackMatter)
-	api.PUT("/:id/back-matter", h.UpdateBackMatter)
-	api.DELETE("/:id/back-matter", h.DeleteBackMatter)
-	api.GET("/:id/back-matter/resources", h.GetBackMatterResources)
-	api.POST("/:id/back-matter/resources", h.CreateBackMatterResource)
-	api.PUT("/:id/back-matter/resources/:resourceId", h.UpdateBackMatterResource)
-	api.DELETE("/:id/back-matter/resources/:resourceId", h.DeleteBackMatterResource)
-	api.GET("/:id/observations", h.GetObservations)
-	api.POST("/:id/observations", h.CreateObservation)
-	api.PUT("/:id/observations/:obsId", h.UpdateObservation)
-	api.DELETE("/:id/observations/:obsId", h.DeleteObservation)
-	api.GET("/:id/risks", h.GetRisks)
-	api.POST("/:id/risks", h.CreateRisk)
-	api.PUT("/:id/risks/:riskId", h.UpdateRisk)
-	api.DELETE("/:id/risks/:riskId", h.DeleteRisk)
-	api.GET("/:id/findings", h.GetFindings)
-	api.POST("/:id/findings", h.CreateFinding)
-	api.PUT("/:id/findings/:findingId", h.UpdateFinding)
-	api.DELETE("/:id/findings/:findingId", h.DeleteFinding)
-	api.GET("/:id/poam-items", h.GetPoamItems)
-	api.POST("/:id/poam-items", h.CreatePoamItem)
-	api.PUT("/:id/poam-items/:itemId", h.UpdatePoamItem)
-	api.DELETE("/:id/poam-items/:itemId", h.DeletePoamItem)
+	scope := middleware.RequireSystemAccess(h.db, &relational.PlanOfActionAndMilestones{}, (*service.SystemScope).PlansOfActionAndMilestones)
+	read := middleware.Chain(middleware.RequirePermission(relational.PermissionOSCALRead), scope)
+	write := middleware.Chain(middleware.RequirePermission(relational.PermissionPOAMWrite), scope)
+
+	api.GET("", h.List, read)           // GET /oscal/plan-of-action-and-milestones
+	api.POST("", h.Create, write)       // POST /oscal/plan-of-action-and-milestones
+	api.GET("/:id", h.Get, read)        // GET /oscal/plan-of-action-and-milestones/:id
+	api.PUT("/:id", h.Update, write)    // PUT /oscal/plan-of-action-and-milestones/:id
+	api.DELETE("/:id", h.Delete, write) // DELETE /oscal/plan-of-action-and-milestones/:id
+	api.GET("/:id/full", h.Full, read)  // GET /oscal/plan-of-action-and-milestones/:id/full
+	api.GET("/:id/metadata", h.GetMetadata, read)
+	api.PUT("/:id/metadata", h.UpdateMetadata, write)
+	api.GET("/:id/import-ssp", h.GetImportSsp, read)
+	api.POST("/:id/import-ssp", h.CreateImportSsp, write)
+	api.PUT("/:id/import-ssp", h.UpdateImportSsp, write)
+	api.GET("/:id/system-id", h.GetSystemId, read)
+	api.POST("/:id/system-id", h.CreateSystemId, write)
+	api.PUT("/:id/system-id", h.UpdateSystemId, write)
+	api.GET("/:id/local-definitions", h.GetLocalDefinitions, read)
+	api.GET("/:id/back-matter", h.GetBackMatter, read)
+	api.POST("/:id/back-matter", h.CreateBackMatter, write)
+	api.PUT("/:id/back-matter", h.UpdateBackMatter, write)
+	api.DELETE("/:id/back-matter", h.DeleteBackMatter, write)
+	api.GET("/:id/back-matter/resources", h.GetBackMatterResources, read)
+	api.POST("/:id/back-matter/resources", h.CreateBackMatterResource, write)
+	api.PUT("/:id/back-matter/resources/:resourceId", h.UpdateBackMatterResource, write)
+	api.DELETE("/:id/back-matter/resources/:resourceId", h.DeleteBackMatterResource, write)
+	api.GET("/:id/observations", h.GetObservations, read)
+	api.POST("/:id/observations", h.CreateObservation, write)
+	api.PUT("/:id/observations/:obsId", h.UpdateObservation, write)
+	api.DELETE("/:id/observations/:obsId", h.DeleteObservation, write)
+	api.GET("/:id/risks", h.GetRisks, read)
+	api.POST("/:id/risks", h.CreateRisk, write)
+	api.PUT("/:id/risks/:riskId", h.UpdateRisk, write)
+	api.DELETE("/:id/risks/:riskId", h.DeleteRisk, write)
+	api.GET("/:id/findings", h.GetFindings, read)
+	api.POST("/:id/findings", h.CreateFinding, write)
+	api.PUT("/:id/findings/:findingId", h.UpdateFinding, write)
+	api.DELETE("/:id/findings/:findingId", h.DeleteFinding, write)
+	api.GET("/:id/poam-items", h.GetPoamItems, read)
+	api.POST("/:id/poam-items", h.CreatePoamItem, write)
+	api.PUT("/:id/poam-items/:itemId", h.UpdatePoamItem, write)
+	api.DELETE("/:id/poam-items/:itemId", h.DeletePoamItem, write)
 }
 
 // validatePoamInput validates POAM input following OSCAL requirements
@@ -202,7 +208,7 @@ func (h *PlanOfActionAndMilestonesHandler) validatePoamItemInput(item *oscalType
 //	@Router			/oscal/plan-of-action-and-milestones [get]
 func (h *PlanOfActionAndMilestonesHandler) List(ctx echo.Context) error {
 	var poams []relational.PlanOfActionAndMilestones
-	if err := h.db.Preload("Metadata").Preload("Metadata.Revisions").Find(&poams).Error; err != nil {
+	if err := h.db.Scopes(middleware.GetSystemScope(ctx).PlansOfActionAndMilestones).Preload("Metadata").Preload("Metadata.Revisions").Find(&poams).Error; err != nil {
 		h.sugar.Errorw("failed to list poams", "error", err)
 		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
 	}
@@ -1246,7 +1252,16 @@ func (h *PlanOfActionAndMilestonesHandler) CreatePoamItem(ctx echo.Context) erro
 	relPoamItem := &relational.PoamItem{}
 	relPoamItem.UnmarshalOscal(oscalPoamItem, id)
 
-	if err := h.db.Create(relPoamItem).Error; err != nil {
+	err = h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(relPoamItem).Error; err != nil {
+			return err
+		}
+		return service.EnqueueWebhookEvent(tx, relational.WebhookEventPoamItemCreated, PoamItemWebhook{
+			PoamID:   id,
+			PoamItem: *relPoamItem.MarshalOscal(),
+		})
+	})
+	if err != nil {
 		h.sugar.Errorf("Failed to create POAM item: %v", err)
 		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
 	}
@@ -1254,6 +1269,12 @@ func (h *PlanOfActionAndMilestonesHandler) CreatePoamItem(ctx echo.Context) erro
 	return ctx.JSON(http.StatusCreated, handler.GenericDataResponse[oscalTypes_1_1_3.PoamItem]{Data: *relPoamItem.MarshalOscal()})
 }
 
+// PoamItemWebhook is the data of poam-item.created webhook events.
+type PoamItemWebhook struct {
+	PoamID   uuid.UUID                 `json:"poam-id"`
+	PoamItem oscalTypes_1_1_3.PoamItem `json:"poam-item"`
+}
+
 // Update godoc
 //
 //	@Summary		Update a POA&M
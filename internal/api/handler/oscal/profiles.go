@@ -9,6 +9,8 @@ import (
 
 	"github.com/compliance-framework/api/internal/api"
 	"github.com/compliance-framework/api/internal/api/handler"
+	"github.com/compliance-framework/api/internal/api/middleware"
+	"github.com/compliance-framework/api/internal/service"
 	"github.com/compliance-framework/api/internal/service/relational"
 	"github.com/defenseunicorns/go-oscal/src/pkg/versioning"
 	oscalTypes_1_1_3 "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
@@ -31,26 +33,29 @@ func NewProfileHandler(sugar *zap.SugaredLogger, db *gorm.DB) *ProfileHandler {
 }
 
 func (h *ProfileHandler) Register(api *echo.Group) {
-	api.GET("", h.List)
-	api.POST("", h.Create)
-	api.GET("/:id", h.Get)
-	api.GET("/:id/resolved", h.Resolved)
+	read := middleware.RequirePermission(relational.PermissionOSCALRead)
+	write := middleware.RequirePermission(relational.PermissionCatalogWrite)
 
-	api.GET("/:id/modify", h.GetModify)
-	api.GET("/:id/back-matter", h.GetBackmatter)
-	api.POST("/:id/resolve", h.Resolve)
-	api.GET("/:id/full", h.GetFull)
+	api.GET("", h.List, read)
+	api.POST("", h.Create, write)
+	api.GET("/:id", h.Get, read)
+	api.GET("/:id/resolved", h.Resolved, read)
+
+	api.GET("/:id/modify", h.GetModify, read)
+	api.GET("/:id/back-matter", h.GetBackmatter, read)
+	api.POST("/:id/resolve", h.Resolve, write)
+	api.GET("/:id/full", h.GetFull, read)
 
 	// imports
-	api.GET("/:id/imports", h.ListImports)
-	api.POST("/:id/imports/add", h.AddImport)
-	api.GET("/:id/imports/:href", h.GetImport)
-	api.PUT("/:id/imports/:href", h.UpdateImport)
-	api.DELETE("/:id/imports/:href", h.DeleteImport)
+	api.GET("/:id/imports", h.ListImports, read)
+	api.POST("/:id/imports/add", h.AddImport, write)
+	api.GET("/:id/imports/:href", h.GetImport, read)
+	api.PUT("/:id/imports/:href", h.UpdateImport, write)
+	api.DELETE("/:id/imports/:href", h.DeleteImport, write)
 
 	// merge
-	api.GET("/:id/merge", h.GetMerge)
-	api.PUT("/:id/merge", h.UpdateMerge)
+	api.GET("/:id/merge", h.GetMerge, read)
+	api.PUT("/:id/merge", h.UpdateMerge, write)
 }
 
 // List godoc
@@ -722,7 +727,17 @@ func (h *ProfileHandler) Resolve(ctx echo.Context) error {
 
 	catalog.BackMatter = CombineBackmatter(backmatters)
 
-	if err := h.db.Save(&catalog).Error; err != nil {
+	err = h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&catalog).Error; err != nil {
+			return err
+		}
+		return service.EnqueueWebhookEvent(tx, relational.WebhookEventProfileResolved, ProfileResolvedWebhook{
+			ProfileID: id,
+			CatalogID: newID,
+			Title:     profile.Metadata.Title,
+		})
+	})
+	if err != nil {
 		h.sugar.Errorw("error saving new catalog to database", "id", idParam, "error", err)
 		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
 	}
@@ -734,6 +749,13 @@ func (h *ProfileHandler) Resolve(ctx echo.Context) error {
 	return ctx.JSON(http.StatusCreated, handler.GenericDataResponse[response]{Data: resp})
 }
 
+// ProfileResolvedWebhook is the data of profile.resolved webhook events.
+type ProfileResolvedWebhook struct {
+	ProfileID uuid.UUID `json:"profile-id"`
+	CatalogID uuid.UUID `json:"catalog-id"`
+	Title     string    `json:"title"`
+}
+
 // Create godoc
 //
 //	@Summary		Create a new OSCAL Profile
@@ -0,0 +1,207 @@
+//go:build integration
+
+package oscal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/api/handler"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/compliance-framework/api/internal/tests"
+	oscalTypes_1_1_3 "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+func TestOscalSystemScopeApi(t *testing.T) {
+	suite.Run(t, new(SystemScopeApiIntegrationSuite))
+}
+
+type SystemScopeApiIntegrationSuite struct {
+	tests.IntegrationTestSuite
+}
+
+// system is a system security plan, along with the documents importing it.
+type system struct {
+	ssp, plan, results, poam string
+}
+
+func (suite *SystemScopeApiIntegrationSuite) TestSystemScope() {
+	err := suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+	// Assessors and read-only users only have access to the systems granted to them once their roles no longer have
+	// access to every system.
+	suite.Require().NoError(suite.DB.
+		Where("role_name IN ? AND permission = ?", []string{relational.RoleAssessor, relational.RoleReadOnly}, relational.PermissionSystemAll).
+		Delete(&relational.AccessRolePermission{}).Error)
+
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+
+	adminToken, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+	assessorToken, err := suite.GetAuthTokenWithRoles(relational.RoleAssessor)
+	suite.Require().NoError(err)
+	readOnlyToken, err := suite.GetAuthTokenWithRoles(relational.RoleReadOnly)
+	suite.Require().NoError(err)
+
+	do := func(token string, method string, path string, body any) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		reqBody, _ := json.Marshal(body)
+		req := httptest.NewRequest(method, path, bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", token))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		server.E().ServeHTTP(rec, req)
+		return rec
+	}
+
+	createSystem := func(name string) system {
+		metadata := oscalTypes_1_1_3.Metadata{Title: name, Version: "1.0.0"}
+		s := system{ssp: uuid.NewString(), plan: uuid.NewString(), results: uuid.NewString(), poam: uuid.NewString()}
+
+		rec := do(*adminToken, http.MethodPost, "/api/oscal/system-security-plans", oscalTypes_1_1_3.SystemSecurityPlan{
+			UUID:     s.ssp,
+			Metadata: metadata,
+		})
+		suite.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+		rec = do(*adminToken, http.MethodPost, "/api/oscal/assessment-plans", oscalTypes_1_1_3.AssessmentPlan{
+			UUID:      s.plan,
+			Metadata:  metadata,
+			ImportSsp: oscalTypes_1_1_3.ImportSsp{Href: "#" + s.ssp},
+		})
+		suite.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+		rec = do(*adminToken, http.MethodPost, "/api/oscal/assessment-results", oscalTypes_1_1_3.AssessmentResults{
+			UUID:     s.results,
+			Metadata: metadata,
+			ImportAp: oscalTypes_1_1_3.ImportAp{Href: "https://ccf.example.com/api/oscal/assessment-plans/" + s.plan},
+			Results: []oscalTypes_1_1_3.Result{{
+				UUID:             uuid.NewString(),
+				Title:            "Result",
+				Description:      "Result",
+				Start:            time.Now(),
+				ReviewedControls: oscalTypes_1_1_3.ReviewedControls{Description: "Controls reviewed"},
+			}},
+		})
+		suite.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+		rec = do(*adminToken, http.MethodPost, "/api/oscal/plan-of-action-and-milestones", oscalTypes_1_1_3.PlanOfActionAndMilestones{
+			UUID:      s.poam,
+			Metadata:  metadata,
+			ImportSsp: &oscalTypes_1_1_3.ImportSsp{Href: "#" + s.ssp},
+		})
+		suite.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+		return s
+	}
+
+	listed := func(token string, path string) []string {
+		rec := do(token, http.MethodGet, path, nil)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		response := &handler.GenericDataListResponse[struct {
+			UUID string `json:"uuid"`
+		}]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		uuids := []string{}
+		for _, document := range response.Data {
+			uuids = append(uuids, document.UUID)
+		}
+		return uuids
+	}
+
+	payments := createSystem("Payments")
+	ledger := createSystem("Ledger")
+	suite.Require().NoError(suite.DB.Create(&relational.SystemGrant{
+		SystemSecurityPlanID: uuid.MustParse(payments.ssp),
+		RoleName:             ptr(relational.RoleAssessor),
+	}).Error)
+
+	suite.Run("Lists are limited to granted systems", func() {
+		suite.ElementsMatch([]string{payments.ssp}, listed(*assessorToken, "/api/oscal/system-security-plans"))
+		suite.ElementsMatch([]string{payments.plan}, listed(*assessorToken, "/api/oscal/assessment-plans"))
+		suite.ElementsMatch([]string{payments.results}, listed(*assessorToken, "/api/oscal/assessment-results"))
+		suite.ElementsMatch([]string{payments.poam}, listed(*assessorToken, "/api/oscal/plan-of-action-and-milestones"))
+	})
+
+	suite.Run("Users with the system:all permission see every system", func() {
+		suite.ElementsMatch([]string{payments.ssp, ledger.ssp}, listed(*adminToken, "/api/oscal/system-security-plans"))
+		suite.ElementsMatch([]string{payments.results, ledger.results}, listed(*adminToken, "/api/oscal/assessment-results"))
+	})
+
+	suite.Run("Users without grants see no system", func() {
+		suite.Empty(listed(*readOnlyToken, "/api/oscal/system-security-plans"))
+		suite.Empty(listed(*readOnlyToken, "/api/oscal/assessment-plans"))
+		suite.Empty(listed(*readOnlyToken, "/api/oscal/assessment-results"))
+		suite.Empty(listed(*readOnlyToken, "/api/oscal/plan-of-action-and-milestones"))
+		suite.Equal(http.StatusNotFound, do(*readOnlyToken, http.MethodGet, "/api/oscal/system-security-plans/"+payments.ssp, nil).Code)
+	})
+
+	suite.Run("Activities are limited to the assessment plans of granted systems", func() {
+		activities := map[string]string{}
+		for _, s := range []system{payments, ledger} {
+			activity := uuid.NewString()
+			rec := do(*adminToken, http.MethodPost, "/api/oscal/activities", oscalTypes_1_1_3.Activity{UUID: activity, Description: "Review"})
+			suite.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+			task := uuid.NewString()
+			rec = do(*adminToken, http.MethodPost, "/api/oscal/assessment-plans/"+s.plan+"/tasks", oscalTypes_1_1_3.Task{UUID: task, Title: "Review", Type: "action"})
+			suite.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+			rec = do(*adminToken, http.MethodPost, "/api/oscal/assessment-plans/"+s.plan+"/tasks/"+task+"/associated-activities/"+activity, nil)
+			suite.Require().Less(rec.Code, 300, rec.Body.String())
+			activities[s.plan] = activity
+		}
+		unassociated := uuid.NewString()
+		rec := do(*adminToken, http.MethodPost, "/api/oscal/activities", oscalTypes_1_1_3.Activity{UUID: unassociated, Description: "Review"})
+		suite.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+		suite.Equal(http.StatusOK, do(*assessorToken, http.MethodGet, "/api/oscal/activities/"+activities[payments.plan], nil).Code)
+		suite.Equal(http.StatusNotFound, do(*assessorToken, http.MethodGet, "/api/oscal/activities/"+activities[ledger.plan], nil).Code)
+		suite.Equal(http.StatusNotFound, do(*assessorToken, http.MethodDelete, "/api/oscal/activities/"+activities[ledger.plan], nil).Code)
+		suite.Equal(http.StatusNotFound, do(*assessorToken, http.MethodGet, "/api/oscal/activities/"+unassociated, nil).Code)
+		suite.Equal(http.StatusOK, do(*adminToken, http.MethodGet, "/api/oscal/activities/"+unassociated, nil).Code)
+	})
+
+	suite.Run("Documents of other systems are not found", func() {
+		for _, path := range []string{
+			"/api/oscal/system-security-plans/%s",
+			"/api/oscal/system-security-plans/%s/metadata",
+		} {
+			suite.Equal(http.StatusOK, do(*assessorToken, http.MethodGet, fmt.Sprintf(path, payments.ssp), nil).Code, path)
+			suite.Equal(http.StatusNotFound, do(*assessorToken, http.MethodGet, fmt.Sprintf(path, ledger.ssp), nil).Code, path)
+		}
+		suite.Equal(http.StatusOK, do(*assessorToken, http.MethodGet, "/api/oscal/assessment-plans/"+payments.plan, nil).Code)
+		suite.Equal(http.StatusNotFound, do(*assessorToken, http.MethodGet, "/api/oscal/assessment-plans/"+ledger.plan, nil).Code)
+		suite.Equal(http.StatusOK, do(*assessorToken, http.MethodGet, "/api/oscal/assessment-results/"+payments.results, nil).Code)
+		suite.Equal(http.StatusNotFound, do(*assessorToken, http.MethodGet, "/api/oscal/assessment-results/"+ledger.results, nil).Code)
+		suite.Equal(http.StatusOK, do(*assessorToken, http.MethodGet, "/api/oscal/plan-of-action-and-milestones/"+payments.poam, nil).Code)
+		suite.Equal(http.StatusNotFound, do(*assessorToken, http.MethodGet, "/api/oscal/plan-of-action-and-milestones/"+ledger.poam, nil).Code)
+		suite.Equal(http.StatusNotFound, do(*assessorToken, http.MethodDelete, "/api/oscal/assessment-results/"+ledger.results, nil).Code)
+	})
+
+	suite.Run("Grants to users apply to them", func() {
+		user := relational.User{Email: "dummy@example.com", FirstName: "Dummy", LastName: "User"}
+		suite.Require().NoError(user.SetPassword("Pa55w0rd"))
+		suite.Require().NoError(suite.DB.Create(&user).Error)
+		suite.Require().NoError(suite.DB.Create(&relational.SystemGrant{
+			SystemSecurityPlanID: uuid.MustParse(ledger.ssp),
+			UserID:               user.ID,
+		}).Error)
+
+		// The tokens are issued to the dummy user, so the read-only user now has access to the ledger, the assessor
+		// to the systems granted to the user and to the role, and the admin still has access to every system.
+		suite.ElementsMatch([]string{ledger.ssp}, listed(*readOnlyToken, "/api/oscal/system-security-plans"))
+		suite.ElementsMatch([]string{payments.ssp, ledger.ssp}, listed(*assessorToken, "/api/oscal/system-security-plans"))
+		suite.ElementsMatch([]string{payments.ssp, ledger.ssp}, listed(*adminToken, "/api/oscal/system-security-plans"))
+	})
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
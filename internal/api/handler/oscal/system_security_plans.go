@@ -11,6 +11,8 @@ import (
 
 	"github.com/compliance-framework/api/internal/api"
 	"github.com/compliance-framework/api/internal/api/handler"
+	"github.com/compliance-framework/api/internal/api/middleware"
+	"github.com/compliance-framework/api/internal/service"
 	"github.com/compliance-framework/api/internal/service/relational"
 	oscalTypes_1_1_3 "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 
@@ -106,59 +108,63 @@ func (h *SystemSecurityPlanHandler) validateImplementedRequirementInput(req *osc
 }
 
 func (h *SystemSecurityPlanHandler) Register(api *echo.Group) {
-	api.GET("", h.List)
-	api.POST("", h.Create)
-	api.GET("/:id", h.Get)
-	api.PUT("/:id", h.Update)
-	api.GET("/:id/profile", h.GetProfile)
-	api.PUT("/:id/profile", h.AttachProfile)
-	api.DELETE("/:id", h.Delete)
-	api.GET("/:id/full", h.Full)
-	api.GET("/:id/metadata", h.GetMetadata)
-	api.PUT("/:id/metadata", h.UpdateMetadata)
-	api.GET("/:id/import-profile", h.GetImportProfile)
-	api.PUT("/:id/import-profile", h.UpdateImportProfile)
-	api.GET("/:id/system-characteristics", h.GetCharacteristics)
-	api.PUT("/:id/system-characteristics", h.UpdateCharacteristics)
-	api.GET("/:id/system-characteristics/network-architecture", h.GetCharacteristicsNetworkArchitecture)
-	api.PUT("/:id/system-characteristics/network-architecture/diagrams/:diagram", h.UpdateCharacteristicsNetworkArchitectureDiagram)
-	api.GET("/:id/system-characteristics/data-flow", h.GetCharacteristicsDataFlow)
-	api.PUT("/:id/system-characteristics/data-flow/diagrams/:diagram", h.UpdateCharacteristicsDataFlowDiagram)
-	api.GET("/:id/system-characteristics/authorization-boundary", h.GetCharacteristicsAuthorizationBoundary)
-	api.PUT("/:id/system-characteristics/authorization-boundary/diagrams/:diagram", h.UpdateCharacteristicsAuthorizationBoundaryDiagram)
-	api.GET("/:id/system-implementation", h.GetSystemImplementation)
-	api.PUT("/:id/system-implementation", h.UpdateSystemImplementation)
-	api.GET("/:id/system-implementation/users", h.GetSystemImplementationUsers)
-	api.POST("/:id/system-implementation/users", h.CreateSystemImplementationUser)
-	api.PUT("/:id/system-implementation/users/:userId", h.UpdateSystemImplementationUser)
-	api.DELETE("/:id/system-implementation/users/:userId", h.DeleteSystemImplementationUser)
-	api.GET("/:id/system-implementation/components", h.GetSystemImplementationComponents)
-	api.GET("/:id/system-implementation/components/:componentId", h.GetSystemImplementationComponent)
-	api.POST("/:id/system-implementation/components", h.CreateSystemImplementationComponent)
-	api.PUT("/:id/system-implementation/components/:componentId", h.UpdateSystemImplementationComponent)
-	api.DELETE("/:id/system-implementation/components/:componentId", h.DeleteSystemImplementationComponent)
-	api.GET("/:id/system-implementation/inventory-items", h.GetSystemImplementationInventoryItems)
-	api.POST("/:id/system-implementation/inventory-items", h.CreateSystemImplementationInventoryItem)
-	api.PUT("/:id/system-implementation/inventory-items/:itemId", h.UpdateSystemImplementationInventoryItem)
-	api.DELETE("/:id/system-implementation/inventory-items/:itemId", h.DeleteSystemImplementationInventoryItem)
-	api.GET("/:id/system-implementation/leveraged-authorizations", h.GetSystemImplementationLeveragedAuthorizations)
-	api.POST("/:id/system-implementation/leveraged-authorizations", h.CreateSystemImplementationLeveragedAuthorization)
-	api.PUT("/:id/system-implementation/leveraged-authorizations/:authId", h.UpdateSystemImplementationLeveragedAuthorization)
-	api.DELETE("/:id/system-implementation/leveraged-authorizations/:authId", h.DeleteSystemImplementationLeveragedAuthorization)
-	api.GET("/:id/control-implementation", h.GetControlImplementation)
-	api.PUT("/:id/control-implementation", h.UpdateControlImplementation)
-	api.GET("/:id/control-implementation/implemented-requirements", h.GetImplementedRequirements)
-	api.POST("/:id/control-implementation/implemented-requirements", h.CreateImplementedRequirement)
-	api.PUT("/:id/control-implementation/implemented-requirements/:reqId", h.UpdateImplementedRequirement)
-	api.POST("/:id/control-implementation/implemented-requirements/:reqId/statements", h.CreateImplementedRequirementStatement)
-	api.PUT("/:id/control-implementation/implemented-requirements/:reqId/statements/:stmtId", h.UpdateImplementedRequirementStatement)
-	api.DELETE("/:id/control-implementation/implemented-requirements/:reqId", h.DeleteImplementedRequirement)
-	api.GET("/:id/back-matter", h.GetBackMatter)
-	api.PUT("/:id/back-matter", h.UpdateBackMatter)
-	api.GET("/:id/back-matter/resources", h.GetBackMatterResources)
-	api.POST("/:id/back-matter/resources", h.CreateBackMatterResource)
-	api.PUT("/:id/back-matter/resources/:resourceId", h.UpdateBackMatterResource)
-	api.DELETE("/:id/back-matter/resources/:resourceId", h.DeleteBackMatterResource)
+	scope := middleware.RequireSystemAccess(h.db, &relational.SystemSecurityPlan{}, (*service.SystemScope).SystemSecurityPlans)
+	read := middleware.Chain(middleware.RequirePermission(relational.PermissionOSCALRead), scope)
+	write := middleware.Chain(middleware.RequirePermission(relational.PermissionSSPWrite), scope)
+
+	api.GET("", h.List, read)
+	api.POST("", h.Create, write)
+	api.GET("/:id", h.Get, read)
+	api.PUT("/:id", h.Update, write)
+	api.GET("/:id/profile", h.GetProfile, read)
+	api.PUT("/:id/profile", h.AttachProfile, write)
+	api.DELETE("/:id", h.Delete, write)
+	api.GET("/:id/full", h.Full, read)
+	api.GET("/:id/metadata", h.GetMetadata, read)
+	api.PUT("/:id/metadata", h.UpdateMetadata, write)
+	api.GET("/:id/import-profile", h.GetImportProfile, read)
+	api.PUT("/:id/import-profile", h.UpdateImportProfile, write)
+	api.GET("/:id/system-characteristics", h.GetCharacteristics, read)
+	api.PUT("/:id/system-characteristics", h.UpdateCharacteristics, write)
+	api.GET("/:id/system-characteristics/network-architecture", h.GetCharacteristicsNetworkArchitecture, read)
+	api.PUT("/:id/system-characteristics/network-architecture/diagrams/:diagram", h.UpdateCharacteristicsNetworkArchitectureDiagram, write)
+	api.GET("/:id/system-characteristics/data-flow", h.GetCharacteristicsDataFlow, read)
+	api.PUT("/:id/system-characteristics/data-flow/diagrams/:diagram", h.UpdateCharacteristicsDataFlowDiagram, write)
+	api.GET("/:id/system-characteristics/authorization-boundary", h.GetCharacteristicsAuthorizationBoundary, read)
+	api.PUT("/:id/system-characteristics/authorization-boundary/diagrams/:diagram", h.UpdateCharacteristicsAuthorizationBoundaryDiagram, write)
+	api.GET("/:id/system-implementation", h.GetSystemImplementation, read)
+	api.PUT("/:id/system-implementation", h.UpdateSystemImplementation, write)
+	api.GET("/:id/system-implementation/users", h.GetSystemImplementationUsers, read)
+	api.POST("/:id/system-implementation/users", h.CreateSystemImplementationUser, write)
+	api.PUT("/:id/system-implementation/users/:userId", h.UpdateSystemImplementationUser, write)
+	api.DELETE("/:id/system-implementation/users/:userId", h.DeleteSystemImplementationUser, write)
+	api.GET("/:id/system-implementation/components", h.GetSystemImplementationComponents, read)
+	api.GET("/:id/system-implementation/components/:componentId", h.GetSystemImplementationComponent, read)
+	api.POST("/:id/system-implementation/components", h.CreateSystemImplementationComponent, write)
+	api.PUT("/:id/system-implementation/components/:componentId", h.UpdateSystemImplementationComponent, write)
+	api.DELETE("/:id/system-implementation/components/:componentId", h.DeleteSystemImplementationComponent, write)
+	api.GET("/:id/system-implementation/inventory-items", h.GetSystemImplementationInventoryItems, read)
+	api.POST("/:id/system-implementation/inventory-items", h.CreateSystemImplementationInventoryItem, write)
+	api.PUT("/:id/system-implementation/inventory-items/:itemId", h.UpdateSystemImplementationInventoryItem, write)
+	api.DELETE("/:id/system-implementation/inventory-items/:itemId", h.DeleteSystemImplementationInventoryItem, write)
+	api.GET("/:id/system-implementation/leveraged-authorizations", h.GetSystemImplementationLeveragedAuthorizations, read)
+	api.POST("/:id/system-implementation/leveraged-authorizations", h.CreateSystemImplementationLeveragedAuthorization, write)
+	api.PUT("/:id/system-implementation/leveraged-authorizations/:authId", h.UpdateSystemImplementationLeveragedAuthorization, write)
+	api.DELETE("/:id/system-implementation/leveraged-authorizations/:authId", h.DeleteSystemImplementationLeveragedAuthorization, write)
+	api.GET("/:id/control-implementation", h.GetControlImplementation, read)
+	api.PUT("/:id/control-implementation", h.UpdateControlImplementation, write)
+	api.GET("/:id/control-implementation/implemented-requirements", h.GetImplementedRequirements, read)
+	api.POST("/:id/control-implementation/implemented-requirements", h.CreateImplementedRequirement, write)
+	api.PUT("/:id/control-implementation/implemented-requirements/:reqId", h.UpdateImplementedRequirement, write)
+	api.POST("/:id/control-implementation/implemented-requirements/:reqId/statements", h.CreateImplementedRequirementStatement, write)
+	api.PUT("/:id/control-implementation/implemented-requirements/:reqId/statements/:stmtId", h.UpdateImplementedRequirementStatement, write)
+	api.DELETE("/:id/control-implementation/implemented-requirements/:reqId", h.DeleteImplementedRequirement, write)
+	api.GET("/:id/back-matter", h.GetBackMatter, read)
+	api.PUT("/:id/back-matter", h.UpdateBackMatter, write)
+	api.GET("/:id/back-matter/resources", h.GetBackMatterResources, read)
+	api.POST("/:id/back-matter/resources", h.CreateBackMatterResource, write)
+	api.PUT("/:id/back-matter/resources/:resourceId", h.UpdateBackMatterResource, write)
+	api.DELETE("/:id/back-matter/resources/:resourceId", h.DeleteBackMatterResource, write)
 }
 
 // List godoc
@@ -182,6 +188,7 @@ func (h *SystemSecurityPlanHandler) List(ctx echo.Context) error {
 	var ssps []relational.SystemSecurityPlan
 
 	if err := h.db.
+		Scopes(middleware.GetSystemScope(ctx).SystemSecurityPlans).
 		Preload("Metadata").
 		Preload("Metadata.Revisions").
 		Find(&ssps).Error; err != nil {
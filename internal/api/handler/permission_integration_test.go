@@ -0,0 +1,95 @@
+//go:build integration
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/compliance-framework/api/internal/tests"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+func TestPermissionApi(t *testing.T) {
+	suite.Run(t, new(PermissionApiIntegrationSuite))
+}
+
+type PermissionApiIntegrationSuite struct {
+	tests.IntegrationTestSuite
+}
+
+func (suite *PermissionApiIntegrationSuite) TestPermissionMatrix() {
+	err := suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+
+	keys := map[string]string{}
+	for _, role := range []string{
+		relational.RoleAdmin,
+		relational.RoleComplianceOfficer,
+		relational.RoleAssessor,
+		relational.RoleReadOnly,
+		relational.RoleAgent,
+	} {
+		token, err := suite.GetAuthTokenWithRoles(role)
+		suite.Require().NoError(err)
+		keys[role] = *token
+	}
+	token, err := suite.GetAuthTokenWithRoles()
+	suite.Require().NoError(err)
+	keys["no roles"] = *token
+	for _, scope := range []relational.AgentCredentialScope{relational.AgentCredentialScopeIngest, relational.AgentCredentialScopeRead} {
+		credential, key, err := relational.NewAgentCredential(string(scope), scope, nil)
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.DB.Create(credential).Error)
+		keys[string(scope)+" key"] = key
+	}
+
+	evidenceReaders := []string{"admin", "compliance-officer", "assessor", "read-only", "agent", "read key"}
+	for _, route := range []struct {
+		method  string
+		path    string
+		allowed []string
+	}{
+		{http.MethodGet, "/api/evidence/labels", evidenceReaders},
+		{http.MethodPost, "/api/evidence", []string{"admin", "agent", "ingest key"}},
+		{http.MethodPost, "/api/agent/heartbeat", []string{"admin", "agent", "ingest key"}},
+		{http.MethodGet, "/api/agents", evidenceReaders},
+		{http.MethodGet, "/api/filters", evidenceReaders},
+		{http.MethodPost, "/api/filters", []string{"admin", "compliance-officer"}},
+		{http.MethodGet, "/api/alerts/rules", evidenceReaders},
+		{http.MethodPost, "/api/alerts/rules", []string{"admin", "compliance-officer"}},
+		{http.MethodGet, "/api/webhooks", []string{"admin"}},
+		{http.MethodPost, "/api/webhooks", []string{"admin"}},
+		{http.MethodGet, "/api/system-grants", []string{"admin"}},
+		{http.MethodPost, "/api/system-grants", []string{"admin"}},
+	} {
+		for name, key := range keys {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(route.method, route.path, bytes.NewReader([]byte("{}")))
+			req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", key))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			server.E().ServeHTTP(rec, req)
+
+			description := fmt.Sprintf("%s %s as %s", route.method, route.path, name)
+			suite.NotEqual(http.StatusUnauthorized, rec.Code, description)
+			if slices.Contains(route.allowed, name) {
+				suite.NotEqual(http.StatusForbidden, rec.Code, description)
+			} else {
+				suite.Equal(http.StatusForbidden, rec.Code, description)
+			}
+		}
+	}
+}
@@ -1,10 +1,16 @@
 package handler
 
 import (
+	"bytes"
+	"errors"
 	"github.com/compliance-framework/api/internal/converters/labelfilter"
 	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/labstack/echo/v4"
 	"gorm.io/datatypes"
+	"io"
+	"net/http"
+	"net/url"
 	"strings"
 	"time"
 )
@@ -48,10 +54,69 @@ type filteredSearchRequest struct {
 	Filter labelfilter.Filter `json:"filter" yaml:"filter" validate:"required"`
 }
 
+// bind reads the filter from the request body, or from the q query parameter written in the label filter query
+// language, which takes precedence over the body.
 func (r *filteredSearchRequest) bind(ctx echo.Context, p *labelfilter.Filter) error {
 	if err := ctx.Bind(r); err != nil {
 		return err
 	}
+	if err := bindFilterQuery(ctx, &r.Filter); err != nil {
+		return err
+	}
+	if err := r.Filter.Validate(); err != nil {
+		return err
+	}
 	p.Scope = r.Filter.Scope
 	return nil
 }
+
+// bindFilterQuery replaces filter with the q query parameter, if one was passed, parsed from the label filter
+// query language.
+func bindFilterQuery(ctx echo.Context, filter *labelfilter.Filter) error {
+	q := ctx.QueryParam("q")
+	if q == "" {
+		return nil
+	}
+	parsed, err := labelfilter.Parse(q)
+	if err != nil {
+		return err
+	}
+	*filter = parsed
+	return nil
+}
+
+// filterQueryErrorStatus maps an error running a query of the evidence matching a label filter to a response status.
+// Regular expressions are validated with Go's syntax but evaluated by Postgres, which rejects some that Go accepts,
+// so those are reported as unprocessable, as other invalid filters are, rather than as the server's error.
+func filterQueryErrorStatus(err error) int {
+	var pgErr *pgconn.PgError
+	// invalid_regular_expression
+	if errors.As(err, &pgErr) && pgErr.Code == "2201B" {
+		return http.StatusUnprocessableEntity
+	}
+	return http.StatusInternalServerError
+}
+
+// bindDocument binds the request body into i as ctx.Bind does, and returns the body as it was sent, so that
+// signatures can be verified against it.
+func bindDocument(ctx echo.Context, i any) ([]byte, error) {
+	body, err := io.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return nil, err
+	}
+	ctx.Request().Body = io.NopCloser(bytes.NewReader(body))
+	if err := ctx.Bind(i); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// pathParam returns the named path parameter, unescaped. Echo matches routes against the raw path when it differs
+// from the decoded one, leaving parameters escaped.
+func pathParam(ctx echo.Context, name string) (string, error) {
+	value := ctx.Param(name)
+	if ctx.Request().URL.RawPath == "" {
+		return value, nil
+	}
+	return url.PathUnescape(value)
+}
@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/api/middleware"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// SystemGrantHandler manages the grants limiting users and roles to systems.
+type SystemGrantHandler struct {
+	db    *gorm.DB
+	sugar *zap.SugaredLogger
+}
+
+func NewSystemGrantHandler(sugar *zap.SugaredLogger, db *gorm.DB) *SystemGrantHandler {
+	return &SystemGrantHandler{
+		sugar: sugar,
+		db:    db,
+	}
+}
+
+// Register registers the system grant endpoints.
+func (h *SystemGrantHandler) Register(api *echo.Group) {
+	api.Use(middleware.RequirePermission(relational.PermissionGrantManage))
+
+	api.GET("", h.List)
+	api.POST("", h.Create)
+	api.DELETE("/:id", h.Delete)
+}
+
+// SystemGrantRequest grants a user, or a role, access to a system security plan.
+type SystemGrantRequest struct {
+	SystemSecurityPlanID uuid.UUID  `json:"systemSecurityPlanId" validate:"required"`
+	UserID               *uuid.UUID `json:"userId"`
+	RoleName             *string    `json:"roleName"`
+}
+
+// List godoc
+//
+//	@Summary		List system grants
+//	@Description	Lists the grants giving users and roles access to system security plans. Users only have access to the system security plans granted to them, directly or through their roles, and to the assessment plans, assessment results and POA&Ms importing them, unless they have the system:all permission.
+//	@Tags			System Grants
+//	@Produce		json
+//	@Param			systemSecurityPlanId	query		string	false	"Only list grants to this system security plan"
+//	@Param			userId					query		string	false	"Only list grants to this user"
+//	@Param			roleName				query		string	false	"Only list grants to this role"
+//	@Success		200						{object}	GenericDataListResponse[relational.SystemGrant]
+//	@Failure		400						{object}	api.Error
+//	@Failure		500						{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/system-grants [get]
+func (h *SystemGrantHandler) List(ctx echo.Context) error {
+	query := h.db.Order("created_at")
+	for param, column := range map[string]string{
+		"systemSecurityPlanId": "system_security_plan_id",
+		"userId":               "user_id",
+	} {
+		if value := ctx.QueryParam(param); value != "" {
+			id, err := uuid.Parse(value)
+			if err != nil {
+				return ctx.JSON(http.StatusBadRequest, api.NewError(fmt.Errorf("invalid %s: %w", param, err)))
+			}
+			query = query.Where(column+" = ?", id)
+		}
+	}
+	if roleName := ctx.QueryParam("roleName"); roleName != "" {
+		query = query.Where("role_name = ?", roleName)
+	}
+
+	grants := []relational.SystemGrant{}
+	if err := query.Find(&grants).Error; err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	return ctx.JSON(http.StatusOK, GenericDataListResponse[relational.SystemGrant]{Data: grants})
+}
+
+// Create godoc
+//
+//	@Summary		Create a system grant
+//	@Description	Gives a user, or every user with a role, access to a system security plan. Exactly one of userId and roleName is required.
+//	@Tags			System Grants
+//	@Accept			json
+//	@Produce		json
+//	@Param			grant	body		SystemGrantRequest	true	"System grant"
+//	@Success		201		{object}	GenericDataResponse[relational.SystemGrant]
+//	@Failure		400		{object}	api.Error
+//	@Failure		500		{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/system-grants [post]
+func (h *SystemGrantHandler) Create(ctx echo.Context) error {
+	var req SystemGrantRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+	if err := ctx.Validate(req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.Validator(err))
+	}
+
+	grant := relational.SystemGrant{
+		SystemSecurityPlanID: req.SystemSecurityPlanID,
+		UserID:               req.UserID,
+		RoleName:             req.RoleName,
+	}
+	if err := grant.Validate(); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+
+	if err := h.checkReference(&relational.SystemSecurityPlan{}, "id = ?", grant.SystemSecurityPlanID); err != nil {
+		return h.referenceError(ctx, err)
+	}
+	if grant.UserID != nil {
+		if err := h.checkReference(&relational.User{}, "id = ?", *grant.UserID); err != nil {
+			return h.referenceError(ctx, err)
+		}
+	}
+	if grant.RoleName != nil {
+		if err := h.checkReference(&relational.AccessRole{}, "name = ?", *grant.RoleName); err != nil {
+			return h.referenceError(ctx, err)
+		}
+	}
+
+	if err := h.db.Create(&grant).Error; err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	return ctx.JSON(http.StatusCreated, GenericDataResponse[relational.SystemGrant]{Data: grant})
+}
+
+// checkReference returns gorm.ErrRecordNotFound when the record a grant refers to does not exist.
+func (h *SystemGrantHandler) checkReference(model any, query string, value any) error {
+	var count int64
+	if err := h.db.Model(model).Where(query, value).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (h *SystemGrantHandler) referenceError(ctx echo.Context, err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+	return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+}
+
+// Delete godoc
+//
+//	@Summary		Delete a system grant
+//	@Description	Deletes a system grant. Users left without grants have access to no system, unless they have the system:all permission.
+//	@Tags			System Grants
+//	@Param			id	path	string	true	"System grant ID"
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	api.Error
+//	@Failure		404	{object}	api.Error
+//	@Failure		500	{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/system-grants/{id} [delete]
+func (h *SystemGrantHandler) Delete(ctx echo.Context) error {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+
+	result := h.db.Delete(&relational.SystemGrant{}, "id = ?", id)
+	if result.Error != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(result.Error))
+	}
+	if result.RowsAffected == 0 {
+		return ctx.JSON(http.StatusNotFound, api.NewError(gorm.ErrRecordNotFound))
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
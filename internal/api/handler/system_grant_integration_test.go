@@ -0,0 +1,104 @@
+//go:build integration
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/compliance-framework/api/internal/tests"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+func TestSystemGrantApi(t *testing.T) {
+	suite.Run(t, new(SystemGrantApiIntegrationSuite))
+}
+
+type SystemGrantApiIntegrationSuite struct {
+	tests.IntegrationTestSuite
+}
+
+func (suite *SystemGrantApiIntegrationSuite) TestSystemGrants() {
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	err = suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+
+	do := func(method string, path string, body any) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		reqBody, _ := json.Marshal(body)
+		req := httptest.NewRequest(method, path, bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		server.E().ServeHTTP(rec, req)
+		return rec
+	}
+
+	sspID := uuid.New()
+	suite.Require().NoError(suite.DB.Create(&relational.SystemSecurityPlan{
+		UUIDModel: relational.UUIDModel{ID: &sspID},
+		Metadata:  relational.Metadata{Title: "Payments"},
+	}).Error)
+	role := relational.RoleAssessor
+
+	var grant relational.SystemGrant
+
+	suite.Run("Grants are created for roles", func() {
+		rec := do(http.MethodPost, "/api/system-grants", SystemGrantRequest{SystemSecurityPlanID: sspID, RoleName: &role})
+		suite.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+		response := &GenericDataResponse[relational.SystemGrant]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		grant = response.Data
+		suite.Equal(sspID, grant.SystemSecurityPlanID)
+		suite.Equal(role, *grant.RoleName)
+	})
+
+	suite.Run("Invalid grants are rejected", func() {
+		unknownRole := "auditor"
+		unknownUser := uuid.New()
+		for name, req := range map[string]SystemGrantRequest{
+			"no user or role": {SystemSecurityPlanID: sspID},
+			"unknown system":  {SystemSecurityPlanID: uuid.New(), RoleName: &role},
+			"unknown role":    {SystemSecurityPlanID: sspID, RoleName: &unknownRole},
+			"unknown user":    {SystemSecurityPlanID: sspID, UserID: &unknownUser},
+		} {
+			suite.Equal(http.StatusBadRequest, do(http.MethodPost, "/api/system-grants", req).Code, name)
+		}
+	})
+
+	suite.Run("Grants are filtered", func() {
+		rec := do(http.MethodGet, "/api/system-grants?systemSecurityPlanId="+sspID.String(), nil)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		response := &GenericDataListResponse[relational.SystemGrant]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		suite.Len(response.Data, 1)
+
+		rec = do(http.MethodGet, "/api/system-grants?roleName=read-only", nil)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		suite.Empty(response.Data)
+
+		suite.Equal(http.StatusBadRequest, do(http.MethodGet, "/api/system-grants?userId=me", nil).Code)
+	})
+
+	suite.Run("Grants are deleted", func() {
+		suite.Equal(http.StatusNoContent, do(http.MethodDelete, "/api/system-grants/"+grant.ID.String(), nil).Code)
+		suite.Equal(http.StatusNotFound, do(http.MethodDelete, "/api/system-grants/"+grant.ID.String(), nil).Code)
+	})
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/api/middleware"
+	"github.com/compliance-framework/api/internal/authn"
+	"github.com/compliance-framework/api/internal/config"
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// UserHandler lists users, and unlocks those locked after too many failed logins.
+type UserHandler struct {
+	db         *gorm.DB
+	sugar      *zap.SugaredLogger
+	lockout    *service.AccountLockout
+	pagination *service.PaginationConfig
+}
+
+func NewUserHandler(sugar *zap.SugaredLogger, db *gorm.DB, config *config.Config) *UserHandler {
+	return &UserHandler{
+		sugar:      sugar,
+		db:         db,
+		lockout:    service.NewAccountLockout(db, config),
+		pagination: service.NewPaginationConfig(),
+	}
+}
+
+// Register registers the user endpoints.
+func (h *UserHandler) Register(api *echo.Group) {
+	api.Use(middleware.RequirePermission(relational.PermissionUserManage))
+
+	api.GET("", h.List)
+	api.POST("/:id/unlock", h.Unlock)
+}
+
+// List godoc
+//
+//	@Summary		List users
+//	@Description	Lists users by email, along with whether they are locked and how many times in a row they have failed to log in.
+//	@Tags			Users
+//	@Produce		json
+//	@Param			locked	query		bool	false	"Only list users who are, or are not, locked"
+//	@Param			page	query		int		false	"Page number, starting at 1"
+//	@Param			limit	query		int		false	"Number of items per page"
+//	@Success		200		{object}	service.ListResponse[relational.User]
+//	@Failure		400		{object}	api.Error
+//	@Failure		500		{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/users [get]
+func (h *UserHandler) List(ctx echo.Context) error {
+	page, err := h.pagination.ParseParams(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+
+	query := h.db.Model(&relational.User{})
+	if value := ctx.QueryParam("locked"); value != "" {
+		locked, err := strconv.ParseBool(value)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, api.NewError(fmt.Errorf("invalid locked parameter: %w", err)))
+		}
+		query = query.Where("is_locked = ?", locked)
+	}
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		h.sugar.Errorw("Failed to count users", "error", err)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+	users := []relational.User{}
+	if err := query.
+		Preload("Roles").
+		Order("email").
+		Limit(page.Limit).
+		Offset(page.Offset).
+		Find(&users).Error; err != nil {
+		h.sugar.Errorw("Failed to list users", "error", err)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	return ctx.JSON(http.StatusOK, service.NewListResponse(users, total, page.Page, page.Limit))
+}
+
+// Unlock godoc
+//
+//	@Summary		Unlock a user
+//	@Description	Unlocks a user locked after too many failed logins, and resets their failed logins. The unlock is recorded as an audit event.
+//	@Tags			Users
+//	@Produce		json
+//	@Param			id	path		string	true	"User ID"
+//	@Success		200	{object}	GenericDataResponse[relational.User]
+//	@Failure		400	{object}	api.Error
+//	@Failure		404	{object}	api.Error
+//	@Failure		500	{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/users/{id}/unlock [post]
+func (h *UserHandler) Unlock(ctx echo.Context) error {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+
+	var user relational.User
+	if err := h.db.First(&user, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, api.NewError(err))
+		}
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	actor := relational.AuditSystemActor
+	if claims, ok := ctx.Get("user").(*authn.UserClaims); ok {
+		actor = claims.Subject
+	}
+	if err := h.lockout.Unlock(&user, actor); err != nil {
+		h.sugar.Errorw("Failed to unlock user", "user", id, "error", err)
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+	h.sugar.Infow("User unlocked", "user", id, "by", actor)
+
+	return ctx.JSON(http.StatusOK, GenericDataResponse[relational.User]{Data: user})
+}
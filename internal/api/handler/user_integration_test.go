@@ -0,0 +1,118 @@
+//go:build integration
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/compliance-framework/api/internal/tests"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+func TestUserApi(t *testing.T) {
+	suite.Run(t, new(UserApiIntegrationSuite))
+}
+
+type UserApiIntegrationSuite struct {
+	tests.IntegrationTestSuite
+}
+
+func (suite *UserApiIntegrationSuite) TestUnlock() {
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+	readOnlyToken, err := suite.GetAuthTokenWithRoles(relational.RoleReadOnly)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.Migrator.Refresh())
+
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+
+	do := func(method string, path string, token *string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", *token))
+		server.E().ServeHTTP(rec, req)
+		return rec
+	}
+
+	var user relational.User
+	suite.Require().NoError(suite.DB.First(&user, "email = ?", "test@example.com").Error)
+	lockedAt := time.Now()
+	suite.Require().NoError(suite.DB.Model(&user).Updates(map[string]any{
+		"is_locked":     true,
+		"locked_at":     lockedAt,
+		"failed_logins": 5,
+	}).Error)
+
+	suite.Run("Users without the permission are forbidden", func() {
+		suite.Equal(http.StatusForbidden, do(http.MethodGet, "/api/users", readOnlyToken).Code)
+		suite.Equal(http.StatusForbidden, do(http.MethodPost, "/api/users/"+user.ID.String()+"/unlock", readOnlyToken).Code)
+		suite.Equal(http.StatusForbidden, do(http.MethodGet, "/api/audit-events", readOnlyToken).Code)
+	})
+
+	suite.Run("Locked users are listed", func() {
+		rec := do(http.MethodGet, "/api/users?locked=true", token)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		suite.NotContains(rec.Body.String(), "PasswordHash")
+
+		response := &service.ListResponse[relational.User]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		suite.Require().Len(response.Data, 1)
+		suite.Equal(*user.ID, *response.Data[0].ID)
+		suite.True(response.Data[0].IsLocked)
+	})
+
+	suite.Run("Users are unlocked", func() {
+		rec := do(http.MethodPost, "/api/users/"+user.ID.String()+"/unlock", token)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		response := &GenericDataResponse[relational.User]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		suite.False(response.Data.IsLocked)
+		suite.Zero(response.Data.FailedLogins)
+
+		var stored relational.User
+		suite.Require().NoError(suite.DB.First(&stored, "id = ?", user.ID).Error)
+		suite.False(stored.IsLocked)
+		suite.Nil(stored.LockedAt)
+		suite.Zero(stored.FailedLogins)
+	})
+
+	suite.Run("Unlocks are recorded as audit events", func() {
+		rec := do(http.MethodGet, "/api/audit-events?type=user.unlocked&userId="+user.ID.String(), token)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		response := &service.ListResponse[relational.AuditEvent]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		suite.Require().Len(response.Data, 1)
+		suite.Equal(relational.AuditEventUserUnlocked, response.Data[0].Type)
+		suite.Equal("dummy@example.com", response.Data[0].Actor)
+	})
+
+	suite.Run("Unlocking a user who is not locked records nothing", func() {
+		suite.Equal(http.StatusOK, do(http.MethodPost, "/api/users/"+user.ID.String()+"/unlock", token).Code)
+
+		var count int64
+		suite.Require().NoError(suite.DB.Model(&relational.AuditEvent{}).Count(&count).Error)
+		suite.EqualValues(1, count)
+	})
+
+	suite.Run("Unknown users and event types are rejected", func() {
+		suite.Equal(http.StatusNotFound, do(http.MethodPost, "/api/users/"+uuid.NewString()+"/unlock", token).Code)
+		suite.Equal(http.StatusBadRequest, do(http.MethodGet, "/api/audit-events?type=user.deleted", token).Code)
+	})
+}
@@ -0,0 +1,355 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/api/middleware"
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// WebhookHandler manages webhook subscriptions, and the log of deliveries made to them.
+type WebhookHandler struct {
+	db         *gorm.DB
+	sugar      *zap.SugaredLogger
+	pagination *service.PaginationConfig
+}
+
+func NewWebhookHandler(sugar *zap.SugaredLogger, db *gorm.DB) *WebhookHandler {
+	return &WebhookHandler{
+		sugar:      sugar,
+		db:         db,
+		pagination: service.NewPaginationConfig(),
+	}
+}
+
+// Register registers the webhook endpoints.
+func (h *WebhookHandler) Register(api *echo.Group) {
+	api.Use(middleware.RequirePermission(relational.PermissionWebhookManage))
+
+	api.GET("", h.List)
+	api.POST("", h.Create)
+	api.GET("/:id", h.Get)
+	api.PUT("/:id", h.Update)
+	api.DELETE("/:id", h.Delete)
+	api.GET("/:id/deliveries", h.ListDeliveries)
+	api.POST("/:id/deliveries/:deliveryId/redeliver", h.Redeliver)
+}
+
+// WebhookSubscriptionRequest creates or replaces a webhook subscription.
+type WebhookSubscriptionRequest struct {
+	Name       string                        `json:"name" validate:"required"`
+	URL        string                        `json:"url" validate:"required"`
+	EventTypes []relational.WebhookEventType `json:"eventTypes" validate:"required"`
+	// Secret signs deliveries. It is generated when a subscription is created without one, and kept when a
+	// subscription is updated without one.
+	Secret string `json:"secret"`
+	// Enabled defaults to true.
+	Enabled *bool `json:"enabled"`
+}
+
+// apply copies the request onto a subscription, and validates the result.
+func (r *WebhookSubscriptionRequest) apply(subscription *relational.WebhookSubscription) error {
+	subscription.Name = r.Name
+	subscription.URL = r.URL
+	subscription.EventTypes = datatypes.NewJSONSlice(r.EventTypes)
+	subscription.Enabled = r.Enabled == nil || *r.Enabled
+	if r.Secret != "" {
+		subscription.Secret = r.Secret
+	}
+	if subscription.Secret == "" {
+		secret, err := relational.NewWebhookSecret()
+		if err != nil {
+			return err
+		}
+		subscription.Secret = secret
+	}
+	return subscription.Validate()
+}
+
+// WebhookSubscriptionSecretResponse is a newly created subscription, along with the secret deliveries are signed
+// with. The secret is not returned again.
+type WebhookSubscriptionSecretResponse struct {
+	relational.WebhookSubscription
+	Secret string `json:"secret"`
+}
+
+// findSubscription loads the subscription identified by the id path parameter, writing an error response and
+// returning nil when it cannot be found.
+func (h *WebhookHandler) findSubscription(ctx echo.Context) (*relational.WebhookSubscription, error) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		return nil, ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+
+	subscription := &relational.WebhookSubscription{}
+	if err := h.db.First(subscription, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ctx.JSON(http.StatusNotFound, api.NewError(err))
+		}
+		h.sugar.Errorw("Failed to load webhook subscription", "id", id, "error", err)
+		return nil, ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+	return subscription, nil
+}
+
+// List godoc
+//
+//	@Summary		List webhook subscriptions
+//	@Description	Lists the webhook subscriptions compliance events are posted to.
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Success		200	{object}	GenericDataListResponse[relational.WebhookSubscription]
+//	@Failure		500	{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/webhooks [get]
+func (h *WebhookHandler) List(ctx echo.Context) error {
+	subscriptions := []relational.WebhookSubscription{}
+	if err := h.db.Order("created_at").Find(&subscriptions).Error; err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	return ctx.JSON(http.StatusOK, GenericDataListResponse[relational.WebhookSubscription]{Data: subscriptions})
+}
+
+// Create godoc
+//
+//	@Summary		Create a webhook subscription
+//	@Description	Subscribes a URL to compliance events: evidence.transitioned, poam-item.created, finding.created and profile.resolved.
+//	@Description	Events are posted as JSON, signed in the X-CCF-Signature header as sha256= followed by the hex encoded HMAC-SHA256 of the X-CCF-Timestamp header, a period and the body, keyed by the secret of the subscription.
+//	@Description	Deliveries which fail are retried with exponential backoff. The secret is only returned by this request.
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			subscription	body		WebhookSubscriptionRequest	true	"Webhook subscription"
+//	@Success		201				{object}	GenericDataResponse[WebhookSubscriptionSecretResponse]
+//	@Failure		400				{object}	api.Error
+//	@Failure		500				{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/webhooks [post]
+func (h *WebhookHandler) Create(ctx echo.Context) error {
+	var req WebhookSubscriptionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+	if err := ctx.Validate(req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.Validator(err))
+	}
+
+	subscription := relational.WebhookSubscription{}
+	if err := req.apply(&subscription); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+	if err := h.db.Create(&subscription).Error; err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	return ctx.JSON(http.StatusCreated, GenericDataResponse[WebhookSubscriptionSecretResponse]{
+		Data: WebhookSubscriptionSecretResponse{WebhookSubscription: subscription, Secret: subscription.Secret},
+	})
+}
+
+// Get godoc
+//
+//	@Summary		Get a webhook subscription
+//	@Description	Retrieves a webhook subscription by its ID.
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Param			id	path		string	true	"Webhook subscription ID"
+//	@Success		200	{object}	GenericDataResponse[relational.WebhookSubscription]
+//	@Failure		400	{object}	api.Error
+//	@Failure		404	{object}	api.Error
+//	@Failure		500	{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/webhooks/{id} [get]
+func (h *WebhookHandler) Get(ctx echo.Context) error {
+	subscription, err := h.findSubscription(ctx)
+	if subscription == nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, GenericDataResponse[relational.WebhookSubscription]{Data: *subscription})
+}
+
+// Update godoc
+//
+//	@Summary		Update a webhook subscription
+//	@Description	Replaces a webhook subscription. Its secret is kept unless a new one is given.
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		string						true	"Webhook subscription ID"
+//	@Param			subscription	body		WebhookSubscriptionRequest	true	"Webhook subscription"
+//	@Success		200				{object}	GenericDataResponse[relational.WebhookSubscription]
+//	@Failure		400				{object}	api.Error
+//	@Failure		404				{object}	api.Error
+//	@Failure		500				{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/webhooks/{id} [put]
+func (h *WebhookHandler) Update(ctx echo.Context) error {
+	subscription, err := h.findSubscription(ctx)
+	if subscription == nil {
+		return err
+	}
+
+	var req WebhookSubscriptionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+	if err := ctx.Validate(req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.Validator(err))
+	}
+	if err := req.apply(subscription); err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+	if err := h.db.Save(subscription).Error; err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	return ctx.JSON(http.StatusOK, GenericDataResponse[relational.WebhookSubscription]{Data: *subscription})
+}
+
+// Delete godoc
+//
+//	@Summary		Delete a webhook subscription
+//	@Description	Deletes a webhook subscription, along with its deliveries, including those not yet sent.
+//	@Tags			Webhooks
+//	@Param			id	path	string	true	"Webhook subscription ID"
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	api.Error
+//	@Failure		404	{object}	api.Error
+//	@Failure		500	{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/webhooks/{id} [delete]
+func (h *WebhookHandler) Delete(ctx echo.Context) error {
+	subscription, err := h.findSubscription(ctx)
+	if subscription == nil {
+		return err
+	}
+
+	err = h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("subscription_id = ?", subscription.ID).Delete(&relational.WebhookDelivery{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(subscription).Error
+	})
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ListDeliveries godoc
+//
+//	@Summary		List webhook deliveries
+//	@Description	Lists the deliveries made to a webhook subscription, most recent first, with the outcome of their last attempt.
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Param			id		path		string	true	"Webhook subscription ID"
+//	@Param			status	query		string	false	"Only list deliveries with this status"	Enums(pending, delivered, failed)
+//	@Param			page	query		int		false	"Page number, starting at 1"
+//	@Param			limit	query		int		false	"Number of items per page"
+//	@Success		200		{object}	service.ListResponse[relational.WebhookDelivery]
+//	@Failure		400		{object}	api.Error
+//	@Failure		404		{object}	api.Error
+//	@Failure		500		{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) ListDeliveries(ctx echo.Context) error {
+	subscription, err := h.findSubscription(ctx)
+	if subscription == nil {
+		return err
+	}
+	page, err := h.pagination.ParseParams(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+
+	query := h.db.Model(&relational.WebhookDelivery{}).Where("subscription_id = ?", subscription.ID)
+	if status := relational.WebhookDeliveryStatus(ctx.QueryParam("status")); status != "" {
+		statuses := []relational.WebhookDeliveryStatus{relational.WebhookDeliveryPending, relational.WebhookDeliveryDelivered, relational.WebhookDeliveryFailed}
+		if !slices.Contains(statuses, status) {
+			return ctx.JSON(http.StatusBadRequest, api.NewError(fmt.Errorf("unknown delivery status %q, expected %s, %s or %s", status, statuses[0], statuses[1], statuses[2])))
+		}
+		query = query.Where("status = ?", status)
+	}
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+	deliveries := []relational.WebhookDelivery{}
+	if err := query.
+		Order("created_at DESC").
+		Order("id").
+		Limit(page.Limit).
+		Offset(page.Offset).
+		Find(&deliveries).Error; err != nil {
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	return ctx.JSON(http.StatusOK, service.NewListResponse(deliveries, total, page.Page, page.Limit))
+}
+
+// Redeliver godoc
+//
+//	@Summary		Redeliver a webhook delivery
+//	@Description	Schedules a delivery to be sent again as soon as possible, such as one which failed while its subscriber was unavailable.
+//	@Description	Failed deliveries get a single further attempt.
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Param			id			path		string	true	"Webhook subscription ID"
+//	@Param			deliveryId	path		string	true	"Webhook delivery ID"
+//	@Success		200			{object}	GenericDataResponse[relational.WebhookDelivery]
+//	@Failure		400			{object}	api.Error
+//	@Failure		404			{object}	api.Error
+//	@Failure		500			{object}	api.Error
+//	@Security		OAuth2Password
+//	@Router			/webhooks/{id}/deliveries/{deliveryId}/redeliver [post]
+func (h *WebhookHandler) Redeliver(ctx echo.Context) error {
+	subscription, err := h.findSubscription(ctx)
+	if subscription == nil {
+		return err
+	}
+	deliveryID, err := uuid.Parse(ctx.Param("deliveryId"))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, api.NewError(err))
+	}
+
+	var delivery relational.WebhookDelivery
+	err = h.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&relational.WebhookDelivery{}).
+			Where("id = ? AND subscription_id = ?", deliveryID, subscription.ID).
+			Updates(map[string]any{
+				"status":          relational.WebhookDeliveryPending,
+				"next_attempt_at": time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.First(&delivery, "id = ?", deliveryID).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, api.NewError(err))
+		}
+		return ctx.JSON(http.StatusInternalServerError, api.NewError(err))
+	}
+
+	return ctx.JSON(http.StatusOK, GenericDataResponse[relational.WebhookDelivery]{Data: delivery})
+}
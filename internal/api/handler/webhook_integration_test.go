@@ -0,0 +1,191 @@
+//go:build integration
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/compliance-framework/api/internal/api"
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/compliance-framework/api/internal/tests"
+	oscalTypes_1_1_3 "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+func TestWebhookApi(t *testing.T) {
+	suite.Run(t, new(WebhookApiIntegrationSuite))
+}
+
+type WebhookApiIntegrationSuite struct {
+	tests.IntegrationTestSuite
+}
+
+func (suite *WebhookApiIntegrationSuite) TestWebhooks() {
+	token, err := suite.GetAuthToken()
+	suite.Require().NoError(err)
+
+	err = suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	read, readKey, err := relational.NewAgentCredential("read", relational.AgentCredentialScopeRead, nil)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.DB.Create(read).Error)
+
+	logger, _ := zap.NewDevelopment()
+	server := api.NewServer(context.Background(), logger.Sugar(), suite.Config)
+	RegisterHandlers(server, logger.Sugar(), suite.DB, suite.Config)
+
+	doAs := func(key string, method string, path string, body any) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		reqBody, _ := json.Marshal(body)
+		req := httptest.NewRequest(method, path, bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", key))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		server.E().ServeHTTP(rec, req)
+		return rec
+	}
+	do := func(method string, path string, body any) *httptest.ResponseRecorder {
+		return doAs(*token, method, path, body)
+	}
+
+	var subscription WebhookSubscriptionSecretResponse
+
+	suite.Run("Subscriptions are created with a secret", func() {
+		rec := do(http.MethodPost, "/api/webhooks", WebhookSubscriptionRequest{
+			Name:       "Ticketing",
+			URL:        "https://tickets.example.com/hooks/ccf",
+			EventTypes: []relational.WebhookEventType{relational.WebhookEventEvidenceTransitioned},
+		})
+		suite.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+
+		response := &GenericDataResponse[WebhookSubscriptionSecretResponse]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		subscription = response.Data
+		suite.NotNil(subscription.ID)
+		suite.True(subscription.Enabled)
+		suite.Len(subscription.Secret, 64)
+	})
+
+	suite.Run("Secrets are not returned afterwards", func() {
+		rec := do(http.MethodGet, "/api/webhooks/"+subscription.ID.String(), nil)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		suite.NotContains(rec.Body.String(), subscription.Secret)
+
+		rec = do(http.MethodGet, "/api/webhooks", nil)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		suite.NotContains(rec.Body.String(), subscription.Secret)
+	})
+
+	suite.Run("Invalid subscriptions are rejected", func() {
+		for _, req := range []WebhookSubscriptionRequest{
+			{URL: "https://tickets.example.com", EventTypes: []relational.WebhookEventType{relational.WebhookEventFindingCreated}},
+			{Name: "No events", URL: "https://tickets.example.com"},
+			{Name: "Unknown event", URL: "https://tickets.example.com", EventTypes: []relational.WebhookEventType{"evidence.deleted"}},
+			{Name: "Relative URL", URL: "/hooks", EventTypes: []relational.WebhookEventType{relational.WebhookEventFindingCreated}},
+		} {
+			rec := do(http.MethodPost, "/api/webhooks", req)
+			suite.Equal(http.StatusBadRequest, rec.Code, req.Name)
+		}
+	})
+
+	suite.Run("Agent keys cannot manage subscriptions", func() {
+		suite.Equal(http.StatusForbidden, doAs(readKey, http.MethodGet, "/api/webhooks", nil).Code)
+		suite.Equal(http.StatusForbidden, doAs(readKey, http.MethodDelete, "/api/webhooks/"+subscription.ID.String(), nil).Code)
+	})
+
+	suite.Run("Subscriptions keep their secret when updated", func() {
+		rec := do(http.MethodPut, "/api/webhooks/"+subscription.ID.String(), WebhookSubscriptionRequest{
+			Name:       "Ticketing",
+			URL:        "https://tickets.example.com/hooks/ccf-v2",
+			EventTypes: []relational.WebhookEventType{relational.WebhookEventEvidenceTransitioned, relational.WebhookEventPoamItemCreated},
+		})
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+
+		var stored relational.WebhookSubscription
+		suite.Require().NoError(suite.DB.First(&stored, "id = ?", subscription.ID).Error)
+		suite.Equal(subscription.Secret, stored.Secret)
+		suite.Equal("https://tickets.example.com/hooks/ccf-v2", stored.URL)
+		suite.Len(stored.EventTypes, 2)
+	})
+
+	suite.Run("Evidence transitions are enqueued", func() {
+		stream := uuid.New()
+		for i, state := range []string{"satisfied", "satisfied", "not-satisfied"} {
+			end := time.Now().Add(time.Duration(i-3) * time.Minute)
+			rec := do(http.MethodPost, "/api/evidence", EvidenceCreateRequest{
+				UUID:   stream,
+				Title:  "SSH password login",
+				Labels: map[string]string{"env": "prod", "run": fmt.Sprint(i)},
+				Start:  end.Add(-time.Minute),
+				End:    end,
+				Status: oscalTypes_1_1_3.ObjectiveStatus{State: state},
+			})
+			suite.Require().Equal(http.StatusCreated, rec.Code, rec.Body.String())
+		}
+
+		rec := do(http.MethodGet, "/api/webhooks/"+subscription.ID.String()+"/deliveries", nil)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		response := &service.ListResponse[relational.WebhookDelivery]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		// The initial state of the stream, and repeats of it, are not transitions.
+		suite.Require().Len(response.Data, 1)
+		delivery := response.Data[0]
+		suite.Equal(relational.WebhookEventEvidenceTransitioned, delivery.EventType)
+		suite.Equal(relational.WebhookDeliveryPending, delivery.Status)
+
+		event := service.WebhookEvent{}
+		suite.Require().NoError(json.Unmarshal(delivery.Payload, &event))
+		data := event.Data.(map[string]any)
+		suite.Equal(stream.String(), data["uuid"])
+		suite.Equal("satisfied", data["previous-state"])
+		suite.Equal("not-satisfied", data["state"])
+		suite.Equal("SSH password login", data["title"])
+	})
+
+	suite.Run("Deliveries are filtered and redelivered", func() {
+		var delivery relational.WebhookDelivery
+		suite.Require().NoError(suite.DB.First(&delivery, "subscription_id = ?", subscription.ID).Error)
+		suite.Require().NoError(suite.DB.Model(&delivery).Updates(map[string]any{
+			"status":          relational.WebhookDeliveryFailed,
+			"next_attempt_at": time.Now().Add(time.Hour),
+		}).Error)
+
+		rec := do(http.MethodGet, "/api/webhooks/"+subscription.ID.String()+"/deliveries?status=pending", nil)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		response := &service.ListResponse[relational.WebhookDelivery]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), response))
+		suite.Empty(response.Data)
+		suite.Equal(http.StatusBadRequest, do(http.MethodGet, "/api/webhooks/"+subscription.ID.String()+"/deliveries?status=lost", nil).Code)
+
+		rec = do(http.MethodPost, fmt.Sprintf("/api/webhooks/%s/deliveries/%s/redeliver", subscription.ID, delivery.ID), nil)
+		suite.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+		redelivered := &GenericDataResponse[relational.WebhookDelivery]{}
+		suite.Require().NoError(json.Unmarshal(rec.Body.Bytes(), redelivered))
+		suite.Equal(relational.WebhookDeliveryPending, redelivered.Data.Status)
+		suite.True(redelivered.Data.NextAttemptAt.Before(time.Now()))
+
+		rec = do(http.MethodPost, fmt.Sprintf("/api/webhooks/%s/deliveries/%s/redeliver", subscription.ID, uuid.New()), nil)
+		suite.Equal(http.StatusNotFound, rec.Code)
+	})
+
+	suite.Run("Deleting a subscription deletes its deliveries", func() {
+		rec := do(http.MethodDelete, "/api/webhooks/"+subscription.ID.String(), nil)
+		suite.Require().Equal(http.StatusNoContent, rec.Code, rec.Body.String())
+
+		var count int64
+		suite.Require().NoError(suite.DB.Model(&relational.WebhookDelivery{}).Count(&count).Error)
+		suite.Zero(count)
+		suite.Equal(http.StatusNotFound, do(http.MethodGet, "/api/webhooks/"+subscription.ID.String(), nil).Code)
+	})
+}
@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"crypto/rsa"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+// agentLastUsedResolution limits how often the last used time of a credential is written, to avoid an update on every request.
+const agentLastUsedResolution = time.Minute
+
+// AgentOrUserAuthMiddleware returns an Echo middleware function which authenticates requests made either by a user,
+// using a JWT as JWTMiddleware does, or by an agent, using an API key sent as a bearer token.
+// Authenticated agents are stored in the context as "agent", and are restricted per route by RequirePermission.
+func AgentOrUserAuthMiddleware(db *gorm.DB, publicKey *rsa.PublicKey) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if c.Request().Method == http.MethodOptions {
+				// Allow preflight requests without authentication
+				return next(c)
+			}
+
+			var tokenString string
+			authTokenCookie, err := c.Cookie("ccf_auth_token")
+			if err == nil {
+				tokenString = authTokenCookie.Value
+			} else {
+				tokenString, err = getTokenFromHeader(c.Request().Header.Get("Authorization"))
+				if err != nil {
+					return echo.NewHTTPError(http.StatusUnauthorized, err)
+				}
+			}
+
+			if relational.IsAgentKey(tokenString) {
+				credential, err := authenticateAgent(db, tokenString)
+				if err != nil {
+					return echo.NewHTTPError(http.StatusUnauthorized, "invalid, expired or revoked agent key")
+				}
+				c.Set("agent", credential)
+				return next(c)
+			}
+
+			claims, err := authenticateUser(db, tokenString, publicKey)
+			if err != nil {
+				return err
+			}
+
+			// Store claims in context for downstream handlers
+			c.Set("user", claims)
+			return next(c)
+		}
+	}
+}
+
+func authenticateAgent(db *gorm.DB, key string) (*relational.AgentCredential, error) {
+	prefix, secret, err := relational.ParseAgentKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	credential := &relational.AgentCredential{}
+	if err := db.First(credential, "prefix = ?", prefix).Error; err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if !credential.CheckSecret(secret) || !credential.IsActive(now) {
+		return nil, errors.New("invalid agent credential")
+	}
+
+	if credential.LastUsedAt == nil || now.Sub(*credential.LastUsedAt) > agentLastUsedResolution {
+		credential.LastUsedAt = &now
+		if err := db.Model(credential).UpdateColumn("last_used_at", now).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	return credential, nil
+}
@@ -7,11 +7,14 @@ import (
 	"strings"
 
 	"github.com/compliance-framework/api/internal/authn"
+	"github.com/compliance-framework/api/internal/service"
 	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
 )
 
-// JWTMiddleware returns an Echo middleware function that verifies JWT tokens using the provided RSA public key.
-func JWTMiddleware(publicKey *rsa.PublicKey) echo.MiddlewareFunc {
+// JWTMiddleware returns an Echo middleware function that verifies JWT tokens using the provided RSA public key, and
+// rejects tokens on the revocation list.
+func JWTMiddleware(db *gorm.DB, publicKey *rsa.PublicKey) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			if c.Request().Method == http.MethodOptions {
@@ -31,9 +34,9 @@ func JWTMiddleware(publicKey *rsa.PublicKey) echo.MiddlewareFunc {
 				}
 			}
 
-			claims, err := authn.VerifyJWTToken(tokenString, publicKey)
+			claims, err := authenticateUser(db, tokenString, publicKey)
 			if err != nil {
-				return echo.NewHTTPError(http.StatusUnauthorized, "invalid or expired token")
+				return err
 			}
 
 			// Store claims in context for downstream handlers
@@ -43,6 +46,23 @@ func JWTMiddleware(publicKey *rsa.PublicKey) echo.MiddlewareFunc {
 	}
 }
 
+// authenticateUser verifies a JWT token, and checks it has not been revoked.
+func authenticateUser(db *gorm.DB, tokenString string, publicKey *rsa.PublicKey) (*authn.UserClaims, error) {
+	claims, err := authn.VerifyJWTToken(tokenString, publicKey)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "invalid or expired token")
+	}
+
+	revoked, err := service.IsTokenRevoked(db, claims)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+	if revoked {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "revoked token")
+	}
+	return claims, nil
+}
+
 func getTokenFromHeader(authHeader string) (string, error) {
 	parts := strings.SplitN(authHeader, " ", 2)
 	if len(parts) != 2 || parts[0] != "Bearer" {
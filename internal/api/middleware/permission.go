@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/compliance-framework/api/internal/authn"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/labstack/echo/v4"
+)
+
+// RequirePermission restricts a route to users whose token grants the permission, and to agents whose credential
+// scope does. It must run after JWTMiddleware or AgentOrUserAuthMiddleware.
+func RequirePermission(permission relational.Permission) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if c.Request().Method == http.MethodOptions {
+				return next(c)
+			}
+			if claims, ok := c.Get("user").(*authn.UserClaims); ok {
+				if !claims.HasPermission(permission) {
+					return echo.NewHTTPError(http.StatusForbidden, fmt.Sprintf("the %s permission is required", permission))
+				}
+				return next(c)
+			}
+			credential, ok := c.Get("agent").(*relational.AgentCredential)
+			if !ok {
+				return echo.NewHTTPError(http.StatusUnauthorized, "missing credentials")
+			}
+			if !slices.Contains(credential.Scope.Permissions(), permission) {
+				return echo.NewHTTPError(http.StatusForbidden, "agent credential does not allow access to this resource")
+			}
+			return next(c)
+		}
+	}
+}
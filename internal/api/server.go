@@ -25,6 +25,16 @@ type Server struct {
 func NewServer(ctx context.Context, s *zap.SugaredLogger, config *config.Config) *Server {
 	e := echo.New()
 	e.Binder = &binders.CustomBinder{}
+	// Clients are identified by the address they connect from, such as to throttle their logins, unless they connect
+	// through a trusted proxy. Otherwise they could choose their own address with the X-Forwarded-For header.
+	e.IPExtractor = echo.ExtractIPDirect()
+	if len(config.TrustedProxies) > 0 {
+		options := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+		for _, proxy := range config.TrustedProxies {
+			options = append(options, echo.TrustIPRange(proxy))
+		}
+		e.IPExtractor = echo.ExtractIPFromXFFHeader(options...)
+	}
 	e.Pre(middleware.RemoveTrailingSlash())
 	e.Use(middleware.Logger())
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
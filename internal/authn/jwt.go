@@ -2,30 +2,52 @@ package authn
 
 import (
 	"crypto/rsa"
+	"slices"
 	"time"
 
 	"github.com/compliance-framework/api/internal/service/relational"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 type UserClaims struct {
 	jwt.RegisteredClaims
 	GivenName  string `json:"given_name"`
 	FamilyName string `json:"family_name"`
+	// SessionID is the session the token was issued for, which revokes the token when it is revoked.
+	SessionID string `json:"sid,omitempty"`
+	// Roles are the names of the roles of the user when the token was issued, and Permissions those they granted.
+	Roles       []string                `json:"roles,omitempty"`
+	Permissions []relational.Permission `json:"permissions,omitempty"`
 }
 
-func GenerateJWTToken(user *relational.User, privateKey *rsa.PrivateKey) (*string, error) {
+// HasPermission reports whether the token grants the permission.
+func (c *UserClaims) HasPermission(permission relational.Permission) bool {
+	return slices.Contains(c.Permissions, permission)
+}
+
+// GenerateJWTToken issues a token for the user, embedding its roles and their permissions, which must be loaded.
+// The token expires after the ttl, and is identified by a unique ID so that it can be revoked, along with every
+// other token of its session when the session ID is not empty.
+func GenerateJWTToken(user *relational.User, sessionID string, ttl time.Duration, privateKey *rsa.PrivateKey) (*string, error) {
 	now := time.Now()
 	claims := UserClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
 			Issuer:    "compliance-framework",
 			Subject:   user.Email,
 			IssuedAt:  jwt.NewNumericDate(now),
-			ExpiresAt: jwt.NewNumericDate(now.Add(24 * time.Hour)),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
 			NotBefore: jwt.NewNumericDate(now),
 		},
-		GivenName:  user.FirstName,
-		FamilyName: user.LastName,
+		GivenName:   user.FirstName,
+		FamilyName:  user.LastName,
+		SessionID:   sessionID,
+		Roles:       []string{},
+		Permissions: user.Permissions(),
+	}
+	for _, role := range user.Roles {
+		claims.Roles = append(claims.Roles, role.Name)
 	}
 	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
 	tokenString, err := token.SignedString(privateKey)
@@ -0,0 +1,167 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/compliance-framework/api/internal/config"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+// OIDCIdentity is the identity of a user who has logged in with an OpenID Connect provider.
+type OIDCIdentity struct {
+	Issuer  string
+	Subject string
+	Email   string
+	// EmailVerified is whether the provider vouched for the email address with an email_verified claim.
+	EmailVerified bool
+	GivenName     string
+	FamilyName    string
+	Groups        []string
+}
+
+// OIDCProvider logs users in with the authorization code flow of an OpenID Connect provider.
+// The provider is discovered when it is first used, so that the API can start while it is unavailable.
+type OIDCProvider struct {
+	issuer       string
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	scopes       []string
+	groupsClaim  string
+
+	mu       sync.Mutex
+	oauth2   *oauth2.Config
+	verifier *oidc.IDTokenVerifier
+}
+
+// NewOIDCProvider returns the provider configured with CCF_OIDC_ISSUER, or nil when single sign-on is disabled.
+func NewOIDCProvider(cfg *config.Config) *OIDCProvider {
+	if cfg.OIDCIssuer == "" {
+		return nil
+	}
+	return &OIDCProvider{
+		issuer:       cfg.OIDCIssuer,
+		clientID:     cfg.OIDCClientID,
+		clientSecret: cfg.OIDCClientSecret,
+		redirectURL:  cfg.OIDCRedirectURL,
+		scopes:       cfg.OIDCScopes,
+		groupsClaim:  cfg.OIDCGroupsClaim,
+	}
+}
+
+func (p *OIDCProvider) discover(ctx context.Context) (*oauth2.Config, *oidc.IDTokenVerifier, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.oauth2 == nil {
+		provider, err := oidc.NewProvider(ctx, p.issuer)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to discover OpenID Connect provider %s: %w", p.issuer, err)
+		}
+		p.oauth2 = &oauth2.Config{
+			ClientID:     p.clientID,
+			ClientSecret: p.clientSecret,
+			RedirectURL:  p.redirectURL,
+			Endpoint:     provider.Endpoint(),
+			Scopes:       append([]string{oidc.ScopeOpenID}, p.scopes...),
+		}
+		p.verifier = provider.Verifier(&oidc.Config{ClientID: p.clientID})
+	}
+	return p.oauth2, p.verifier, nil
+}
+
+// AuthCodeURL returns the URL of the provider users are sent to in order to log in.
+func (p *OIDCProvider) AuthCodeURL(ctx context.Context, state string, nonce string) (string, error) {
+	config, _, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	return config.AuthCodeURL(state, oidc.Nonce(nonce)), nil
+}
+
+// Exchange redeems the code the provider redirected the user back with, and returns the identity in the ID token.
+// The token must have been issued for the nonce sent with AuthCodeURL.
+func (p *OIDCProvider) Exchange(ctx context.Context, code string, nonce string) (*OIDCIdentity, error) {
+	config, verifier, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := config.Exchange(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, errors.New("token response does not include an ID token")
+	}
+	idToken, err := verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID token: %w", err)
+	}
+	if idToken.Nonce != nonce {
+		return nil, errors.New("ID token was not issued for this login")
+	}
+
+	var claims struct {
+		Email         string `json:"email"`
+		EmailVerified *bool  `json:"email_verified"`
+		GivenName     string `json:"given_name"`
+		FamilyName    string `json:"family_name"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, err
+	}
+	if claims.Email == "" {
+		return nil, errors.New("ID token does not include an email address")
+	}
+	if claims.EmailVerified != nil && !*claims.EmailVerified {
+		return nil, errors.New("email address has not been verified by the provider")
+	}
+
+	groups, err := p.groups(idToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OIDCIdentity{
+		Issuer:        idToken.Issuer,
+		Subject:       idToken.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified != nil && *claims.EmailVerified,
+		GivenName:     claims.GivenName,
+		FamilyName:    claims.FamilyName,
+		Groups:        groups,
+	}, nil
+}
+
+// groups reads the groups claim, which providers send either as a list or, for a single group, as a string.
+func (p *OIDCProvider) groups(idToken *oidc.IDToken) ([]string, error) {
+	claims := map[string]any{}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, err
+	}
+
+	switch value := claims[p.groupsClaim].(type) {
+	case nil:
+		return []string{}, nil
+	case string:
+		return []string{value}, nil
+	case []any:
+		groups := []string{}
+		for _, group := range value {
+			name, ok := group.(string)
+			if !ok {
+				return nil, fmt.Errorf("claim %s is not a list of groups", p.groupsClaim)
+			}
+			groups = append(groups, name)
+		}
+		return groups, nil
+	default:
+		return nil, fmt.Errorf("claim %s is not a list of groups", p.groupsClaim)
+	}
+}
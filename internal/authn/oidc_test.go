@@ -0,0 +1,93 @@
+package authn_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/compliance-framework/api/internal/authn"
+	"github.com/compliance-framework/api/internal/config"
+	"github.com/compliance-framework/api/internal/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOIDCProvider(t *testing.T) {
+	mock, err := tests.NewMockOIDCProvider("ccf")
+	require.NoError(t, err)
+	defer mock.Close()
+
+	provider := authn.NewOIDCProvider(&config.Config{
+		OIDCIssuer:      mock.URL,
+		OIDCClientID:    "ccf",
+		OIDCRedirectURL: "https://ccf.example.com/api/auth/oidc/callback",
+		OIDCScopes:      []string{"profile", "email"},
+		OIDCGroupsClaim: "groups",
+	})
+	ctx := context.Background()
+
+	login := func(nonce string) (*authn.OIDCIdentity, error) {
+		url, err := provider.AuthCodeURL(ctx, "state", "nonce")
+		require.NoError(t, err)
+		code, state, err := mock.Authorize(url)
+		require.NoError(t, err)
+		require.Equal(t, "state", state)
+		return provider.Exchange(ctx, code, nonce)
+	}
+
+	t.Run("Identity is read from the ID token", func(t *testing.T) {
+		mock.Claims = map[string]any{
+			"sub":            "user-1",
+			"email":          "jane@example.com",
+			"email_verified": true,
+			"given_name":     "Jane",
+			"family_name":    "Doe",
+			"groups":         []string{"auditors", "engineers"},
+		}
+		identity, err := login("nonce")
+		require.NoError(t, err)
+		assert.Equal(t, &authn.OIDCIdentity{
+			Issuer:        mock.URL,
+			Subject:       "user-1",
+			Email:         "jane@example.com",
+			EmailVerified: true,
+			GivenName:     "Jane",
+			FamilyName:    "Doe",
+			Groups:        []string{"auditors", "engineers"},
+		}, identity)
+	})
+
+	t.Run("A single group may be sent as a string", func(t *testing.T) {
+		mock.Claims = map[string]any{"email": "jane@example.com", "groups": "auditors"}
+		identity, err := login("nonce")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"auditors"}, identity.Groups)
+		assert.False(t, identity.EmailVerified, "email addresses are only verified when the provider says so")
+	})
+
+	t.Run("Tokens for another login are rejected", func(t *testing.T) {
+		mock.Claims = map[string]any{"email": "jane@example.com"}
+		_, err := login("another-nonce")
+		assert.Error(t, err)
+	})
+
+	t.Run("Unverified email addresses are rejected", func(t *testing.T) {
+		mock.Claims = map[string]any{"email": "jane@example.com", "email_verified": false}
+		_, err := login("nonce")
+		assert.Error(t, err)
+	})
+
+	t.Run("Tokens without an email address are rejected", func(t *testing.T) {
+		mock.Claims = map[string]any{}
+		_, err := login("nonce")
+		assert.Error(t, err)
+	})
+
+	t.Run("Unknown codes are rejected", func(t *testing.T) {
+		_, err := provider.Exchange(ctx, "unknown", "nonce")
+		assert.Error(t, err)
+	})
+}
+
+func TestNewOIDCProviderIsDisabledWithoutIssuer(t *testing.T) {
+	assert.Nil(t, authn.NewOIDCProvider(&config.Config{}))
+}
@@ -6,9 +6,11 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"net"
 	"os"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
@@ -16,6 +18,17 @@ import (
 
 var (
 	DriverOptions = []string{"postgres"}
+	// AttachmentStoreOptions are the blob stores evidence attachments can be kept in.
+	AttachmentStoreOptions = []string{"database", "filesystem"}
+	// SignaturePolicyOptions are the ways evidence without a valid signature can be handled.
+	SignaturePolicyOptions = []string{SignaturePolicyFlag, SignaturePolicyRequire}
+)
+
+const (
+	// SignaturePolicyFlag accepts evidence without a valid signature, recording it as unsigned or invalid.
+	SignaturePolicyFlag = "flag"
+	// SignaturePolicyRequire rejects evidence without a valid signature.
+	SignaturePolicyRequire = "require"
 )
 
 type Config struct {
@@ -27,6 +40,97 @@ type Config struct {
 	JWTPrivateKey      *rsa.PrivateKey
 	JWTPublicKey       *rsa.PublicKey
 	APIAllowedOrigins  []string
+	// TrustedProxies are the networks of the proxies in front of the API, whose X-Forwarded-For header is trusted to
+	// give the address of clients. Without any, clients are identified by the address they connect from.
+	TrustedProxies []*net.IPNet
+
+	// AccessTokenTTL is how long the tokens users authenticate with are valid for. RefreshTokenTTL is how long a
+	// session may go unused before its refresh token expires, and a new token can only be obtained by logging in.
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+
+	// LoginMaxFailures is how many consecutive failed logins lock an account, which is never locked when it is zero.
+	// Locked accounts are unlocked after LoginLockoutDuration, or only by an admin when it is zero.
+	LoginMaxFailures     int
+	LoginLockoutDuration time.Duration
+	// LoginIPRateLimit and LoginAccountRateLimit are how many logins may be attempted from an IP address, and for an
+	// account, within LoginRateLimitWindow. Zero disables the limit.
+	LoginIPRateLimit      int
+	LoginAccountRateLimit int
+	LoginRateLimitWindow  time.Duration
+
+	// EvidenceBatchMaxItems is the most evidence which may be submitted in a single batch.
+	EvidenceBatchMaxItems int
+
+	// EvidenceRetentionPolicy describes how long evidence is kept, and how densely, such as "7d=all,90d=1h,*=24h".
+	// It is parsed by service.ParseRetentionPolicy. An empty policy keeps all evidence.
+	EvidenceRetentionPolicy    string
+	EvidenceCompactionInterval time.Duration
+
+	// EvidenceStalenessWindow is how long evidence remains current without being seen again, even if it has
+	// not expired. Stale evidence is reported with a "stale" status. A zero window only takes expiry into account.
+	EvidenceStalenessWindow time.Duration
+
+	// EvidenceAttachmentStore is the blob store evidence attachments are kept in, one of AttachmentStoreOptions.
+	// EvidenceAttachmentPath is the directory used by the filesystem store.
+	EvidenceAttachmentStore string
+	EvidenceAttachmentPath  string
+
+	// EvidenceSignaturePolicy decides whether evidence which is unsigned, or whose signature cannot be verified,
+	// is rejected or flagged. It is one of SignaturePolicyOptions.
+	EvidenceSignaturePolicy string
+
+	// EvidenceLabelCardinalityLimit is the number of distinct values above which a label is reported as having a
+	// high cardinality in the label catalog.
+	EvidenceLabelCardinalityLimit int
+
+	// AgentOfflineAfter is how long an agent is reported online after its last heartbeat.
+	AgentOfflineAfter time.Duration
+
+	// HeartbeatRetentionPolicy describes how long raw heartbeats are kept, and the rollups they are downsampled
+	// to, such as "1d=all,30d=10m,365d=1h". It is parsed by service.ParseHeartbeatRetentionPolicy.
+	HeartbeatRetentionPolicy    string
+	HeartbeatCompactionInterval time.Duration
+
+	// AlertEvaluationInterval is how often alert rules are evaluated.
+	AlertEvaluationInterval time.Duration
+	// AlertWebhookURL receives alerts as JSON when set.
+	AlertWebhookURL string
+	// AlertSMTPAddr is the host:port of the mail server alerts are emailed through, from AlertSMTPFrom to each of
+	// AlertSMTPTo. Alerts are not emailed when it is empty.
+	AlertSMTPAddr     string
+	AlertSMTPUsername string
+	AlertSMTPPassword string
+	AlertSMTPFrom     string
+	AlertSMTPTo       []string
+
+	// WebhookDispatchInterval is how often pending webhook deliveries are sent.
+	WebhookDispatchInterval time.Duration
+	// WebhookMaxAttempts is how many times a webhook delivery is attempted before it is marked as failed.
+	WebhookMaxAttempts int
+
+	// OIDCIssuer is the URL of the OpenID Connect provider users can log in with, using the client registered with
+	// it. Single sign-on is disabled when it is empty. OIDCRedirectURL is the URL of /api/auth/oidc/callback, as
+	// registered with the provider, and OIDCScopes are requested along with openid.
+	OIDCIssuer       string
+	OIDCClientID     string
+	OIDCClientSecret string
+	OIDCRedirectURL  string
+	OIDCScopes       []string
+	// OIDCGroupsClaim is the claim of ID tokens listing the groups of the user.
+	OIDCGroupsClaim string
+	// OIDCRoleMapping maps groups to the roles given to their members, and is parsed from a list such as
+	// "ccf-admins=admin,auditors=assessor". Users none of whose groups are mapped are given OIDCDefaultRole, or no
+	// role when it is empty. When neither is set, users are given the read-only role when they are created, and
+	// keep the roles they are given after.
+	OIDCRoleMapping map[string][]string
+	OIDCDefaultRole string
+	// OIDCLoginRedirectURL is where users are sent once they have logged in.
+	OIDCLoginRedirectURL string
+	// OIDCLinkExistingUsers links the first login of an identity to the existing user with the same email address,
+	// when the provider has verified it. Otherwise such logins are rejected, as the provider may let users claim
+	// any email address.
+	OIDCLinkExistingUsers bool
 }
 
 func NewConfig(logger *zap.SugaredLogger) *Config {
@@ -82,6 +186,33 @@ func NewConfig(logger *zap.SugaredLogger) *Config {
 		}
 	}
 
+	accessTokenTTL := viper.GetDuration("access_token_ttl")
+	if accessTokenTTL <= 0 {
+		logger.Fatal("CCF_ACCESS_TOKEN_TTL must be a positive duration")
+	}
+	refreshTokenTTL := viper.GetDuration("refresh_token_ttl")
+	if refreshTokenTTL <= accessTokenTTL {
+		logger.Fatal("CCF_REFRESH_TOKEN_TTL must be longer than CCF_ACCESS_TOKEN_TTL")
+	}
+
+	loginMaxFailures := viper.GetInt("login_max_failures")
+	if loginMaxFailures < 0 {
+		logger.Fatal("CCF_LOGIN_MAX_FAILURES must not be negative")
+	}
+	loginLockoutDuration := viper.GetDuration("login_lockout_duration")
+	if loginLockoutDuration < 0 {
+		logger.Fatal("CCF_LOGIN_LOCKOUT_DURATION must not be negative")
+	}
+	loginIPRateLimit := viper.GetInt("login_ip_rate_limit")
+	loginAccountRateLimit := viper.GetInt("login_account_rate_limit")
+	if loginIPRateLimit < 0 || loginAccountRateLimit < 0 {
+		logger.Fatal("CCF_LOGIN_IP_RATE_LIMIT and CCF_LOGIN_ACCOUNT_RATE_LIMIT must not be negative")
+	}
+	loginRateLimitWindow := viper.GetDuration("login_rate_limit_window")
+	if loginRateLimitWindow <= 0 {
+		logger.Fatal("CCF_LOGIN_RATE_LIMIT_WINDOW must be a positive duration, such as 1m")
+	}
+
 	appPort := viper.GetString("app_port")
 	if !strings.HasPrefix(appPort, ":") {
 		appPort = ":" + appPort
@@ -107,6 +238,116 @@ func NewConfig(logger *zap.SugaredLogger) *Config {
 		}
 	}
 
+	trustedProxies := []*net.IPNet{}
+	for _, proxy := range strings.Split(viper.GetString("trusted_proxies"), ",") {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		network, err := parseNetwork(proxy)
+		if err != nil {
+			logger.Fatalw("CCF_TRUSTED_PROXIES must be a comma separated list of IP addresses and CIDR ranges", "error", err)
+		}
+		trustedProxies = append(trustedProxies, network)
+	}
+
+	compactionInterval := viper.GetDuration("evidence_compaction_interval")
+	if compactionInterval <= 0 {
+		logger.Fatal("CCF_EVIDENCE_COMPACTION_INTERVAL must be a positive duration, such as 1h")
+	}
+
+	stalenessWindow := viper.GetDuration("evidence_staleness_window")
+	if stalenessWindow < 0 {
+		logger.Fatal("CCF_EVIDENCE_STALENESS_WINDOW must not be negative")
+	}
+
+	attachmentStore := stripQuotes(strings.ToLower(viper.GetString("evidence_attachment_store")))
+	if !slices.Contains(AttachmentStoreOptions, attachmentStore) {
+		logger.Fatal(
+			"CCF_EVIDENCE_ATTACHMENT_STORE is set to an unsupported value: ",
+			viper.GetString("evidence_attachment_store"),
+			". Supported values are: ",
+			strings.Join(AttachmentStoreOptions, ", "),
+		)
+	}
+	attachmentPath := stripQuotes(viper.GetString("evidence_attachment_path"))
+	if attachmentStore == "filesystem" && attachmentPath == "" {
+		logger.Fatal("CCF_EVIDENCE_ATTACHMENT_PATH must be set when attachments are stored on the filesystem")
+	}
+
+	signaturePolicy := stripQuotes(strings.ToLower(viper.GetString("evidence_signature_policy")))
+	if !slices.Contains(SignaturePolicyOptions, signaturePolicy) {
+		logger.Fatal(
+			"CCF_EVIDENCE_SIGNATURE_POLICY is set to an unsupported value: ",
+			viper.GetString("evidence_signature_policy"),
+			". Supported values are: ",
+			strings.Join(SignaturePolicyOptions, ", "),
+		)
+	}
+
+	batchMaxItems := viper.GetInt("evidence_batch_max_items")
+	if batchMaxItems <= 0 {
+		logger.Fatal("CCF_EVIDENCE_BATCH_MAX_ITEMS must be a positive number")
+	}
+
+	labelCardinalityLimit := viper.GetInt("evidence_label_cardinality_limit")
+	if labelCardinalityLimit <= 0 {
+		logger.Fatal("CCF_EVIDENCE_LABEL_CARDINALITY_LIMIT must be a positive number")
+	}
+
+	agentOfflineAfter := viper.GetDuration("agent_offline_after")
+	if agentOfflineAfter <= 0 {
+		logger.Fatal("CCF_AGENT_OFFLINE_AFTER must be a positive duration, such as 5m")
+	}
+
+	heartbeatCompactionInterval := viper.GetDuration("heartbeat_compaction_interval")
+	if heartbeatCompactionInterval <= 0 {
+		logger.Fatal("CCF_HEARTBEAT_COMPACTION_INTERVAL must be a positive duration, such as 10m")
+	}
+
+	alertEvaluationInterval := viper.GetDuration("alert_evaluation_interval")
+	if alertEvaluationInterval <= 0 {
+		logger.Fatal("CCF_ALERT_EVALUATION_INTERVAL must be a positive duration, such as 1m")
+	}
+
+	alertSMTPTo := []string{}
+	for _, address := range strings.Split(stripQuotes(viper.GetString("alert_smtp_to")), ",") {
+		if trimmed := strings.TrimSpace(address); trimmed != "" {
+			alertSMTPTo = append(alertSMTPTo, trimmed)
+		}
+	}
+	alertSMTPAddr := stripQuotes(viper.GetString("alert_smtp_addr"))
+	alertSMTPFrom := stripQuotes(viper.GetString("alert_smtp_from"))
+	if alertSMTPAddr != "" && (alertSMTPFrom == "" || len(alertSMTPTo) == 0) {
+		logger.Fatal("CCF_ALERT_SMTP_FROM and CCF_ALERT_SMTP_TO must be set when CCF_ALERT_SMTP_ADDR is set")
+	}
+
+	webhookDispatchInterval := viper.GetDuration("webhook_dispatch_interval")
+	if webhookDispatchInterval <= 0 {
+		logger.Fatal("CCF_WEBHOOK_DISPATCH_INTERVAL must be a positive duration, such as 10s")
+	}
+	webhookMaxAttempts := viper.GetInt("webhook_max_attempts")
+	if webhookMaxAttempts <= 0 {
+		logger.Fatal("CCF_WEBHOOK_MAX_ATTEMPTS must be a positive number")
+	}
+
+	oidcIssuer := stripQuotes(viper.GetString("oidc_issuer"))
+	oidcClientID := stripQuotes(viper.GetString("oidc_client_id"))
+	oidcRedirectURL := stripQuotes(viper.GetString("oidc_redirect_url"))
+	if oidcIssuer != "" && (oidcClientID == "" || oidcRedirectURL == "") {
+		logger.Fatal("CCF_OIDC_CLIENT_ID and CCF_OIDC_REDIRECT_URL must be set when CCF_OIDC_ISSUER is set")
+	}
+	oidcScopes := []string{}
+	for _, scope := range strings.Split(stripQuotes(viper.GetString("oidc_scopes")), ",") {
+		if trimmed := strings.TrimSpace(scope); trimmed != "" {
+			oidcScopes = append(oidcScopes, trimmed)
+		}
+	}
+	oidcRoleMapping, err := ParseOIDCRoleMapping(stripQuotes(viper.GetString("oidc_role_mapping")))
+	if err != nil {
+		logger.Fatalw("CCF_OIDC_ROLE_MAPPING is invalid", "error", err)
+	}
+
 	return &Config{
 		AppPort:            appPort,
 		DBDriver:           dbDriver,
@@ -116,10 +357,79 @@ func NewConfig(logger *zap.SugaredLogger) *Config {
 		JWTPrivateKey:      jwtPrivateKey,
 		JWTPublicKey:       jwtPublicKey,
 		APIAllowedOrigins:  allowedOrigins,
+		TrustedProxies:     trustedProxies,
+
+		AccessTokenTTL:  accessTokenTTL,
+		RefreshTokenTTL: refreshTokenTTL,
+
+		LoginMaxFailures:      loginMaxFailures,
+		LoginLockoutDuration:  loginLockoutDuration,
+		LoginIPRateLimit:      loginIPRateLimit,
+		LoginAccountRateLimit: loginAccountRateLimit,
+		LoginRateLimitWindow:  loginRateLimitWindow,
+
+		EvidenceBatchMaxItems: batchMaxItems,
+
+		EvidenceRetentionPolicy:    stripQuotes(viper.GetString("evidence_retention_policy")),
+		EvidenceCompactionInterval: compactionInterval,
+		EvidenceStalenessWindow:    stalenessWindow,
+		EvidenceAttachmentStore:    attachmentStore,
+		EvidenceAttachmentPath:     attachmentPath,
+		EvidenceSignaturePolicy:    signaturePolicy,
+
+		EvidenceLabelCardinalityLimit: labelCardinalityLimit,
+
+		AgentOfflineAfter: agentOfflineAfter,
+
+		HeartbeatRetentionPolicy:    stripQuotes(viper.GetString("heartbeat_retention_policy")),
+		HeartbeatCompactionInterval: heartbeatCompactionInterval,
+
+		AlertEvaluationInterval: alertEvaluationInterval,
+		AlertWebhookURL:         stripQuotes(viper.GetString("alert_webhook_url")),
+		AlertSMTPAddr:           alertSMTPAddr,
+		AlertSMTPUsername:       stripQuotes(viper.GetString("alert_smtp_username")),
+		AlertSMTPPassword:       stripQuotes(viper.GetString("alert_smtp_password")),
+		AlertSMTPFrom:           alertSMTPFrom,
+		AlertSMTPTo:             alertSMTPTo,
+
+		WebhookDispatchInterval: webhookDispatchInterval,
+		WebhookMaxAttempts:      webhookMaxAttempts,
+
+		OIDCIssuer:            oidcIssuer,
+		OIDCClientID:          oidcClientID,
+		OIDCClientSecret:      stripQuotes(viper.GetString("oidc_client_secret")),
+		OIDCRedirectURL:       oidcRedirectURL,
+		OIDCScopes:            oidcScopes,
+		OIDCGroupsClaim:       stripQuotes(viper.GetString("oidc_groups_claim")),
+		OIDCRoleMapping:       oidcRoleMapping,
+		OIDCDefaultRole:       stripQuotes(viper.GetString("oidc_default_role")),
+		OIDCLoginRedirectURL:  stripQuotes(viper.GetString("oidc_login_redirect_url")),
+		OIDCLinkExistingUsers: viper.GetBool("oidc_link_existing_users"),
 	}
 
 }
 
+// ParseOIDCRoleMapping parses a list of group=role pairs, such as "ccf-admins=admin,auditors=assessor". A group may
+// be mapped to several roles.
+func ParseOIDCRoleMapping(mapping string) (map[string][]string, error) {
+	roles := map[string][]string{}
+	for _, pair := range strings.Split(mapping, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		group, role, ok := strings.Cut(pair, "=")
+		group, role = strings.TrimSpace(group), strings.TrimSpace(role)
+		if !ok || group == "" || role == "" {
+			return nil, fmt.Errorf("%q is not a group=role pair", pair)
+		}
+		if !slices.Contains(roles[group], role) {
+			roles[group] = append(roles[group], role)
+		}
+	}
+	return roles, nil
+}
+
 func stripQuotes(s string) string {
 	if len(s) >= 2 {
 		if (s[0] == '"' && s[len(s)-1] == '"') || (s[0] == '\'' && s[len(s)-1] == '\'') {
@@ -199,3 +509,21 @@ func GenerateKeyPair(bitsize int) (*rsa.PrivateKey, *rsa.PublicKey, error) {
 
 	return privKey, &privKey.PublicKey, nil
 }
+
+// parseNetwork parses a CIDR range, or an IP address as the range of only that address.
+func parseNetwork(value string) (*net.IPNet, error) {
+	if strings.Contains(value, "/") {
+		_, network, err := net.ParseCIDR(value)
+		return network, err
+	}
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IP address %q", value)
+	}
+	bits := 8 * net.IPv6len
+	if ip.To4() != nil {
+		ip = ip.To4()
+		bits = 8 * net.IPv4len
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
@@ -2,6 +2,11 @@ package labelfilter
 
 import (
 	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // Filter represents the overarching filter for this particular set of conditions.
@@ -11,14 +16,233 @@ type Filter struct {
 	Scope *Scope `json:"scope"`
 }
 
-// Condition represents a strict evaluation on a specific label.
-// The Operator is a simple representation of the type of evaluation being made.
-// Equals `=` and Not Equals `!=` are supported.
-// In future Bigger Than `>`, Smaller Than `<` and potentially `LIKE` type searches can be supported.
+// Condition represents a strict evaluation on a specific label, or on another field of the evidence.
+// The Operator is a simple representation of the type of evaluation being made, and defaults to Equals `=`.
+// See the Operator constants for the supported operators. The `in` and `not in` operators compare against Values,
+// `exists` and `not exists` only check the label key, and every other operator compares against Value.
+// See the Field constants for the fields which can be evaluated, which defaults to labels.
 type Condition struct {
-	Label    string `json:"label,omitempty"`    // Label name (e.g., "type", "group", "app").
-	Operator string `json:"operator,omitempty"` // Operator (e.g., "=", "!=", etc.).
-	Value    string `json:"value,omitempty"`    // Value for the condition (e.g., "ssh", "prod").
+	Field    string   `json:"field,omitempty"`    // Evidence field (e.g., "status", "subject"), defaulting to "label".
+	Label    string   `json:"label,omitempty"`    // Label name (e.g., "type", "group", "app"), or prop name for the "prop" field.
+	Operator string   `json:"operator,omitempty"` // Operator (e.g., "=", "!=", etc.).
+	Value    string   `json:"value,omitempty"`    // Value for the condition (e.g., "ssh", "prod").
+	Values   []string `json:"values,omitempty"`   // Values for list conditions (e.g., ["ssh", "rdp"]).
+}
+
+const (
+	// OperatorEquals matches labels with the value, ignoring case.
+	OperatorEquals = "="
+	// OperatorNotEquals matches when no label has the value, ignoring case.
+	OperatorNotEquals = "!="
+	// OperatorIn matches labels with any of the values, ignoring case.
+	OperatorIn = "in"
+	// OperatorNotIn matches when no label has any of the values, ignoring case.
+	OperatorNotIn = "not in"
+	// OperatorExists matches when the label is present, whatever its value.
+	OperatorExists = "exists"
+	// OperatorNotExists matches when the label is not present.
+	OperatorNotExists = "not exists"
+	// OperatorPrefix matches labels whose value starts with the value, ignoring case.
+	OperatorPrefix = "prefix"
+	// OperatorSuffix matches labels whose value ends with the value, ignoring case.
+	OperatorSuffix = "suffix"
+	// OperatorLike matches labels against a SQL LIKE pattern using % and _ wildcards, ignoring case.
+	OperatorLike = "like"
+	// OperatorRegex matches labels against a regular expression.
+	OperatorRegex = "~"
+	// OperatorNotRegex matches when no label matches a regular expression.
+	OperatorNotRegex = "!~"
+	// OperatorGreaterThan and the other numeric operators compare labels holding numbers. Labels which are not
+	// numbers never match.
+	OperatorGreaterThan        = ">"
+	OperatorGreaterThanOrEqual = ">="
+	OperatorLessThan           = "<"
+	OperatorLessThanOrEqual    = "<="
+	// OperatorSemverGreaterThan and the other semver operators compare labels holding versions such as v1.2.3.
+	// Missing minor and patch versions are treated as 0, and pre-release or build suffixes are ignored.
+	// Labels which are not versions never match.
+	OperatorSemverEquals             = "semver="
+	OperatorSemverGreaterThan        = "semver>"
+	OperatorSemverGreaterThanOrEqual = "semver>="
+	OperatorSemverLessThan           = "semver<"
+	OperatorSemverLessThanOrEqual    = "semver<="
+)
+
+const (
+	// FieldLabel matches the value of the label named by the condition. It is the default field.
+	FieldLabel = "label"
+	// FieldStatus matches the objective status state of the evidence, such as satisfied or not-satisfied.
+	FieldStatus = "status"
+	// FieldTitle matches the title of the evidence.
+	FieldTitle = "title"
+	// FieldVerification matches the outcome of verifying the signature of the evidence: verified, unsigned or invalid.
+	FieldVerification = "verification"
+	// FieldSubject matches the UUIDs of the subjects the evidence is about. The equality and list operators also
+	// accept the identifiers sent when the evidence was created.
+	FieldSubject = "subject"
+	// FieldComponent matches the UUIDs of the components the evidence observed, or their identifiers as above.
+	FieldComponent = "component"
+	// FieldInventoryItem matches the UUIDs of the inventory items the evidence observed, or their identifiers as above.
+	FieldInventoryItem = "inventory-item"
+	// FieldOrigin matches the UUIDs of the actors which generated the evidence.
+	FieldOrigin = "origin"
+	// FieldProp matches the value of the evidence props named by the condition label.
+	FieldProp = "prop"
+	// FieldStart and FieldEnd compare when the evidence was collected, using the comparison operators. Values are
+	// timestamps in RFC 3339 format, or durations such as 24h or 7d, meaning that long before the filter is evaluated.
+	// The end of evidence which has been seen again is when it was last seen.
+	FieldStart = "start"
+	FieldEnd   = "end"
+)
+
+// Fields lists every field supported in a Condition.
+var Fields = []string{
+	FieldLabel, FieldStatus, FieldTitle, FieldVerification, FieldSubject, FieldComponent, FieldInventoryItem,
+	FieldOrigin, FieldProp, FieldStart, FieldEnd,
+}
+
+// Operators lists every operator supported in a Condition.
+var Operators = []string{
+	OperatorEquals, OperatorNotEquals,
+	OperatorIn, OperatorNotIn,
+	OperatorExists, OperatorNotExists,
+	OperatorPrefix, OperatorSuffix, OperatorLike,
+	OperatorRegex, OperatorNotRegex,
+	OperatorGreaterThan, OperatorGreaterThanOrEqual, OperatorLessThan, OperatorLessThanOrEqual,
+	OperatorSemverEquals, OperatorSemverGreaterThan, OperatorSemverGreaterThanOrEqual, OperatorSemverLessThan, OperatorSemverLessThanOrEqual,
+}
+
+// NormalizedOperator returns the operator of the condition in lower case, defaulting to Equals.
+func (c Condition) NormalizedOperator() string {
+	operator := strings.ToLower(strings.Join(strings.Fields(c.Operator), " "))
+	if operator == "" {
+		return OperatorEquals
+	}
+	return operator
+}
+
+// NormalizedField returns the field of the condition in lower case, defaulting to FieldLabel.
+func (c Condition) NormalizedField() string {
+	field := strings.ToLower(strings.TrimSpace(c.Field))
+	if field == "" {
+		return FieldLabel
+	}
+	return field
+}
+
+// IsTimeField reports whether the condition compares a time, rather than text.
+func (c Condition) IsTimeField() bool {
+	field := c.NormalizedField()
+	return field == FieldStart || field == FieldEnd
+}
+
+// Validate checks the condition uses a known field and operator, with values it can be evaluated against.
+func (c Condition) Validate() error {
+	field := c.NormalizedField()
+	switch field {
+	case FieldLabel, FieldProp:
+		if c.Label == "" {
+			return fmt.Errorf("label filter condition on %s has no label", field)
+		}
+	case FieldStatus, FieldTitle, FieldVerification, FieldSubject, FieldComponent, FieldInventoryItem, FieldOrigin, FieldStart, FieldEnd:
+	default:
+		return fmt.Errorf("unknown label filter field %q, expected one of: %s", c.Field, strings.Join(Fields, ", "))
+	}
+
+	operator := c.NormalizedOperator()
+	if c.IsTimeField() {
+		switch operator {
+		case OperatorEquals, OperatorNotEquals, OperatorGreaterThan, OperatorGreaterThanOrEqual, OperatorLessThan, OperatorLessThanOrEqual:
+		default:
+			return fmt.Errorf("label filter operator %q cannot be used on the %s field", operator, field)
+		}
+		if _, err := ResolveTime(c.Value, time.Now()); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	switch operator {
+	case OperatorIn, OperatorNotIn:
+		if len(c.Values) == 0 {
+			return fmt.Errorf("label filter operator %q requires a list of values", operator)
+		}
+	case OperatorRegex, OperatorNotRegex:
+		if _, err := regexp.Compile(c.Value); err != nil {
+			return fmt.Errorf("label filter operator %q has an invalid regular expression: %w", operator, err)
+		}
+	case OperatorGreaterThan, OperatorGreaterThanOrEqual, OperatorLessThan, OperatorLessThanOrEqual:
+		if !numberRegexp.MatchString(c.Value) {
+			return fmt.Errorf("label filter operator %q requires a numeric value, got %q", operator, c.Value)
+		}
+	case OperatorSemverEquals, OperatorSemverGreaterThan, OperatorSemverGreaterThanOrEqual, OperatorSemverLessThan, OperatorSemverLessThanOrEqual:
+		if _, err := ParseSemver(c.Value); err != nil {
+			return fmt.Errorf("label filter operator %q requires a version value: %w", operator, err)
+		}
+	case OperatorEquals, OperatorNotEquals, OperatorExists, OperatorNotExists, OperatorPrefix, OperatorSuffix, OperatorLike:
+	default:
+		return fmt.Errorf("unknown label filter operator %q, expected one of: %s", c.Operator, strings.Join(Operators, ", "))
+	}
+	return nil
+}
+
+// ResolveTime parses the value of a condition on a time field, which is either a timestamp in RFC 3339 format,
+// or a duration such as 24h or 7d which is resolved to that long before now.
+func ResolveTime(value string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	d, err := ParseDuration(value)
+	if err != nil || d < 0 {
+		return time.Time{}, fmt.Errorf("invalid time %q: expected an RFC 3339 timestamp or a duration such as 24h or 7d", value)
+	}
+	return now.Add(-d), nil
+}
+
+// ParseDuration parses a Go duration, such as 90m, or a number of days, such as 7d.
+func ParseDuration(value string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		count, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+		return time.Duration(count) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(value)
+}
+
+// NumberPattern matches label values which can be compared as numbers.
+// It is valid for both Go and Postgres regular expressions, so it is shared with the database translation.
+const NumberPattern = `^[-+]?[0-9]+(\.[0-9]+)?$`
+
+var numberRegexp = regexp.MustCompile(NumberPattern)
+
+// SemverPattern matches label values which can be compared as versions, capturing the major, minor and patch
+// numbers. It is valid for both Go and Postgres regular expressions, so it is shared with the database translation.
+const SemverPattern = `^v?([0-9]+)(?:\.([0-9]+))?(?:\.([0-9]+))?(?:[-+].*)?$`
+
+var semverRegexp = regexp.MustCompile(SemverPattern)
+
+// ParseSemver parses a version such as v1.2.3 into its major, minor and patch numbers.
+// Missing minor and patch versions are treated as 0, and pre-release or build suffixes are ignored.
+func ParseSemver(value string) ([3]int, error) {
+	version := [3]int{}
+	match := semverRegexp.FindStringSubmatch(value)
+	if match == nil {
+		return version, fmt.Errorf("invalid version %q", value)
+	}
+	for i := range version {
+		if match[i+1] == "" {
+			continue
+		}
+		part, err := strconv.Atoi(match[i+1])
+		if err != nil {
+			return version, fmt.Errorf("invalid version %q: %w", value, err)
+		}
+		version[i] = part
+	}
+	return version, nil
 }
 
 // Query brings N Conditions or Queries together with a logical operator
@@ -27,16 +251,57 @@ type Condition struct {
 // <-condition->    <-------subquery------->
 // "label:value	AND (label:foo OR label:bar)"
 type Query struct {
-	Operator string  `json:"operator"` // Logical operator (e.g., "AND", "OR").
+	Operator string  `json:"operator"` // Logical operator (e.g., "AND", "OR", "NOT").
 	Scopes   []Scope `json:"scopes"`   // Scopes can be either `Condition` or nested `Query`.
 }
 
+const (
+	// QueryOperatorAnd matches when every scope of the query matches.
+	QueryOperatorAnd = "and"
+	// QueryOperatorOr matches when any scope of the query matches.
+	QueryOperatorOr = "or"
+	// QueryOperatorNot matches when the single scope of the query does not match.
+	QueryOperatorNot = "not"
+)
+
 // Scope represents a Sub Condition or Query which can be logically represented separately or within another Scope
 type Scope struct {
 	*Condition `json:"condition,omitempty"`
 	*Query     `json:"query,omitempty"`
 }
 
+// Validate checks every condition and query in the filter can be evaluated.
+func (f Filter) Validate() error {
+	if f.Scope == nil {
+		return nil
+	}
+	return f.Scope.Validate()
+}
+
+// Validate checks the condition or query of the scope, and any nested scopes, can be evaluated.
+func (s *Scope) Validate() error {
+	if s.IsCondition() {
+		return s.Condition.Validate()
+	}
+	if s.IsQuery() {
+		switch strings.ToLower(s.Query.Operator) {
+		case QueryOperatorAnd, QueryOperatorOr:
+		case QueryOperatorNot:
+			if len(s.Query.Scopes) != 1 {
+				return fmt.Errorf("label filter query operator NOT requires exactly one scope, got %d", len(s.Query.Scopes))
+			}
+		default:
+			return fmt.Errorf("unknown label filter query operator %q, expected AND, OR or NOT", s.Query.Operator)
+		}
+		for i := range s.Query.Scopes {
+			if err := s.Query.Scopes[i].Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (qc *Scope) IsQuery() bool {
 	return qc.Query != nil
 }
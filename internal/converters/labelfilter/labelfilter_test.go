@@ -3,6 +3,7 @@ package labelfilter
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -513,3 +514,131 @@ func TestScopeUnmarshalling(t *testing.T) {
 		assert.True(t, scope.Query.Scopes[0].Scopes[0].Scopes[0].IsCondition())
 	})
 }
+
+func TestConditionValidate(t *testing.T) {
+	valid := []Condition{
+		{Label: "provider", Value: "aws"},
+		{Label: "provider", Operator: "!=", Value: "aws"},
+		{Label: "provider", Operator: "IN", Values: []string{"aws", "gcp"}},
+		{Label: "provider", Operator: "not  in", Values: []string{"aws"}},
+		{Label: "provider", Operator: "exists"},
+		{Label: "provider", Operator: "not exists"},
+		{Label: "env", Operator: "prefix", Value: "prod"},
+		{Label: "env", Operator: "suffix", Value: "prod"},
+		{Label: "env", Operator: "like", Value: "prod-%"},
+		{Label: "env", Operator: "~", Value: "^prod-[0-9]+$"},
+		{Label: "env", Operator: "!~", Value: "^dev"},
+		{Label: "port", Operator: ">", Value: "1024"},
+		{Label: "score", Operator: "<=", Value: "-0.5"},
+		{Label: "version", Operator: "semver>=", Value: "v1.2"},
+		{Field: "status", Value: "not-satisfied"},
+		{Field: "Origin", Operator: "in", Values: []string{"b8a3f9e2-0000-4000-8000-000000000001"}},
+		{Field: "prop", Label: "owner", Operator: "exists"},
+		{Field: "end", Operator: ">=", Value: "24h"},
+		{Field: "start", Operator: "<", Value: "2025-01-01T00:00:00Z"},
+	}
+	for _, condition := range valid {
+		assert.NoError(t, condition.Validate(), "%+v", condition)
+	}
+
+	invalid := []Condition{
+		{Operator: "=", Value: "aws"},
+		{Label: "provider", Operator: "contains", Value: "aws"},
+		{Label: "provider", Operator: "in"},
+		{Label: "env", Operator: "~", Value: "(prod"},
+		{Label: "port", Operator: ">", Value: "high"},
+		{Label: "port", Operator: ">", Value: "1e3"},
+		{Label: "version", Operator: "semver<", Value: "latest"},
+		{Field: "colour", Value: "red"},
+		{Field: "prop", Value: "red"},
+		{Field: "end", Operator: "~", Value: "2025"},
+		{Field: "end", Operator: ">", Value: "last week"},
+	}
+	for _, condition := range invalid {
+		assert.Error(t, condition.Validate(), "%+v", condition)
+	}
+}
+
+func TestFilterValidate(t *testing.T) {
+	assert.NoError(t, Filter{}.Validate())
+
+	filter := Filter{
+		Scope: &Scope{
+			Query: &Query{
+				Operator: "xor",
+				Scopes: []Scope{
+					{Condition: &Condition{Label: "foo", Value: "bar"}},
+				},
+			},
+		},
+	}
+	assert.Error(t, filter.Validate())
+
+	filter.Scope.Query.Operator = "and"
+	assert.NoError(t, filter.Validate())
+
+	filter.Scope.Query.Scopes = append(filter.Scope.Query.Scopes, Scope{
+		Condition: &Condition{Label: "foo", Operator: "in"},
+	})
+	assert.Error(t, filter.Validate())
+}
+
+func TestParseSemver(t *testing.T) {
+	tests := map[string][3]int{
+		"1.2.3":         {1, 2, 3},
+		"v1.2.3":        {1, 2, 3},
+		"1.2":           {1, 2, 0},
+		"2":             {2, 0, 0},
+		"1.10.0-rc.1":   {1, 10, 0},
+		"1.2.3+build.5": {1, 2, 3},
+	}
+	for value, expected := range tests {
+		version, err := ParseSemver(value)
+		assert.NoError(t, err, value)
+		assert.Equal(t, expected, version, value)
+	}
+
+	for _, value := range []string{"", "latest", "1.x", "v"} {
+		_, err := ParseSemver(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestResolveTime(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	at, err := ResolveTime("2025-01-02T03:04:05Z", now)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), at)
+
+	at, err = ResolveTime("90m", now)
+	assert.NoError(t, err)
+	assert.Equal(t, now.Add(-90*time.Minute), at)
+
+	at, err = ResolveTime("7d", now)
+	assert.NoError(t, err)
+	assert.Equal(t, now.AddDate(0, 0, -7), at)
+
+	for _, value := range []string{"", "yesterday", "-1h", "2025-01-02"} {
+		_, err := ResolveTime(value, now)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	for value, expected := range map[string]time.Duration{
+		"90s": 90 * time.Second,
+		"36h": 36 * time.Hour,
+		"1d":  24 * time.Hour,
+		"7d":  7 * 24 * time.Hour,
+	} {
+		duration, err := ParseDuration(value)
+		assert.NoError(t, err, value)
+		assert.Equal(t, expected, duration, value)
+	}
+
+	for _, value := range []string{"", "d", "1.5d", "one day"} {
+		_, err := ParseDuration(value)
+		assert.Error(t, err, value)
+	}
+}
@@ -0,0 +1,261 @@
+package labelfilter
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Target is something a filter can be evaluated against in memory, such as a piece of evidence which has not been
+// read back from the database. Matching follows the same rules as the database translation of a filter.
+type Target interface {
+	// FilterValues returns the values of a field, or of the labels or props named name, ignoring the case of name.
+	FilterValues(field string, name string) []string
+	// FilterTime returns the time of the start or end field.
+	FilterTime(field string) time.Time
+}
+
+// Matches reports whether the target matches the filter, resolving relative times against now.
+// A filter without a scope matches everything.
+func (f Filter) Matches(target Target, now time.Time) (bool, error) {
+	if f.Scope == nil {
+		return true, nil
+	}
+	return f.Scope.Matches(target, now)
+}
+
+// Matches reports whether the target matches the condition or query of the scope.
+func (s *Scope) Matches(target Target, now time.Time) (bool, error) {
+	if s.IsCondition() {
+		return s.Condition.Matches(target, now)
+	}
+	if !s.IsQuery() {
+		return true, nil
+	}
+
+	operator := strings.ToLower(s.Query.Operator)
+	switch operator {
+	case QueryOperatorAnd, QueryOperatorOr, QueryOperatorNot:
+	default:
+		return false, fmt.Errorf("unknown label filter query operator %q", s.Query.Operator)
+	}
+	for i := range s.Query.Scopes {
+		matched, err := s.Query.Scopes[i].Matches(target, now)
+		if err != nil {
+			return false, err
+		}
+		switch {
+		case operator == QueryOperatorNot:
+			return !matched, nil
+		case operator == QueryOperatorAnd && !matched:
+			return false, nil
+		case operator == QueryOperatorOr && matched:
+			return true, nil
+		}
+	}
+	return operator == QueryOperatorAnd, nil
+}
+
+// Matches reports whether the target matches the condition, resolving relative times against now.
+func (c Condition) Matches(target Target, now time.Time) (bool, error) {
+	if err := c.Validate(); err != nil {
+		return false, err
+	}
+	operator := c.NormalizedOperator()
+
+	if c.IsTimeField() {
+		at, err := ResolveTime(c.Value, now)
+		if err != nil {
+			return false, err
+		}
+		value := target.FilterTime(c.NormalizedField())
+		switch operator {
+		case OperatorEquals:
+			return value.Equal(at), nil
+		case OperatorNotEquals:
+			return !value.Equal(at), nil
+		case OperatorGreaterThan:
+			return value.After(at), nil
+		case OperatorGreaterThanOrEqual:
+			return !value.Before(at), nil
+		case OperatorLessThan:
+			return value.Before(at), nil
+		default:
+			return !value.After(at), nil
+		}
+	}
+
+	// Negated operators match when no value matches the positive form of the condition.
+	negate := false
+	switch operator {
+	case OperatorNotEquals:
+		operator, negate = OperatorEquals, true
+	case OperatorNotIn:
+		operator, negate = OperatorIn, true
+	case OperatorNotExists:
+		operator, negate = OperatorExists, true
+	case OperatorNotRegex:
+		operator, negate = OperatorRegex, true
+	}
+
+	match, err := c.valueMatcher(operator)
+	if err != nil {
+		return false, err
+	}
+	for _, value := range target.FilterValues(c.NormalizedField(), c.Label) {
+		if match(value) {
+			return !negate, nil
+		}
+	}
+	return negate, nil
+}
+
+// valueMatcher returns a function testing a single value against the positive form of the condition's operator.
+func (c Condition) valueMatcher(operator string) (func(string) bool, error) {
+	switch operator {
+	case OperatorEquals:
+		return func(value string) bool { return strings.EqualFold(value, c.Value) }, nil
+	case OperatorIn:
+		return func(value string) bool {
+			for _, v := range c.Values {
+				if strings.EqualFold(value, v) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	case OperatorExists:
+		return func(string) bool { return true }, nil
+	case OperatorPrefix:
+		return func(value string) bool { return strings.HasPrefix(strings.ToLower(value), strings.ToLower(c.Value)) }, nil
+	case OperatorSuffix:
+		return func(value string) bool { return strings.HasSuffix(strings.ToLower(value), strings.ToLower(c.Value)) }, nil
+	case OperatorLike:
+		pattern, err := likeRegexp(c.Value)
+		if err != nil {
+			return nil, err
+		}
+		return pattern.MatchString, nil
+	case OperatorRegex:
+		pattern, err := regexp.Compile(c.Value)
+		if err != nil {
+			return nil, err
+		}
+		return pattern.MatchString, nil
+	case OperatorGreaterThan, OperatorGreaterThanOrEqual, OperatorLessThan, OperatorLessThanOrEqual:
+		expected, err := strconv.ParseFloat(c.Value, 64)
+		if err != nil {
+			return nil, err
+		}
+		return func(value string) bool {
+			if !numberRegexp.MatchString(value) {
+				return false
+			}
+			actual, err := strconv.ParseFloat(value, 64)
+			return err == nil && compare(operator, compareFloats(actual, expected))
+		}, nil
+	case OperatorSemverEquals, OperatorSemverGreaterThan, OperatorSemverGreaterThanOrEqual, OperatorSemverLessThan, OperatorSemverLessThanOrEqual:
+		expected, err := ParseSemver(c.Value)
+		if err != nil {
+			return nil, err
+		}
+		return func(value string) bool {
+			actual, err := ParseSemver(value)
+			return err == nil && compare(strings.TrimPrefix(operator, "semver"), compareVersions(actual, expected))
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported label filter operator %q", c.Operator)
+}
+
+// likeRegexp translates a SQL LIKE pattern, matched ignoring case, into a regular expression.
+func likeRegexp(pattern string) (*regexp.Regexp, error) {
+	var expression strings.Builder
+	expression.WriteString("(?is)^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			expression.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		case c == '%':
+			expression.WriteString(".*")
+		case c == '_':
+			expression.WriteString(".")
+		default:
+			expression.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	expression.WriteString("$")
+	return regexp.Compile(expression.String())
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareVersions(a, b [3]int) int {
+	for i := range a {
+		if a[i] != b[i] {
+			return compareFloats(float64(a[i]), float64(b[i]))
+		}
+	}
+	return 0
+}
+
+// compare reports whether a comparison result satisfies the comparison operator.
+func compare(operator string, result int) bool {
+	switch operator {
+	case "=":
+		return result == 0
+	case ">":
+		return result > 0
+	case ">=":
+		return result >= 0
+	case "<":
+		return result < 0
+	case "<=":
+		return result <= 0
+	}
+	return false
+}
+
+// MapConditions returns a copy of the filter with every condition replaced by the result of fn.
+func (f Filter) MapConditions(fn func(Condition) (Condition, error)) (Filter, error) {
+	if f.Scope == nil {
+		return f, nil
+	}
+	scope, err := f.Scope.mapConditions(fn)
+	if err != nil {
+		return Filter{}, err
+	}
+	return Filter{Scope: scope}, nil
+}
+
+func (s *Scope) mapConditions(fn func(Condition) (Condition, error)) (*Scope, error) {
+	if s.IsCondition() {
+		condition, err := fn(*s.Condition)
+		if err != nil {
+			return nil, err
+		}
+		return &Scope{Condition: &condition}, nil
+	}
+	if !s.IsQuery() {
+		return &Scope{}, nil
+	}
+	query := &Query{Operator: s.Query.Operator, Scopes: make([]Scope, 0, len(s.Query.Scopes))}
+	for i := range s.Query.Scopes {
+		scope, err := s.Query.Scopes[i].mapConditions(fn)
+		if err != nil {
+			return nil, err
+		}
+		query.Scopes = append(query.Scopes, *scope)
+	}
+	return &Scope{Query: query}, nil
+}
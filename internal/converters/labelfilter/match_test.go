@@ -0,0 +1,120 @@
+package labelfilter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type target struct {
+	labels map[string][]string
+	fields map[string][]string
+	start  time.Time
+	end    time.Time
+}
+
+func (t target) FilterValues(field string, name string) []string {
+	if field == FieldLabel || field == FieldProp {
+		return t.labels[strings.ToLower(name)]
+	}
+	return t.fields[field]
+}
+
+func (t target) FilterTime(field string) time.Time {
+	if field == FieldStart {
+		return t.start
+	}
+	return t.end
+}
+
+func TestFilterMatches(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	evidence := target{
+		labels: map[string][]string{
+			"provider": {"AWS"},
+			"env":      {"prod-eu"},
+			"port":     {"443"},
+			"version":  {"v1.10.2"},
+			"path":     {"/var/log/app.log"},
+			"owner":    {"platform"},
+		},
+		fields: map[string][]string{
+			FieldStatus: {"not-satisfied"},
+			FieldTitle:  {"SSH root login"},
+		},
+		start: now.Add(-2 * time.Hour),
+		end:   now.Add(-time.Hour),
+	}
+
+	matching := []string{
+		"",
+		"provider=aws",
+		"provider in (gcp, aws)",
+		"provider != gcp",
+		"team not exists",
+		"env prefix PROD",
+		"env suffix -eu",
+		"env like prod-__",
+		`path ~ "^/var/log/"`,
+		`path !~ "^/tmp/"`,
+		"port > 80",
+		"port <= 443",
+		"version semver>v1.9",
+		"version semver<2",
+		"@status=not-satisfied",
+		"@title like %root%",
+		"@prop:Owner=platform",
+		"@end > 2h",
+		"@start < 90m",
+		"provider=aws AND (env=dev OR port=443) AND NOT team=legacy",
+	}
+	for _, q := range matching {
+		t.Run(q, func(t *testing.T) {
+			filter, err := Parse(q)
+			require.NoError(t, err)
+			matched, err := filter.Matches(evidence, now)
+			require.NoError(t, err)
+			assert.True(t, matched)
+		})
+	}
+
+	notMatching := []string{
+		"provider=gcp",
+		"provider not in (aws)",
+		"team exists",
+		"team=legacy",
+		"env like prod",
+		`path ~ "^/VAR"`,
+		"port >= 1024",
+		"version semver=1.10",
+		"@status=satisfied",
+		"@end >= 30m",
+		"NOT provider=aws",
+		"provider=aws AND env=dev",
+	}
+	for _, q := range notMatching {
+		t.Run(q, func(t *testing.T) {
+			filter, err := Parse(q)
+			require.NoError(t, err)
+			matched, err := filter.Matches(evidence, now)
+			require.NoError(t, err)
+			assert.False(t, matched)
+		})
+	}
+}
+
+func TestFilterMapConditions(t *testing.T) {
+	filter, err := Parse("provider=aws AND NOT (env=dev OR team exists)")
+	require.NoError(t, err)
+
+	mapped, err := filter.MapConditions(func(condition Condition) (Condition, error) {
+		condition.Label = strings.ToUpper(condition.Label)
+		return condition, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "PROVIDER=aws AND NOT (ENV=dev OR TEAM exists)", mapped.String())
+	assert.Equal(t, "provider=aws AND NOT (env=dev OR team exists)", filter.String())
+}
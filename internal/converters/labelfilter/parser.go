@@ -0,0 +1,289 @@
+package labelfilter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Parse reads a filter written in the label filter query language, such as
+//
+//	provider=aws AND (env=prod OR env=staging) AND NOT team=legacy
+//
+// Conditions are written as a label, an operator and a value. Fields of the evidence other than labels are written
+// with an @ prefix in place of the label, such as @status=not-satisfied or @end>=24h, and props are written as
+// @prop:name. Symbolic operators (=, !=, ~, !~, >, >=, <, <=,
+// semver=, semver>, semver>=, semver<, semver<=) may be written with or without spaces, while word operators
+// (in, not in, exists, not exists, prefix, suffix, like) are separated by spaces. The in operators take a
+// parenthesised, comma separated list of values, and the exists operators take no value.
+//
+// Labels and values containing spaces, quotes, parentheses, commas or operator characters must be double quoted,
+// using Go string escapes. Keywords are case-insensitive. NOT binds tighter than AND, which binds tighter than OR.
+// An empty query results in an empty filter, which matches all evidence.
+func Parse(text string) (Filter, error) {
+	tokens, err := tokenize(text)
+	if err != nil {
+		return Filter{}, err
+	}
+	p := &parser{tokens: tokens}
+	if p.peek().kind == tokenEnd {
+		return Filter{}, nil
+	}
+
+	scope, err := p.parseOr()
+	if err != nil {
+		return Filter{}, err
+	}
+	if next := p.peek(); next.kind != tokenEnd {
+		return Filter{}, p.errorf(next, "unexpected %s", next)
+	}
+
+	filter := Filter{Scope: scope}
+	if err := filter.Validate(); err != nil {
+		return Filter{}, err
+	}
+	return filter, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEnd tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOperator
+	tokenOpen
+	tokenClose
+	tokenComma
+)
+
+type token struct {
+	kind     tokenKind
+	value    string
+	position int
+}
+
+func (t token) String() string {
+	if t.kind == tokenEnd {
+		return "end of query"
+	}
+	return strconv.Quote(t.value)
+}
+
+// is reports whether the token is the given keyword, ignoring case. Quoted strings are never keywords.
+func (t token) is(keyword string) bool {
+	return t.kind == tokenWord && strings.EqualFold(t.value, keyword)
+}
+
+// operatorCharacters may start a symbolic operator, and so end a word.
+const operatorCharacters = "=!~<>"
+
+func isWordCharacter(r byte) bool {
+	return r > ' ' && r != '(' && r != ')' && r != ',' && r != '"' && !strings.ContainsRune(operatorCharacters, rune(r))
+}
+
+func tokenize(text string) ([]token, error) {
+	tokens := []token{}
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenOpen, value: "(", position: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenClose, value: ")", position: i})
+			i++
+		case c == ',':
+			tokens = append(tokens, token{kind: tokenComma, value: ",", position: i})
+			i++
+		case c == '"':
+			end := i + 1
+			for ; end < len(text) && text[end] != '"'; end++ {
+				if text[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(text) {
+				return nil, fmt.Errorf("invalid label filter query: unterminated string at position %d", i)
+			}
+			value, err := strconv.Unquote(text[i : end+1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid label filter query: invalid string at position %d: %w", i, err)
+			}
+			tokens = append(tokens, token{kind: tokenString, value: value, position: i})
+			i = end + 1
+		case strings.IndexByte(operatorCharacters, c) >= 0:
+			end := i
+			for end < len(text) && strings.IndexByte(operatorCharacters, text[end]) >= 0 {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenOperator, value: text[i:end], position: i})
+			i = end
+		default:
+			end := i
+			for end < len(text) && isWordCharacter(text[end]) {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenWord, value: text[i:end], position: i})
+			i = end
+		}
+	}
+	return append(tokens, token{kind: tokenEnd, position: len(text)}), nil
+}
+
+type parser struct {
+	tokens []token
+	next   int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.next]
+}
+
+func (p *parser) advance() token {
+	t := p.tokens[p.next]
+	if t.kind != tokenEnd {
+		p.next++
+	}
+	return t
+}
+
+func (p *parser) errorf(t token, format string, args ...any) error {
+	return fmt.Errorf("invalid label filter query: %s at position %d", fmt.Sprintf(format, args...), t.position)
+}
+
+func (p *parser) parseOr() (*Scope, error) {
+	return p.parseLogical(QueryOperatorOr, p.parseAnd)
+}
+
+func (p *parser) parseAnd() (*Scope, error) {
+	return p.parseLogical(QueryOperatorAnd, p.parseUnary)
+}
+
+// parseLogical parses one or more operands separated by the keyword, collecting them into a single query.
+func (p *parser) parseLogical(keyword string, operand func() (*Scope, error)) (*Scope, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	if !p.peek().is(keyword) {
+		return first, nil
+	}
+
+	query := &Query{Operator: keyword, Scopes: []Scope{*first}}
+	for p.peek().is(keyword) {
+		p.advance()
+		scope, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		query.Scopes = append(query.Scopes, *scope)
+	}
+	return &Scope{Query: query}, nil
+}
+
+func (p *parser) parseUnary() (*Scope, error) {
+	next := p.peek()
+	switch {
+	case next.is(QueryOperatorNot):
+		p.advance()
+		scope, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &Scope{Query: &Query{Operator: QueryOperatorNot, Scopes: []Scope{*scope}}}, nil
+	case next.kind == tokenOpen:
+		p.advance()
+		scope, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.advance(); closing.kind != tokenClose {
+			return nil, p.errorf(closing, "expected \")\" but found %s", closing)
+		}
+		return scope, nil
+	default:
+		condition, err := p.parseCondition()
+		if err != nil {
+			return nil, err
+		}
+		return &Scope{Condition: condition}, nil
+	}
+}
+
+func (p *parser) parseCondition() (*Condition, error) {
+	label := p.advance()
+	if label.kind != tokenWord && label.kind != tokenString {
+		return nil, p.errorf(label, "expected a label but found %s", label)
+	}
+	condition := &Condition{Label: label.value}
+	if field, ok := strings.CutPrefix(label.value, "@"); ok && label.kind == tokenWord {
+		condition.Field, condition.Label, _ = strings.Cut(strings.ToLower(field), ":")
+		if condition.Field == FieldProp {
+			// Prop names keep their case, and may be quoted when they are not a single word.
+			_, condition.Label, _ = strings.Cut(field, ":")
+			if condition.Label == "" && p.peek().kind == tokenString {
+				condition.Label = p.advance().value
+			}
+		}
+	}
+
+	operator := p.advance()
+	switch {
+	case operator.kind == tokenOperator:
+		condition.Operator = operator.value
+	case operator.is("semver") && p.peek().kind == tokenOperator:
+		condition.Operator = "semver" + p.advance().value
+	case operator.is(OperatorIn), operator.is(OperatorExists), operator.is(OperatorPrefix), operator.is(OperatorSuffix), operator.is(OperatorLike):
+		condition.Operator = strings.ToLower(operator.value)
+	case operator.is("not") && (p.peek().is(OperatorIn) || p.peek().is(OperatorExists)):
+		condition.Operator = "not " + strings.ToLower(p.advance().value)
+	default:
+		return nil, p.errorf(operator, "expected an operator after label %q but found %s", condition.Label, operator)
+	}
+
+	switch condition.Operator {
+	case OperatorExists, OperatorNotExists:
+		return condition, nil
+	case OperatorIn, OperatorNotIn:
+		values, err := p.parseList()
+		if err != nil {
+			return nil, err
+		}
+		condition.Values = values
+		return condition, nil
+	}
+
+	value := p.advance()
+	if value.kind != tokenWord && value.kind != tokenString {
+		return nil, p.errorf(value, "expected a value for label %q but found %s", condition.Label, value)
+	}
+	condition.Value = value.value
+	return condition, nil
+}
+
+func (p *parser) parseList() ([]string, error) {
+	if open := p.advance(); open.kind != tokenOpen {
+		return nil, p.errorf(open, "expected \"(\" but found %s", open)
+	}
+	values := []string{}
+	for {
+		value := p.advance()
+		if value.kind != tokenWord && value.kind != tokenString {
+			return nil, p.errorf(value, "expected a value but found %s", value)
+		}
+		values = append(values, value.value)
+
+		separator := p.advance()
+		switch separator.kind {
+		case tokenComma:
+			continue
+		case tokenClose:
+			return values, nil
+		default:
+			return nil, p.errorf(separator, "expected \",\" or \")\" but found %s", separator)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package labelfilter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func condition(label, operator, value string) Scope {
+	return Scope{Condition: &Condition{Label: label, Operator: operator, Value: value}}
+}
+
+func query(operator string, scopes ...Scope) Scope {
+	return Scope{Query: &Query{Operator: operator, Scopes: scopes}}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected Scope
+	}{
+		{"provider=aws", condition("provider", "=", "aws")},
+		{"provider = aws", condition("provider", "=", "aws")},
+		{"provider!=aws", condition("provider", "!=", "aws")},
+		{`"cloud provider"="Amazon Web Services"`, condition("cloud provider", "=", "Amazon Web Services")},
+		{`path~"^/var/(log|lib)"`, condition("path", "~", "^/var/(log|lib)")},
+		{"port >= 1024", condition("port", ">=", "1024")},
+		{"version semver<2.0", condition("version", "semver<", "2.0")},
+		{"version SEMVER >= v1.2.3", condition("version", "semver>=", "v1.2.3")},
+		{"env prefix prod", condition("env", "prefix", "prod")},
+		{"env LIKE prod-%", condition("env", "like", "prod-%")},
+		{"team exists", condition("team", "exists", "")},
+		{"team not exists", condition("team", "not exists", "")},
+		{"provider in (aws, \"google cloud\")", Scope{Condition: &Condition{Label: "provider", Operator: "in", Values: []string{"aws", "google cloud"}}}},
+		{"provider NOT IN (aws)", Scope{Condition: &Condition{Label: "provider", Operator: "not in", Values: []string{"aws"}}}},
+		{
+			"provider=aws AND (env=prod OR env=staging) AND NOT team=legacy",
+			query("and",
+				condition("provider", "=", "aws"),
+				query("or", condition("env", "=", "prod"), condition("env", "=", "staging")),
+				query("not", condition("team", "=", "legacy")),
+			),
+		},
+		{
+			"a=1 or b=2 and c=3",
+			query("or",
+				condition("a", "=", "1"),
+				query("and", condition("b", "=", "2"), condition("c", "=", "3")),
+			),
+		},
+		{"@status=not-satisfied", Scope{Condition: &Condition{Field: "status", Operator: "=", Value: "not-satisfied"}}},
+		{"@Title prefix CIS", Scope{Condition: &Condition{Field: "title", Operator: "prefix", Value: "CIS"}}},
+		{"@end >= 2025-01-02T03:04:05Z", Scope{Condition: &Condition{Field: "end", Operator: ">=", Value: "2025-01-02T03:04:05Z"}}},
+		{"@prop:Owner=platform", Scope{Condition: &Condition{Field: "prop", Label: "Owner", Operator: "=", Value: "platform"}}},
+		{`@prop:"cost centre" exists`, Scope{Condition: &Condition{Field: "prop", Label: "cost centre", Operator: "exists"}}},
+		{`"@status"=fail`, condition("@status", "=", "fail")},
+		{
+			"NOT (a=1 OR b=2)",
+			query("not", query("or", condition("a", "=", "1"), condition("b", "=", "2"))),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			filter, err := Parse(test.text)
+			require.NoError(t, err)
+			assert.Equal(t, Filter{Scope: &test.expected}, filter)
+		})
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		filter, err := Parse("  ")
+		require.NoError(t, err)
+		assert.Nil(t, filter.Scope)
+	})
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, text := range []string{
+		"provider",
+		"provider=",
+		"provider=aws AND",
+		"(provider=aws",
+		"provider=aws)",
+		"provider=aws env=prod",
+		"provider contains aws",
+		"provider in aws",
+		"provider in (aws,",
+		`provider="aws`,
+		"port > many",
+		"env ~ \"(\"",
+		"AND provider=aws",
+		"@colour=red",
+		"@prop:=red",
+		"@end prefix 2025",
+		"@start > yesterday",
+	} {
+		t.Run(text, func(t *testing.T) {
+			_, err := Parse(text)
+			assert.Error(t, err)
+		})
+	}
+}
+
+// TestFilterStringRoundTrip ensures a filter rendered as text parses back into the same filter.
+func TestFilterStringRoundTrip(t *testing.T) {
+	filters := []Filter{
+		{},
+		{Scope: &Scope{Condition: &Condition{Label: "provider", Operator: "=", Value: "aws"}}},
+		{Scope: &Scope{Condition: &Condition{Label: "team name", Operator: "!=", Value: `the "core" team`}}},
+		{Scope: &Scope{Condition: &Condition{Label: "and", Operator: "=", Value: "not"}}},
+		{Scope: &Scope{Condition: &Condition{Label: "env", Operator: "=", Value: ""}}},
+		{Scope: &Scope{Condition: &Condition{Label: "region", Operator: "in", Values: []string{"eu-west-1", "us east"}}}},
+		{Scope: &Scope{Condition: &Condition{Label: "region", Operator: "not in", Values: []string{"eu-west-1"}}}},
+		{Scope: &Scope{Condition: &Condition{Label: "team", Operator: "not exists"}}},
+		{Scope: &Scope{Condition: &Condition{Label: "env", Operator: "suffix", Value: "-prod"}}},
+		{Scope: &Scope{Condition: &Condition{Label: "path", Operator: "!~", Value: `^/tmp/.*\.log$`}}},
+		{Scope: &Scope{Condition: &Condition{Label: "port", Operator: "<=", Value: "-10.5"}}},
+		{Scope: &Scope{Condition: &Condition{Label: "version", Operator: "semver>", Value: "v1.2.3-rc.1"}}},
+		{Scope: &Scope{Condition: &Condition{Label: "@timestamp", Operator: "exists"}}},
+		{Scope: &Scope{Condition: &Condition{Field: "subject", Operator: "in", Values: []string{"2f1c3e4a-0000-4000-8000-000000000001"}}}},
+		{Scope: &Scope{Condition: &Condition{Field: "prop", Label: "and", Operator: "!=", Value: "x"}}},
+		{Scope: &Scope{Condition: &Condition{Field: "start", Operator: "<", Value: "7d"}}},
+		{Scope: ptr(query("and",
+			condition("provider", "=", "aws"),
+			query("or", condition("env", "=", "prod"), condition("env", "=", "staging")),
+			query("not", condition("team", "=", "legacy")),
+		))},
+		{Scope: ptr(query("or",
+			query("and", condition("a", "=", "1"), query("and", condition("b", "=", "2"), condition("c", "=", "3"))),
+			query("not", query("not", query("or", condition("d", "=", "4"), condition("e", "=", "5")))),
+		))},
+	}
+	for _, filter := range filters {
+		text := filter.String()
+		t.Run(text, func(t *testing.T) {
+			parsed, err := Parse(text)
+			require.NoError(t, err)
+			assert.Equal(t, filter, parsed)
+			assert.Equal(t, text, parsed.String())
+		})
+	}
+
+	t.Run("Rendering", func(t *testing.T) {
+		filter, err := Parse(`provider = aws and (env=prod or env = staging) and not team="legacy" and region in ("eu-west-1",us)`)
+		require.NoError(t, err)
+		assert.Equal(t, "provider=aws AND (env=prod OR env=staging) AND NOT team=legacy AND region in (eu-west-1, us)", filter.String())
+	})
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
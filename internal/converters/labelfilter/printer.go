@@ -0,0 +1,95 @@
+package labelfilter
+
+import (
+	"strconv"
+	"strings"
+)
+
+// String renders the filter in the label filter query language, so that Parse returns an equivalent filter.
+// An empty filter renders as an empty string.
+func (f Filter) String() string {
+	if f.Scope == nil {
+		return ""
+	}
+	return formatScope(*f.Scope)
+}
+
+func formatScope(scope Scope) string {
+	if scope.IsCondition() {
+		return formatCondition(*scope.Condition)
+	}
+	if !scope.IsQuery() {
+		return ""
+	}
+
+	operator := strings.ToLower(scope.Query.Operator)
+	parts := make([]string, 0, len(scope.Query.Scopes))
+	for _, child := range scope.Query.Scopes {
+		part := formatScope(child)
+		// Nested AND and OR queries are parenthesised, so that they parse back into the same structure.
+		if child.IsQuery() && !strings.EqualFold(child.Query.Operator, QueryOperatorNot) {
+			part = "(" + part + ")"
+		}
+		parts = append(parts, part)
+	}
+
+	if operator == QueryOperatorNot {
+		return "NOT " + strings.Join(parts, " ")
+	}
+	return strings.Join(parts, " "+strings.ToUpper(operator)+" ")
+}
+
+func formatCondition(condition Condition) string {
+	label := formatText(condition.Label)
+	switch field := condition.NormalizedField(); field {
+	case FieldLabel:
+		if strings.HasPrefix(label, "@") {
+			label = strconv.Quote(label)
+		}
+	case FieldProp:
+		label = "@" + field + ":" + label
+	default:
+		label = "@" + field
+	}
+	operator := condition.NormalizedOperator()
+	switch operator {
+	case OperatorExists, OperatorNotExists:
+		return label + " " + operator
+	case OperatorIn, OperatorNotIn:
+		values := make([]string, 0, len(condition.Values))
+		for _, value := range condition.Values {
+			values = append(values, formatText(value))
+		}
+		return label + " " + operator + " (" + strings.Join(values, ", ") + ")"
+	case OperatorPrefix, OperatorSuffix, OperatorLike:
+		return label + " " + operator + " " + formatText(condition.Value)
+	case OperatorSemverEquals, OperatorSemverGreaterThan, OperatorSemverGreaterThanOrEqual, OperatorSemverLessThan, OperatorSemverLessThanOrEqual:
+		return label + " " + operator + formatText(condition.Value)
+	default:
+		return label + operator + formatText(condition.Value)
+	}
+}
+
+// keywords are quoted when used as labels or values, so they are not mistaken for operators.
+var keywords = []string{
+	QueryOperatorAnd, QueryOperatorOr, QueryOperatorNot,
+	OperatorIn, OperatorExists, OperatorPrefix, OperatorSuffix, OperatorLike, "semver",
+}
+
+// formatText renders a label or value, quoting it when it could not be read back as a single word.
+func formatText(text string) string {
+	if text == "" {
+		return strconv.Quote(text)
+	}
+	for i := 0; i < len(text); i++ {
+		if !isWordCharacter(text[i]) {
+			return strconv.Quote(text)
+		}
+	}
+	for _, keyword := range keywords {
+		if strings.EqualFold(text, keyword) {
+			return strconv.Quote(text)
+		}
+	}
+	return text
+}
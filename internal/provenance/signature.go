@@ -0,0 +1,168 @@
+// Package provenance signs and verifies the evidence agents send to the API, so that evidence can be traced back to
+// the agent which collected it.
+//
+// Agents sign the canonical JSON of the evidence they send: the JSON object without its "signature" member, with
+// object members sorted by key, without insignificant whitespace, and with strings and numbers written as they
+// were in the document. The signature is sent in the "signature" member of the same object, which is matched
+// regardless of case, as the API matches members when decoding evidence.
+package provenance
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// AlgorithmEd25519 is the only supported signature algorithm.
+const AlgorithmEd25519 = "ed25519"
+
+// SignatureField is the member of a signed JSON object holding its signature. It is left out of the canonical JSON.
+const SignatureField = "signature"
+
+var (
+	// ErrUnsupportedAlgorithm is returned for signatures or keys using an algorithm other than AlgorithmEd25519.
+	ErrUnsupportedAlgorithm = errors.New("unsupported signature algorithm")
+	// ErrInvalidSignature is returned when a signature does not match the document it was sent with.
+	ErrInvalidSignature = errors.New("signature does not match the document")
+)
+
+// Signature is the signature of a JSON document, made with the key identified by KeyID.
+type Signature struct {
+	KeyID     uuid.UUID `json:"key-id"`
+	Algorithm string    `json:"algorithm"`
+	// Value is the standard base64 encoding of the signature.
+	Value string `json:"value"`
+}
+
+// Canonicalize returns the canonical JSON of a JSON object, leaving out its signature.
+func Canonicalize(document []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	decoder.UseNumber()
+	var object map[string]any
+	if err := decoder.Decode(&object); err != nil {
+		return nil, fmt.Errorf("signed document must be a JSON object: %w", err)
+	}
+	for key := range object {
+		if strings.EqualFold(key, SignatureField) {
+			delete(object, key)
+		}
+	}
+
+	buffer := &bytes.Buffer{}
+	if err := writeCanonical(buffer, object); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func writeCanonical(buffer *bytes.Buffer, value any) error {
+	switch value := value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		buffer.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+			if err := writeCanonical(buffer, key); err != nil {
+				return err
+			}
+			buffer.WriteByte(':')
+			if err := writeCanonical(buffer, value[key]); err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte('}')
+	case []any:
+		buffer.WriteByte('[')
+		for i, item := range value {
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+			if err := writeCanonical(buffer, item); err != nil {
+				return err
+			}
+		}
+		buffer.WriteByte(']')
+	default:
+		// Strings are written without escaping HTML characters, which json.Marshal would otherwise do.
+		encoder := json.NewEncoder(buffer)
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(value); err != nil {
+			return err
+		}
+		buffer.Truncate(buffer.Len() - 1) // Encode terminates each value with a newline
+	}
+	return nil
+}
+
+// Sign signs the canonical JSON of a JSON object with an ed25519 private key registered under keyID.
+func Sign(document []byte, keyID uuid.UUID, key ed25519.PrivateKey) (*Signature, error) {
+	canonical, err := Canonicalize(document)
+	if err != nil {
+		return nil, err
+	}
+	return &Signature{
+		KeyID:     keyID,
+		Algorithm: AlgorithmEd25519,
+		Value:     base64.StdEncoding.EncodeToString(ed25519.Sign(key, canonical)),
+	}, nil
+}
+
+// Verify checks the signature was made over the canonical JSON of the document with the given public key.
+func Verify(document []byte, signature Signature, key ed25519.PublicKey) error {
+	if !strings.EqualFold(signature.Algorithm, AlgorithmEd25519) {
+		return fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, signature.Algorithm)
+	}
+	value, err := base64.StdEncoding.DecodeString(signature.Value)
+	if err != nil {
+		return fmt.Errorf("signature is not base64 encoded: %w", err)
+	}
+	canonical, err := Canonicalize(document)
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(key, canonical, value) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// ParsePublicKey reads an ed25519 public key, either PEM encoded in PKIX form as written by openssl, or as the
+// standard base64 encoding of the raw 32 byte key.
+func ParsePublicKey(encoded string) (ed25519.PublicKey, error) {
+	encoded = strings.TrimSpace(encoded)
+	if block, _ := pem.Decode([]byte(encoded)); block != nil {
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse public key: %w", err)
+		}
+		public, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("%w: %T", ErrUnsupportedAlgorithm, key)
+		}
+		return public, nil
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("public key is neither PEM nor base64 encoded: %w", err)
+	}
+	if len(raw) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("ed25519 public keys are %d bytes, got %d", ed25519.PublicKeySize, len(raw))
+	}
+	return ed25519.PublicKey(raw), nil
+}
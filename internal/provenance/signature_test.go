@@ -0,0 +1,76 @@
+package provenance
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalize(t *testing.T) {
+	canonical, err := Canonicalize([]byte(`{
+		"title": "<b>SSH</b>",
+		"signature": {"value": "ignored"},
+		"labels": {"z": "1", "a": "2"},
+		"props": [{"value": 1.50, "name": "ratio"}],
+		"count": 12345678901234567890
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, `{"count":12345678901234567890,"labels":{"a":"2","z":"1"},"props":[{"name":"ratio","value":1.50}],"title":"<b>SSH</b>"}`, string(canonical))
+
+	_, err = Canonicalize([]byte(`[1, 2]`))
+	assert.Error(t, err)
+}
+
+func TestSignAndVerify(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	keyID := uuid.New()
+
+	document := []byte(`{"uuid": "0b1c", "title": "SSH", "labels": {"env": "prod"}}`)
+	signature, err := Sign(document, keyID, private)
+	require.NoError(t, err)
+	assert.Equal(t, keyID, signature.KeyID)
+	assert.Equal(t, AlgorithmEd25519, signature.Algorithm)
+
+	// Formatting, member order and the signature itself are not part of the signed content.
+	reformatted := []byte(`{"labels":{"env":"prod"},"signature":{"key-id":"` + keyID.String() + `"},"title":"SSH","uuid":"0b1c"}`)
+	assert.NoError(t, Verify(reformatted, *signature, public))
+
+	tampered := []byte(`{"uuid": "0b1c", "title": "SSH", "labels": {"env": "staging"}}`)
+	assert.ErrorIs(t, Verify(tampered, *signature, public), ErrInvalidSignature)
+
+	other, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	assert.ErrorIs(t, Verify(document, *signature, other), ErrInvalidSignature)
+
+	unsupported := *signature
+	unsupported.Algorithm = "rsa"
+	assert.ErrorIs(t, Verify(document, unsupported, public), ErrUnsupportedAlgorithm)
+}
+
+func TestParsePublicKey(t *testing.T) {
+	public, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	key, err := ParsePublicKey(base64.StdEncoding.EncodeToString(public))
+	require.NoError(t, err)
+	assert.Equal(t, public, key)
+
+	der, err := x509.MarshalPKIXPublicKey(public)
+	require.NoError(t, err)
+	key, err = ParsePublicKey(string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})))
+	require.NoError(t, err)
+	assert.Equal(t, public, key)
+
+	_, err = ParsePublicKey(base64.StdEncoding.EncodeToString([]byte("short")))
+	assert.Error(t, err)
+	_, err = ParsePublicKey("not a key")
+	assert.Error(t, err)
+}
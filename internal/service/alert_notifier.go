@@ -0,0 +1,158 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/compliance-framework/api/internal/config"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"go.uber.org/zap"
+)
+
+// AlertNotification is sent when an alert fires or is resolved.
+type AlertNotification struct {
+	Rule  relational.AlertRule `json:"rule"`
+	Alert relational.Alert     `json:"alert"`
+}
+
+// Subject summarises the notification in a single line, such as the subject of an email.
+func (n AlertNotification) Subject() string {
+	return fmt.Sprintf("[%s] %s: %s", strings.ToUpper(string(n.Alert.State)), n.Rule.Name, n.Alert.Subject)
+}
+
+// Notifier sends alert notifications to people or systems outside of the API.
+type Notifier interface {
+	Notify(ctx context.Context, notification AlertNotification) error
+}
+
+// NewAlertNotifiers creates the notifiers available in the configuration, by name. Alerts are always logged, and
+// are posted to a webhook and emailed when these are configured.
+func NewAlertNotifiers(config *config.Config, sugar *zap.SugaredLogger) map[string]Notifier {
+	notifiers := map[string]Notifier{
+		relational.AlertNotifierLog: NewLogNotifier(sugar),
+	}
+	if config.AlertWebhookURL != "" {
+		notifiers[relational.AlertNotifierWebhook] = NewWebhookNotifier(config.AlertWebhookURL)
+	}
+	if config.AlertSMTPAddr != "" {
+		var auth smtp.Auth
+		if config.AlertSMTPUsername != "" {
+			host, _, _ := net.SplitHostPort(config.AlertSMTPAddr)
+			auth = smtp.PlainAuth("", config.AlertSMTPUsername, config.AlertSMTPPassword, host)
+		}
+		notifiers[relational.AlertNotifierSMTP] = NewSMTPNotifier(config.AlertSMTPAddr, auth, config.AlertSMTPFrom, config.AlertSMTPTo)
+	}
+	return notifiers
+}
+
+// LogNotifier writes alerts to the log of the API.
+type LogNotifier struct {
+	sugar *zap.SugaredLogger
+}
+
+func NewLogNotifier(sugar *zap.SugaredLogger) *LogNotifier {
+	return &LogNotifier{sugar: sugar}
+}
+
+func (n *LogNotifier) Notify(_ context.Context, notification AlertNotification) error {
+	log := n.sugar.Infow
+	if notification.Alert.State == relational.AlertStateFiring {
+		log = n.sugar.Warnw
+	}
+	log(notification.Subject(),
+		"rule", notification.Rule.ID,
+		"alert", notification.Alert.ID,
+		"summary", notification.Alert.Summary,
+	)
+	return nil
+}
+
+// webhookNotifierTimeout bounds how long a webhook may take to accept a notification.
+const webhookNotifierTimeout = 10 * time.Second
+
+// WebhookNotifier posts alerts as JSON to a URL.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: webhookNotifierTimeout},
+	}
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, notification AlertNotification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := n.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to post alert to webhook: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded to alert with status %d", response.StatusCode)
+	}
+	return nil
+}
+
+// SMTPNotifier emails alerts through a mail server.
+type SMTPNotifier struct {
+	addr string
+	auth smtp.Auth
+	from string
+	to   []string
+	// send is smtp.SendMail, replaced in tests.
+	send func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func NewSMTPNotifier(addr string, auth smtp.Auth, from string, to []string) *SMTPNotifier {
+	return &SMTPNotifier{
+		addr: addr,
+		auth: auth,
+		from: from,
+		to:   to,
+		send: smtp.SendMail,
+	}
+}
+
+func (n *SMTPNotifier) Notify(_ context.Context, notification AlertNotification) error {
+	if err := n.send(n.addr, n.auth, n.from, n.to, n.message(notification)); err != nil {
+		return fmt.Errorf("failed to email alert: %w", err)
+	}
+	return nil
+}
+
+// message formats a notification as a plain text email.
+func (n *SMTPNotifier) message(notification AlertNotification) []byte {
+	alert := notification.Alert
+	body := &strings.Builder{}
+	fmt.Fprintf(body, "From: %s\r\n", n.from)
+	fmt.Fprintf(body, "To: %s\r\n", strings.Join(n.to, ", "))
+	fmt.Fprintf(body, "Subject: %s\r\n", strings.NewReplacer("\r", " ", "\n", " ").Replace(notification.Subject()))
+	body.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	fmt.Fprintf(body, "%s\r\n\r\n", alert.Summary)
+	fmt.Fprintf(body, "Rule: %s (%s)\r\n", notification.Rule.Name, notification.Rule.Kind)
+	fmt.Fprintf(body, "Subject: %s\r\n", alert.Subject)
+	fmt.Fprintf(body, "Fired at: %s\r\n", alert.FiredAt.UTC().Format(time.RFC3339))
+	if alert.ResolvedAt != nil {
+		fmt.Fprintf(body, "Resolved at: %s\r\n", alert.ResolvedAt.UTC().Format(time.RFC3339))
+	}
+	return []byte(body.String())
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"testing"
+	"time"
+
+	"github.com/compliance-framework/api/internal/config"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func testAlertNotification() AlertNotification {
+	return AlertNotification{
+		Rule: relational.AlertRule{Name: "Agents offline", Kind: relational.AlertRuleAgentOffline, Window: "10m"},
+		Alert: relational.Alert{
+			Subject: "0b4c6a10-ae3c-4ce5-8c3b-5b0a0c9e0f4f",
+			State:   relational.AlertStateFiring,
+			Summary: "Agent has not sent a heartbeat",
+			FiredAt: time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
+func TestAlertNotification(t *testing.T) {
+	assert.Equal(t, "[FIRING] Agents offline: 0b4c6a10-ae3c-4ce5-8c3b-5b0a0c9e0f4f", testAlertNotification().Subject())
+}
+
+func TestNewAlertNotifiers(t *testing.T) {
+	sugar := zap.NewNop().Sugar()
+
+	notifiers := NewAlertNotifiers(&config.Config{}, sugar)
+	assert.Len(t, notifiers, 1)
+	assert.Contains(t, notifiers, relational.AlertNotifierLog)
+
+	notifiers = NewAlertNotifiers(&config.Config{
+		AlertWebhookURL: "https://example.com/alerts",
+		AlertSMTPAddr:   "mail.example.com:587",
+		AlertSMTPFrom:   "ccf@example.com",
+		AlertSMTPTo:     []string{"ops@example.com"},
+	}, sugar)
+	assert.Len(t, notifiers, 3)
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	t.Run("Notifications are posted as JSON", func(t *testing.T) {
+		var received AlertNotification
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		notification := testAlertNotification()
+		require.NoError(t, NewWebhookNotifier(server.URL).Notify(context.Background(), notification))
+		assert.Equal(t, notification.Rule.Name, received.Rule.Name)
+		assert.Equal(t, notification.Alert.Subject, received.Alert.Subject)
+		assert.Equal(t, relational.AlertStateFiring, received.Alert.State)
+	})
+
+	t.Run("Unsuccessful responses are errors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		assert.Error(t, NewWebhookNotifier(server.URL).Notify(context.Background(), testAlertNotification()))
+	})
+}
+
+func TestSMTPNotifier(t *testing.T) {
+	notifier := NewSMTPNotifier("mail.example.com:587", nil, "ccf@example.com", []string{"ops@example.com", "sec@example.com"})
+
+	var sent []byte
+	notifier.send = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		assert.Equal(t, "mail.example.com:587", addr)
+		assert.Equal(t, "ccf@example.com", from)
+		assert.Equal(t, []string{"ops@example.com", "sec@example.com"}, to)
+		sent = msg
+		return nil
+	}
+
+	notification := testAlertNotification()
+	resolvedAt := notification.Alert.FiredAt.Add(time.Hour)
+	notification.Alert.State = relational.AlertStateResolved
+	notification.Alert.ResolvedAt = &resolvedAt
+	require.NoError(t, notifier.Notify(context.Background(), notification))
+
+	message := string(sent)
+	assert.Contains(t, message, "To: ops@example.com, sec@example.com\r\n")
+	assert.Contains(t, message, "Subject: [RESOLVED] Agents offline: 0b4c6a10-ae3c-4ce5-8c3b-5b0a0c9e0f4f\r\n")
+	assert.Contains(t, message, "\r\n\r\nAgent has not sent a heartbeat\r\n")
+	assert.Contains(t, message, "Resolved at: 2025-06-01T13:00:00Z\r\n")
+}
@@ -0,0 +1,299 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/compliance-framework/api/internal/converters/labelfilter"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AlertCondition is a subject the condition of an alert rule holds for.
+type AlertCondition struct {
+	Subject string
+	Summary string
+}
+
+// AlertEvaluator evaluates alert rules, recording alerts as they fire and resolve, and notifying them.
+type AlertEvaluator struct {
+	db        *gorm.DB
+	sugar     *zap.SugaredLogger
+	notifiers map[string]Notifier
+	// staleness is the evidence staleness window, as stale evidence is not counted as satisfied.
+	staleness time.Duration
+}
+
+func NewAlertEvaluator(db *gorm.DB, sugar *zap.SugaredLogger, notifiers map[string]Notifier, staleness time.Duration) *AlertEvaluator {
+	return &AlertEvaluator{
+		db:        db,
+		sugar:     sugar,
+		notifiers: notifiers,
+		staleness: staleness,
+	}
+}
+
+// Conditions returns the subjects the condition of a rule holds for at the given time.
+func (e *AlertEvaluator) Conditions(ctx context.Context, rule relational.AlertRule, now time.Time) ([]AlertCondition, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+	db := e.db.WithContext(ctx)
+	switch rule.Kind {
+	case relational.AlertRuleAgentOffline:
+		return e.offlineAgents(db, rule, now)
+	case relational.AlertRuleEvidenceGap:
+		return e.evidenceGaps(db, rule, now)
+	case relational.AlertRuleComplianceBelow:
+		return e.complianceBelow(db, rule, now)
+	}
+	return nil, fmt.Errorf("unknown alert rule kind %q", rule.Kind)
+}
+
+func (e *AlertEvaluator) offlineAgents(db *gorm.DB, rule relational.AlertRule, now time.Time) ([]AlertCondition, error) {
+	window, _ := rule.WindowDuration()
+	query := db.Model(&relational.Agent{}).Where("last_seen_at < ?", now.Add(-window))
+	if rule.Target != "" {
+		var known int64
+		if err := db.Model(&relational.Agent{}).Where("uuid = ?", rule.Target).Count(&known).Error; err != nil {
+			return nil, err
+		}
+		if known == 0 {
+			return []AlertCondition{{
+				Subject: rule.Target,
+				Summary: fmt.Sprintf("Agent %s has never sent a heartbeat", rule.Target),
+			}}, nil
+		}
+		query = query.Where("uuid = ?", rule.Target)
+	}
+
+	var agents []relational.Agent
+	if err := query.Order("uuid").Find(&agents).Error; err != nil {
+		return nil, err
+	}
+	conditions := make([]AlertCondition, 0, len(agents))
+	for _, agent := range agents {
+		name := agent.UUID.String()
+		if agent.Name != "" {
+			name = fmt.Sprintf("%s (%s)", agent.Name, agent.UUID)
+		}
+		conditions = append(conditions, AlertCondition{
+			Subject: agent.UUID.String(),
+			Summary: fmt.Sprintf("Agent %s has not sent a heartbeat since %s", name, agent.LastSeenAt.UTC().Format(time.RFC3339)),
+		})
+	}
+	return conditions, nil
+}
+
+func (e *AlertEvaluator) evidenceGaps(db *gorm.DB, rule relational.AlertRule, now time.Time) ([]AlertCondition, error) {
+	window, _ := rule.WindowDuration()
+	query := db.Table("(?) AS l", relational.GetLatestEvidenceStreamsQuery(db))
+	if rule.Target != "" {
+		query = query.Where("l.uuid = ?", rule.Target)
+	}
+
+	type stream struct {
+		UUID     uuid.UUID
+		Title    string
+		LastSeen time.Time
+	}
+	var streams []stream
+	if err := query.
+		Select(`l.uuid, l.title, coalesce(l.last_seen_at, l."end") AS last_seen`).
+		Order("l.uuid").
+		Scan(&streams).Error; err != nil {
+		return nil, err
+	}
+	if rule.Target != "" && len(streams) == 0 {
+		return []AlertCondition{{
+			Subject: rule.Target,
+			Summary: fmt.Sprintf("No evidence has been received for stream %s", rule.Target),
+		}}, nil
+	}
+
+	conditions := []AlertCondition{}
+	for _, stream := range streams {
+		if !stream.LastSeen.Before(now.Add(-window)) {
+			continue
+		}
+		conditions = append(conditions, AlertCondition{
+			Subject: stream.UUID.String(),
+			Summary: fmt.Sprintf("No evidence has been received for %q (%s) since %s", stream.Title, stream.UUID, stream.LastSeen.UTC().Format(time.RFC3339)),
+		})
+	}
+	return conditions, nil
+}
+
+func (e *AlertEvaluator) complianceBelow(db *gorm.DB, rule relational.AlertRule, now time.Time) ([]AlertCondition, error) {
+	control := &relational.Control{}
+	if err := db.Preload("Filters").First(control, "id = ?", rule.Target).Error; err != nil {
+		return nil, fmt.Errorf("failed to load control %s: %w", rule.Target, err)
+	}
+	filters := []labelfilter.Filter{}
+	for _, filter := range control.Filters {
+		filters = append(filters, filter.Filter.Data())
+	}
+	// As for the compliance of a control reported by the API, a control without filters has no evidence.
+	if len(filters) == 0 {
+		return nil, nil
+	}
+
+	latest, err := relational.GetEvidenceSearchByFilterQuery(relational.GetLatestEvidenceStreamsQuery(db), db, filters...)
+	if err != nil {
+		return nil, err
+	}
+	var counts struct {
+		Total     int64
+		Satisfied int64
+	}
+	states := latest.Select("? AS state", relational.EvidenceStateExpression(now, e.staleness))
+	if err := db.Table("(?) AS states", states).
+		Select("count(*) AS total, count(*) FILTER (WHERE state = 'satisfied') AS satisfied").
+		Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+	if counts.Total == 0 {
+		return nil, nil
+	}
+
+	percentage := float64(counts.Satisfied) / float64(counts.Total) * 100
+	if percentage >= rule.Threshold {
+		return nil, nil
+	}
+	return []AlertCondition{{
+		Subject: control.ID,
+		Summary: fmt.Sprintf("%.1f%% of evidence for control %s is satisfied (%d of %d), below %g%%", percentage, control.ID, counts.Satisfied, counts.Total, rule.Threshold),
+	}}, nil
+}
+
+// Evaluate evaluates every alert rule, firing an alert for each subject a condition newly holds for, and resolving
+// the alerts of subjects it no longer holds for. Alerts of disabled rules are resolved. Notifications are sent for
+// each alert fired or resolved. Rules which fail to evaluate are skipped, and their errors returned together.
+func (e *AlertEvaluator) Evaluate(ctx context.Context, now time.Time) (fired int, resolved int, err error) {
+	var rules []relational.AlertRule
+	if err := e.db.WithContext(ctx).Order("created_at").Find(&rules).Error; err != nil {
+		return 0, 0, fmt.Errorf("failed to load alert rules: %w", err)
+	}
+
+	errs := []error{}
+	for _, rule := range rules {
+		conditions := []AlertCondition{}
+		if rule.Enabled {
+			var evaluateErr error
+			conditions, evaluateErr = e.Conditions(ctx, rule, now)
+			if evaluateErr != nil {
+				errs = append(errs, fmt.Errorf("failed to evaluate alert rule %s: %w", rule.ID, evaluateErr))
+				continue
+			}
+		}
+		ruleFired, ruleResolved, applyErr := e.apply(ctx, rule, conditions, now)
+		fired += ruleFired
+		resolved += ruleResolved
+		if applyErr != nil {
+			errs = append(errs, fmt.Errorf("failed to record alerts of rule %s: %w", rule.ID, applyErr))
+		}
+	}
+	return fired, resolved, errors.Join(errs...)
+}
+
+// apply fires alerts for conditions without a firing alert, and resolves firing alerts without a condition.
+// Alerts are recorded so that only one evaluator fires or resolves each of them, should several run at once.
+func (e *AlertEvaluator) apply(ctx context.Context, rule relational.AlertRule, conditions []AlertCondition, now time.Time) (fired int, resolved int, err error) {
+	db := e.db.WithContext(ctx)
+	var firing []relational.Alert
+	if err := db.Where("rule_id = ? AND state = ?", rule.ID, relational.AlertStateFiring).Find(&firing).Error; err != nil {
+		return 0, 0, err
+	}
+
+	for _, condition := range conditions {
+		if slices.ContainsFunc(firing, func(alert relational.Alert) bool { return alert.Subject == condition.Subject }) {
+			continue
+		}
+		alert := relational.Alert{
+			RuleID:  *rule.ID,
+			Subject: condition.Subject,
+			State:   relational.AlertStateFiring,
+			Summary: condition.Summary,
+			FiredAt: now,
+		}
+		result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&alert)
+		if result.Error != nil {
+			return fired, resolved, result.Error
+		}
+		if result.RowsAffected == 1 {
+			fired++
+			e.notify(ctx, rule, alert)
+		}
+	}
+
+	for _, alert := range firing {
+		if slices.ContainsFunc(conditions, func(condition AlertCondition) bool { return condition.Subject == alert.Subject }) {
+			continue
+		}
+		result := db.Model(&relational.Alert{}).
+			Where("id = ? AND state = ?", alert.ID, relational.AlertStateFiring).
+			Updates(map[string]any{"state": relational.AlertStateResolved, "resolved_at": now})
+		if result.Error != nil {
+			return fired, resolved, result.Error
+		}
+		if result.RowsAffected == 1 {
+			resolved++
+			alert.State = relational.AlertStateResolved
+			alert.ResolvedAt = &now
+			e.notify(ctx, rule, alert)
+		}
+	}
+	return fired, resolved, nil
+}
+
+// notify sends a notification through the notifiers of the rule, or all of them when the rule names none.
+// Notifiers which are not configured are skipped, and failures are logged rather than retried.
+func (e *AlertEvaluator) notify(ctx context.Context, rule relational.AlertRule, alert relational.Alert) {
+	names := rule.Notifiers
+	if len(names) == 0 {
+		names = slices.Sorted(maps.Keys(e.notifiers))
+	}
+
+	notification := AlertNotification{Rule: rule, Alert: alert}
+	for _, name := range names {
+		notifier, ok := e.notifiers[name]
+		if !ok {
+			e.sugar.Warnw("Alert notifier is not configured", "notifier", name, "rule", rule.ID)
+			continue
+		}
+		if err := notifier.Notify(ctx, notification); err != nil {
+			e.sugar.Errorw("Failed to send alert notification", "notifier", name, "rule", rule.ID, "alert", alert.ID, "error", err)
+		}
+	}
+}
+
+// Run evaluates alert rules immediately, and then at every interval until the context is cancelled.
+func (e *AlertEvaluator) Run(ctx context.Context, interval time.Duration) {
+	e.sugar.Infow("Starting alert evaluation", "interval", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		started := time.Now()
+		fired, resolved, err := e.Evaluate(ctx, started)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			e.sugar.Errorw("Failed to evaluate alert rules", "error", err)
+		}
+		if fired > 0 || resolved > 0 {
+			e.sugar.Infow("Evaluated alert rules", "fired", fired, "resolved", resolved, "duration", time.Since(started))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
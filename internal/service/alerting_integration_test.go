@@ -0,0 +1,246 @@
+//go:build integration
+
+package service_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/compliance-framework/api/internal/converters/labelfilter"
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/compliance-framework/api/internal/tests"
+	oscalTypes_1_1_3 "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"gorm.io/datatypes"
+)
+
+func TestAlerting(t *testing.T) {
+	suite.Run(t, new(AlertingIntegrationSuite))
+}
+
+type AlertingIntegrationSuite struct {
+	tests.IntegrationTestSuite
+}
+
+// recordingNotifier records the notifications it is sent.
+type recordingNotifier struct {
+	mu            sync.Mutex
+	notifications []service.AlertNotification
+}
+
+func (n *recordingNotifier) Notify(_ context.Context, notification service.AlertNotification) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.notifications = append(n.notifications, notification)
+	return nil
+}
+
+func (n *recordingNotifier) take() []service.AlertNotification {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	notifications := n.notifications
+	n.notifications = nil
+	return notifications
+}
+
+func (suite *AlertingIntegrationSuite) evaluator() (*service.AlertEvaluator, *recordingNotifier) {
+	notifier := &recordingNotifier{}
+	logger, _ := zap.NewDevelopment()
+	evaluator := service.NewAlertEvaluator(suite.DB, logger.Sugar(), map[string]service.Notifier{
+		relational.AlertNotifierLog: notifier,
+	}, 0)
+	return evaluator, notifier
+}
+
+func (suite *AlertingIntegrationSuite) createRule(rule relational.AlertRule) relational.AlertRule {
+	rule.Enabled = true
+	suite.Require().NoError(suite.DB.Create(&rule).Error)
+	return rule
+}
+
+func (suite *AlertingIntegrationSuite) createEvidence(stream uuid.UUID, end time.Time, state string) {
+	evidence := relational.Evidence{
+		UUID:   stream,
+		Title:  "Evidence",
+		Start:  end.Add(-time.Minute),
+		End:    end,
+		Status: datatypes.NewJSONType(oscalTypes_1_1_3.ObjectiveStatus{State: state}),
+		Labels: []relational.Labels{
+			{Name: "provider", Value: "aws"},
+		},
+	}
+	suite.Require().NoError(suite.DB.Create(&evidence).Error)
+}
+
+func (suite *AlertingIntegrationSuite) firing(rule relational.AlertRule) []relational.Alert {
+	var alerts []relational.Alert
+	suite.Require().NoError(suite.DB.
+		Where("rule_id = ? AND state = ?", rule.ID, relational.AlertStateFiring).
+		Order("subject").
+		Find(&alerts).Error)
+	return alerts
+}
+
+func (suite *AlertingIntegrationSuite) TestAgentOffline() {
+	suite.Require().NoError(suite.Migrator.Refresh())
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	online := relational.Agent{UUID: uuid.New(), FirstSeenAt: now.Add(-time.Hour), LastSeenAt: now.Add(-time.Minute)}
+	offline := relational.Agent{UUID: uuid.New(), FirstSeenAt: now.Add(-time.Hour), LastSeenAt: now.Add(-30 * time.Minute)}
+	suite.Require().NoError(suite.DB.Create(&[]relational.Agent{online, offline}).Error)
+
+	all := suite.createRule(relational.AlertRule{Name: "Agents offline", Kind: relational.AlertRuleAgentOffline, Window: "10m"})
+	unknown := suite.createRule(relational.AlertRule{Name: "Unknown agent", Kind: relational.AlertRuleAgentOffline, Target: uuid.NewString(), Window: "10m"})
+
+	evaluator, notifier := suite.evaluator()
+
+	suite.Run("Offline agents fire", func() {
+		fired, resolved, err := evaluator.Evaluate(context.Background(), now)
+		suite.Require().NoError(err)
+		suite.Equal(2, fired)
+		suite.Zero(resolved)
+
+		alerts := suite.firing(all)
+		suite.Require().Len(alerts, 1)
+		suite.Equal(offline.UUID.String(), alerts[0].Subject)
+		suite.True(now.Equal(alerts[0].FiredAt))
+
+		// An agent which has never sent a heartbeat is offline too.
+		alerts = suite.firing(unknown)
+		suite.Require().Len(alerts, 1)
+		suite.Equal(unknown.Target, alerts[0].Subject)
+
+		suite.Len(notifier.take(), 2)
+	})
+
+	suite.Run("Firing alerts are not fired again", func() {
+		fired, resolved, err := evaluator.Evaluate(context.Background(), now.Add(time.Minute))
+		suite.Require().NoError(err)
+		suite.Zero(fired)
+		suite.Zero(resolved)
+		suite.Empty(notifier.take())
+	})
+
+	suite.Run("Alerts resolve once agents are back online", func() {
+		suite.Require().NoError(suite.DB.Model(&offline).Update("last_seen_at", now.Add(2*time.Minute)).Error)
+
+		fired, resolved, err := evaluator.Evaluate(context.Background(), now.Add(3*time.Minute))
+		suite.Require().NoError(err)
+		suite.Zero(fired)
+		suite.Equal(1, resolved)
+		suite.Empty(suite.firing(all))
+
+		var alert relational.Alert
+		suite.Require().NoError(suite.DB.First(&alert, "rule_id = ?", all.ID).Error)
+		suite.Equal(relational.AlertStateResolved, alert.State)
+		suite.Require().NotNil(alert.ResolvedAt)
+		suite.True(now.Add(3 * time.Minute).Equal(*alert.ResolvedAt))
+
+		notifications := notifier.take()
+		suite.Require().Len(notifications, 1)
+		suite.Equal(relational.AlertStateResolved, notifications[0].Alert.State)
+		suite.Equal(all.Name, notifications[0].Rule.Name)
+	})
+
+	suite.Run("Alerts of disabled rules resolve", func() {
+		suite.Require().NoError(suite.DB.Model(&unknown).Update("enabled", false).Error)
+
+		fired, resolved, err := evaluator.Evaluate(context.Background(), now.Add(4*time.Minute))
+		suite.Require().NoError(err)
+		suite.Zero(fired)
+		suite.Equal(1, resolved)
+		suite.Empty(suite.firing(unknown))
+	})
+}
+
+func (suite *AlertingIntegrationSuite) TestEvidenceGap() {
+	suite.Require().NoError(suite.Migrator.Refresh())
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	current := uuid.New()
+	lapsed := uuid.New()
+	suite.createEvidence(current, now.Add(-5*time.Minute), "satisfied")
+	suite.createEvidence(lapsed, now.Add(-2*time.Hour), "satisfied")
+
+	all := suite.createRule(relational.AlertRule{Name: "Evidence gaps", Kind: relational.AlertRuleEvidenceGap, Window: "1h"})
+	target := suite.createRule(relational.AlertRule{Name: "Current stream", Kind: relational.AlertRuleEvidenceGap, Target: current.String(), Window: "1h"})
+
+	evaluator, notifier := suite.evaluator()
+
+	fired, _, err := evaluator.Evaluate(context.Background(), now)
+	suite.Require().NoError(err)
+	suite.Equal(1, fired)
+
+	alerts := suite.firing(all)
+	suite.Require().Len(alerts, 1)
+	suite.Equal(lapsed.String(), alerts[0].Subject)
+	suite.Empty(suite.firing(target))
+	suite.Len(notifier.take(), 1)
+
+	// Once the targeted stream has not been seen for longer than the window, it fires as well.
+	fired, _, err = evaluator.Evaluate(context.Background(), now.Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Equal(2, fired)
+	suite.Len(suite.firing(all), 2)
+	suite.Len(suite.firing(target), 1)
+}
+
+func (suite *AlertingIntegrationSuite) TestComplianceBelow() {
+	suite.Require().NoError(suite.Migrator.Refresh())
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	suite.Require().NoError(suite.DB.Create(&relational.Catalog{
+		Metadata: relational.Metadata{Title: "Catalog"},
+		Controls: []relational.Control{{ID: "AC-1", Title: "Access Control"}},
+	}).Error)
+	var control relational.Control
+	suite.Require().NoError(suite.DB.First(&control, "id = ?", "AC-1").Error)
+	suite.Require().NoError(suite.DB.Create(&relational.Filter{
+		Name: "AWS",
+		Filter: datatypes.NewJSONType(labelfilter.Filter{
+			Scope: &labelfilter.Scope{
+				Condition: &labelfilter.Condition{Label: "provider", Operator: "=", Value: "aws"},
+			},
+		}),
+		Controls: []relational.Control{control},
+	}).Error)
+
+	suite.createEvidence(uuid.New(), now.Add(-time.Minute), "satisfied")
+	suite.createEvidence(uuid.New(), now.Add(-time.Minute), "not-satisfied")
+
+	rule := suite.createRule(relational.AlertRule{Name: "AC-1 compliance", Kind: relational.AlertRuleComplianceBelow, Target: "AC-1", Threshold: 75})
+	evaluator, notifier := suite.evaluator()
+
+	suite.Run("Controls below the threshold fire", func() {
+		fired, _, err := evaluator.Evaluate(context.Background(), now)
+		suite.Require().NoError(err)
+		suite.Equal(1, fired)
+
+		alerts := suite.firing(rule)
+		suite.Require().Len(alerts, 1)
+		suite.Equal("AC-1", alerts[0].Subject)
+		suite.Contains(alerts[0].Summary, "50.0%")
+		suite.Len(notifier.take(), 1)
+	})
+
+	suite.Run("Controls at the threshold resolve", func() {
+		suite.createEvidence(uuid.New(), now.Add(-time.Minute), "satisfied")
+		suite.createEvidence(uuid.New(), now.Add(-time.Minute), "satisfied")
+
+		_, resolved, err := evaluator.Evaluate(context.Background(), now)
+		suite.Require().NoError(err)
+		suite.Equal(1, resolved)
+		suite.Empty(suite.firing(rule))
+	})
+
+	suite.Run("Rules for unknown controls fail", func() {
+		suite.createRule(relational.AlertRule{Name: "Unknown control", Kind: relational.AlertRuleComplianceBelow, Target: "XX-1", Threshold: 50})
+		_, _, err := evaluator.Evaluate(context.Background(), now)
+		suite.Error(err)
+	})
+}
@@ -0,0 +1,339 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/compliance-framework/api/internal/service/relational"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrBlobNotFound is returned by blob stores when no content is stored under a key.
+var ErrBlobNotFound = errors.New("blob not found")
+
+const (
+	// BlobStoreDatabase stores blobs in the database, alongside the evidence referencing them.
+	BlobStoreDatabase = "database"
+	// BlobStoreFilesystem stores blobs as files in a local directory.
+	BlobStoreFilesystem = "filesystem"
+)
+
+// BlobStores lists the supported blob store kinds.
+var BlobStores = []string{BlobStoreDatabase, BlobStoreFilesystem}
+
+// BlobInfo describes content written to a blob store.
+type BlobInfo struct {
+	// Key identifies the content in the store. Keys are derived from the content, so identical content is only
+	// stored once.
+	Key    string
+	Size   int64
+	Hashes []relational.Hash
+}
+
+// BlobStore stores the raw content of evidence attachments.
+type BlobStore interface {
+	// Put stores content, computing its hashes as it is read.
+	Put(ctx context.Context, content io.Reader) (*BlobInfo, error)
+	// Get opens the content stored under key, returning ErrBlobNotFound if there is none.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Sweep deletes the content last stored before the given time which is no longer in use, and returns the number of
+	// blobs deleted. inUse is called with batches of keys, and returns those which are still in use.
+	Sweep(ctx context.Context, before time.Time, inUse func(keys []string) ([]string, error)) (int64, error)
+}
+
+// blobSweepBatchSize is the number of keys checked by each call to the inUse function of a sweep.
+const blobSweepBatchSize = 1000
+
+// unusedBlobs returns the keys which inUse does not report as being in use.
+func unusedBlobs(keys []string, inUse func(keys []string) ([]string, error)) ([]string, error) {
+	used, err := inUse(keys)
+	if err != nil {
+		return nil, err
+	}
+	unused := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if !slices.Contains(used, key) {
+			unused = append(unused, key)
+		}
+	}
+	return unused, nil
+}
+
+// TransactionalBlobStore is implemented by blob stores which can write blobs within a database transaction, so that
+// blobs written for evidence which fails to be recorded are rolled back along with it.
+type TransactionalBlobStore interface {
+	BlobStore
+	// WithTx returns a store which writes blobs using the transaction.
+	WithTx(tx *gorm.DB) BlobStore
+}
+
+// NewBlobStore creates a blob store of the given kind. path is the directory used by the filesystem store.
+func NewBlobStore(kind string, path string, db *gorm.DB) (BlobStore, error) {
+	switch kind {
+	case BlobStoreDatabase, "":
+		return NewDatabaseBlobStore(db), nil
+	case BlobStoreFilesystem:
+		return NewFilesystemBlobStore(path)
+	}
+	return nil, fmt.Errorf("unsupported blob store %q", kind)
+}
+
+// blobHasher computes the hashes recorded for blobs, and the key they are stored under.
+type blobHasher struct {
+	sha256 hash.Hash
+	size   int64
+}
+
+func newBlobHasher() *blobHasher {
+	return &blobHasher{sha256: sha256.New()}
+}
+
+func (h *blobHasher) Write(p []byte) (int, error) {
+	h.size += int64(len(p))
+	return h.sha256.Write(p)
+}
+
+func (h *blobHasher) info() *BlobInfo {
+	sum := hex.EncodeToString(h.sha256.Sum(nil))
+	return &BlobInfo{
+		Key:  sum,
+		Size: h.size,
+		Hashes: []relational.Hash{
+			{Algorithm: relational.HashAlgorithmSHA_256, Value: sum},
+		},
+	}
+}
+
+// DatabaseBlobStore keeps blobs in the blobs table. It suits small artifacts, and deployments without shared storage.
+type DatabaseBlobStore struct {
+	db *gorm.DB
+}
+
+func NewDatabaseBlobStore(db *gorm.DB) *DatabaseBlobStore {
+	return &DatabaseBlobStore{db: db}
+}
+
+func (s *DatabaseBlobStore) WithTx(tx *gorm.DB) BlobStore {
+	return &DatabaseBlobStore{db: tx}
+}
+
+func (s *DatabaseBlobStore) Put(ctx context.Context, content io.Reader) (*BlobInfo, error) {
+	hasher := newBlobHasher()
+	data, err := io.ReadAll(io.TeeReader(content, hasher))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read blob: %w", err)
+	}
+	info := hasher.info()
+	// Content which is already stored is marked as stored again, so that it is not swept before it is referenced.
+	if err := s.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "key"}},
+			DoUpdates: clause.AssignmentColumns([]string{"stored_at"}),
+		}).
+		Create(&relational.Blob{Key: info.Key, Content: data, StoredAt: time.Now()}).Error; err != nil {
+		return nil, fmt.Errorf("failed to store blob: %w", err)
+	}
+	return info, nil
+}
+
+func (s *DatabaseBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	blob := &relational.Blob{}
+	err := s.db.WithContext(ctx).First(blob, "key = ?", key).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(blob.Content)), nil
+}
+
+func (s *DatabaseBlobStore) Sweep(ctx context.Context, before time.Time, inUse func(keys []string) ([]string, error)) (int64, error) {
+	db := s.db.WithContext(ctx)
+	var deleted int64
+	last := ""
+	for {
+		var keys []string
+		if err := db.Model(&relational.Blob{}).
+			Where("stored_at < ? AND key > ?", before, last).
+			Order("key").
+			Limit(blobSweepBatchSize).
+			Pluck("key", &keys).Error; err != nil {
+			return deleted, fmt.Errorf("failed to list blobs: %w", err)
+		}
+		if len(keys) == 0 {
+			return deleted, nil
+		}
+		last = keys[len(keys)-1]
+
+		unused, err := unusedBlobs(keys, inUse)
+		if err != nil {
+			return deleted, err
+		}
+		if len(unused) == 0 {
+			continue
+		}
+		// Blobs stored again since they were listed are kept.
+		result := db.Where("key IN ? AND stored_at < ?", unused, before).Delete(&relational.Blob{})
+		if result.Error != nil {
+			return deleted, fmt.Errorf("failed to delete blobs: %w", result.Error)
+		}
+		deleted += result.RowsAffected
+	}
+}
+
+// FilesystemBlobStore keeps blobs as files in a directory, named after their SHA-256 hash.
+type FilesystemBlobStore struct {
+	root string
+}
+
+// NewFilesystemBlobStore creates a store in the directory at root, creating the directory if needed.
+func NewFilesystemBlobStore(root string) (*FilesystemBlobStore, error) {
+	if root == "" {
+		return nil, errors.New("a directory is required for the filesystem blob store")
+	}
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create blob directory: %w", err)
+	}
+	return &FilesystemBlobStore{root: root}, nil
+}
+
+// path spreads blobs across subdirectories named after the first characters of their key.
+func (s *FilesystemBlobStore) path(key string) (string, error) {
+	if len(key) < 3 || filepath.Base(key) != key {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(s.root, key[:2], key), nil
+}
+
+func (s *FilesystemBlobStore) Put(ctx context.Context, content io.Reader) (*BlobInfo, error) {
+	// Content is written to a temporary file first, as the name of the file depends on its hash.
+	temp, err := os.CreateTemp(s.root, "upload-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create blob: %w", err)
+	}
+	defer os.Remove(temp.Name())
+	defer temp.Close()
+
+	hasher := newBlobHasher()
+	if _, err := io.Copy(io.MultiWriter(temp, hasher), content); err != nil {
+		return nil, fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := temp.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write blob: %w", err)
+	}
+
+	info := hasher.info()
+	path, err := s.path(info.Key)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create blob directory: %w", err)
+	}
+	if err := os.Rename(temp.Name(), path); err != nil {
+		return nil, fmt.Errorf("failed to store blob: %w", err)
+	}
+	return info, nil
+}
+
+func (s *FilesystemBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+func (s *FilesystemBlobStore) Sweep(ctx context.Context, before time.Time, inUse func(keys []string) ([]string, error)) (int64, error) {
+	var deleted int64
+	sweep := func(keys []string) error {
+		unused, err := unusedBlobs(keys, inUse)
+		if err != nil {
+			return err
+		}
+		for _, key := range unused {
+			path, err := s.path(key)
+			if err != nil {
+				return err
+			}
+			// Blobs stored again since they were listed are kept, as storing them replaces their file.
+			info, err := os.Stat(path)
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if !info.ModTime().Before(before) {
+				continue
+			}
+			if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("failed to delete blob: %w", err)
+			}
+			deleted++
+		}
+		return nil
+	}
+
+	keys := []string{}
+	err := filepath.WalkDir(s.root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		// Only blobs are swept, leaving alone the temporary files of blobs being stored.
+		if entry.IsDir() {
+			return nil
+		}
+		if expected, err := s.path(entry.Name()); err != nil || expected != path {
+			return nil
+		}
+		info, err := entry.Info()
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !info.ModTime().Before(before) {
+			return nil
+		}
+
+		keys = append(keys, entry.Name())
+		if len(keys) < blobSweepBatchSize {
+			return nil
+		}
+		err = sweep(keys)
+		keys = keys[:0]
+		return err
+	})
+	if err == nil && len(keys) > 0 {
+		err = sweep(keys)
+	}
+	if err != nil {
+		return deleted, fmt.Errorf("failed to sweep blobs: %w", err)
+	}
+	return deleted, nil
+}
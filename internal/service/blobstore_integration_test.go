@@ -0,0 +1,53 @@
+//go:build integration
+
+package service_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/tests"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestBlobStore(t *testing.T) {
+	suite.Run(t, new(BlobStoreIntegrationSuite))
+}
+
+type BlobStoreIntegrationSuite struct {
+	tests.IntegrationTestSuite
+}
+
+func (suite *BlobStoreIntegrationSuite) TestDatabaseBlobStoreTransaction() {
+	suite.Require().NoError(suite.Migrator.Refresh())
+	ctx := context.Background()
+	store := service.NewDatabaseBlobStore(suite.DB)
+	rollback := errors.New("rollback")
+
+	var key string
+	err := suite.DB.Transaction(func(tx *gorm.DB) error {
+		info, err := store.WithTx(tx).Put(ctx, strings.NewReader("rolled back"))
+		suite.Require().NoError(err)
+		key = info.Key
+		return rollback
+	})
+	suite.Require().ErrorIs(err, rollback)
+	_, err = store.Get(ctx, key)
+	suite.ErrorIs(err, service.ErrBlobNotFound, "Expected blobs written in a transaction to be rolled back with it")
+
+	err = suite.DB.Transaction(func(tx *gorm.DB) error {
+		info, err := store.WithTx(tx).Put(ctx, strings.NewReader("committed"))
+		if err == nil {
+			key = info.Key
+		}
+		return err
+	})
+	suite.Require().NoError(err)
+	content, err := store.Get(ctx, key)
+	suite.Require().NoError(err)
+	content.Close()
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilesystemBlobStore(t *testing.T) {
+	ctx := context.Background()
+	root := filepath.Join(t.TempDir(), "blobs")
+	store, err := NewFilesystemBlobStore(root)
+	require.NoError(t, err)
+
+	info, err := store.Put(ctx, strings.NewReader("hello world"))
+	require.NoError(t, err)
+	sum := "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+	assert.Equal(t, sum, info.Key)
+	assert.Equal(t, int64(11), info.Size)
+	assert.Equal(t, []relational.Hash{{Algorithm: relational.HashAlgorithmSHA_256, Value: sum}}, info.Hashes)
+	assert.FileExists(t, filepath.Join(root, "b9", sum))
+
+	again, err := store.Put(ctx, strings.NewReader("hello world"))
+	require.NoError(t, err)
+	assert.Equal(t, info.Key, again.Key, "identical content is stored under the same key")
+
+	entries, err := os.ReadDir(root)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "temporary files are cleaned up")
+
+	content, err := store.Get(ctx, info.Key)
+	require.NoError(t, err)
+	defer content.Close()
+	data, err := io.ReadAll(content)
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", string(data))
+
+	_, err = store.Get(ctx, strings.Repeat("0", 64))
+	assert.ErrorIs(t, err, ErrBlobNotFound)
+
+	_, err = store.Get(ctx, "../../etc/passwd")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrBlobNotFound)
+}
+
+func TestNewBlobStore(t *testing.T) {
+	store, err := NewBlobStore("", "", nil)
+	require.NoError(t, err)
+	assert.IsType(t, &DatabaseBlobStore{}, store)
+
+	store, err = NewBlobStore(BlobStoreFilesystem, t.TempDir(), nil)
+	require.NoError(t, err)
+	assert.IsType(t, &FilesystemBlobStore{}, store)
+
+	_, err = NewBlobStore(BlobStoreFilesystem, "", nil)
+	assert.Error(t, err)
+
+	_, err = NewBlobStore("s3", "", nil)
+	assert.Error(t, err)
+}
+
+func TestFilesystemBlobStoreSweep(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	store, err := NewFilesystemBlobStore(root)
+	require.NoError(t, err)
+
+	used, err := store.Put(ctx, strings.NewReader("used"))
+	require.NoError(t, err)
+	unused, err := store.Put(ctx, strings.NewReader("unused"))
+	require.NoError(t, err)
+	recent, err := store.Put(ctx, strings.NewReader("recent"))
+	require.NoError(t, err)
+	// Temporary files of blobs being stored are not swept.
+	temp, err := os.CreateTemp(root, "upload-*")
+	require.NoError(t, err)
+	require.NoError(t, temp.Close())
+
+	old := time.Now().Add(-2 * time.Hour)
+	for _, info := range []*BlobInfo{used, unused} {
+		path, err := store.path(info.Key)
+		require.NoError(t, err)
+		require.NoError(t, os.Chtimes(path, old, old))
+	}
+	require.NoError(t, os.Chtimes(temp.Name(), old, old))
+
+	deleted, err := store.Sweep(ctx, time.Now().Add(-time.Hour), func(keys []string) ([]string, error) {
+		assert.ElementsMatch(t, []string{used.Key, unused.Key}, keys, "only blobs stored before the cutoff are checked")
+		return []string{used.Key}, nil
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, deleted)
+
+	for _, info := range []*BlobInfo{used, recent} {
+		_, err := store.Get(ctx, info.Key)
+		assert.NoError(t, err)
+	}
+	_, err = store.Get(ctx, unused.Key)
+	assert.ErrorIs(t, err, ErrBlobNotFound)
+	assert.FileExists(t, temp.Name())
+}
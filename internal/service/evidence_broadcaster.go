@@ -0,0 +1,156 @@
+package service
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/compliance-framework/api/internal/converters/labelfilter"
+	"github.com/compliance-framework/api/internal/service/relational"
+)
+
+// EvidenceEventType identifies what happened to evidence in an EvidenceEvent.
+type EvidenceEventType string
+
+const (
+	// EvidenceCreated is published when new evidence is stored. Repeated sightings of unchanged evidence are not
+	// published, as they do not create evidence.
+	EvidenceCreated EvidenceEventType = "evidence.created"
+	// EvidenceTransitioned is published, alongside EvidenceCreated, when new evidence changes the state of its
+	// stream from the state of the previous evidence.
+	EvidenceTransitioned EvidenceEventType = "evidence.transitioned"
+)
+
+// EvidenceEvent describes newly created evidence, or a change of state in an evidence stream.
+type EvidenceEvent struct {
+	// Sequence increases with every event published by a broadcaster, so subscribers can detect gaps.
+	Sequence      uint64               `json:"sequence"`
+	Type          EvidenceEventType    `json:"type"`
+	Time          time.Time            `json:"time"`
+	PreviousState string               `json:"previous-state,omitempty"`
+	State         string               `json:"state,omitempty"`
+	Evidence      *relational.Evidence `json:"evidence"`
+}
+
+// EvidenceBroadcaster fans evidence events out to subscribers within the process.
+//
+// Publishing never blocks: each subscriber has its own buffer, and events which do not fit into a full buffer are
+// dropped for that subscriber only and counted, so that a slow subscriber cannot hold up evidence ingestion or
+// other subscribers.
+type EvidenceBroadcaster struct {
+	mu          sync.RWMutex
+	subscribers map[*EvidenceSubscription]struct{}
+	sequence    atomic.Uint64
+}
+
+// NewEvidenceBroadcaster creates a broadcaster without subscribers.
+func NewEvidenceBroadcaster() *EvidenceBroadcaster {
+	return &EvidenceBroadcaster{
+		subscribers: map[*EvidenceSubscription]struct{}{},
+	}
+}
+
+// EvidenceSubscription receives the events matching its filter until it is closed.
+type EvidenceSubscription struct {
+	broadcaster *EvidenceBroadcaster
+	filter      labelfilter.Filter
+	types       []EvidenceEventType
+	events      chan EvidenceEvent
+	dropped     atomic.Uint64
+	closeOnce   sync.Once
+}
+
+// Subscribe registers a subscriber for events on evidence matching filter, buffering up to buffer events.
+// When types are given, only events of those types are delivered.
+func (b *EvidenceBroadcaster) Subscribe(filter labelfilter.Filter, buffer int, types ...EvidenceEventType) (*EvidenceSubscription, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	filter, err := relational.ResolveFilterIdentifiers(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription := &EvidenceSubscription{
+		broadcaster: b,
+		filter:      filter,
+		types:       types,
+		events:      make(chan EvidenceEvent, buffer),
+	}
+	b.mu.Lock()
+	b.subscribers[subscription] = struct{}{}
+	b.mu.Unlock()
+	return subscription, nil
+}
+
+// Publish delivers events to every subscriber whose filter matches the evidence of the event.
+func (b *EvidenceBroadcaster) Publish(events ...EvidenceEvent) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, event := range events {
+		event.Sequence = b.sequence.Add(1)
+		if event.Time.IsZero() {
+			event.Time = time.Now()
+		}
+		for subscription := range b.subscribers {
+			if !subscription.wants(event) {
+				continue
+			}
+			select {
+			case subscription.events <- event:
+			default:
+				subscription.dropped.Add(1)
+			}
+		}
+	}
+}
+
+// Subscribers returns the number of open subscriptions.
+func (b *EvidenceBroadcaster) Subscribers() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subscribers)
+}
+
+func (s *EvidenceSubscription) wants(event EvidenceEvent) bool {
+	if len(s.types) > 0 && !containsEventType(s.types, event.Type) {
+		return false
+	}
+	if event.Evidence == nil {
+		return false
+	}
+	// The filter was validated when subscribing, so an error here can only come from evidence the filter cannot
+	// be evaluated against, which does not match.
+	matched, err := s.filter.Matches(event.Evidence, event.Time)
+	return err == nil && matched
+}
+
+func containsEventType(types []EvidenceEventType, eventType EvidenceEventType) bool {
+	for _, t := range types {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Events returns the channel events are delivered on. It is closed when the subscription is closed.
+func (s *EvidenceSubscription) Events() <-chan EvidenceEvent {
+	return s.events
+}
+
+// Dropped returns the number of events dropped since it was last called, because the buffer was full.
+func (s *EvidenceSubscription) Dropped() uint64 {
+	return s.dropped.Swap(0)
+}
+
+// Close unregisters the subscription and closes its events channel. It is safe to call more than once.
+func (s *EvidenceSubscription) Close() {
+	s.closeOnce.Do(func() {
+		s.broadcaster.mu.Lock()
+		delete(s.broadcaster.subscribers, s)
+		s.broadcaster.mu.Unlock()
+		close(s.events)
+	})
+}
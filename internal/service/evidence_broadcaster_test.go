@@ -0,0 +1,82 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/compliance-framework/api/internal/converters/labelfilter"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvidenceBroadcaster(t *testing.T) {
+	aws := &relational.Evidence{Title: "aws", Labels: []relational.Labels{{Name: "provider", Value: "aws"}}}
+	gcp := &relational.Evidence{Title: "gcp", Labels: []relational.Labels{{Name: "provider", Value: "gcp"}}}
+
+	subscribe := func(b *EvidenceBroadcaster, q string, buffer int, types ...EvidenceEventType) *EvidenceSubscription {
+		filter, err := labelfilter.Parse(q)
+		require.NoError(t, err)
+		subscription, err := b.Subscribe(filter, buffer, types...)
+		require.NoError(t, err)
+		return subscription
+	}
+
+	t.Run("Filters", func(t *testing.T) {
+		b := NewEvidenceBroadcaster()
+		all := subscribe(b, "", 10)
+		onlyAws := subscribe(b, "provider=aws", 10)
+		transitions := subscribe(b, "", 10, EvidenceTransitioned)
+
+		b.Publish(
+			EvidenceEvent{Type: EvidenceCreated, Evidence: aws},
+			EvidenceEvent{Type: EvidenceCreated, Evidence: gcp},
+			EvidenceEvent{Type: EvidenceTransitioned, Evidence: gcp},
+		)
+
+		assert.Len(t, all.Events(), 3)
+		assert.Len(t, onlyAws.Events(), 1)
+		assert.Len(t, transitions.Events(), 1)
+
+		event := <-onlyAws.Events()
+		assert.Equal(t, "aws", event.Evidence.Title)
+		assert.Equal(t, uint64(1), event.Sequence)
+		assert.False(t, event.Time.IsZero())
+	})
+
+	t.Run("Drops events for full buffers", func(t *testing.T) {
+		b := NewEvidenceBroadcaster()
+		slow := subscribe(b, "", 2)
+		fast := subscribe(b, "", 10)
+
+		for range 5 {
+			b.Publish(EvidenceEvent{Type: EvidenceCreated, Evidence: aws})
+		}
+
+		assert.Len(t, slow.Events(), 2)
+		assert.Len(t, fast.Events(), 5)
+		assert.Equal(t, uint64(3), slow.Dropped())
+		assert.Equal(t, uint64(0), slow.Dropped())
+		assert.Equal(t, uint64(0), fast.Dropped())
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		b := NewEvidenceBroadcaster()
+		subscription := subscribe(b, "", 10)
+		assert.Equal(t, 1, b.Subscribers())
+
+		subscription.Close()
+		subscription.Close()
+		assert.Equal(t, 0, b.Subscribers())
+
+		b.Publish(EvidenceEvent{Type: EvidenceCreated, Evidence: aws})
+		_, ok := <-subscription.Events()
+		assert.False(t, ok)
+	})
+
+	t.Run("Invalid filter", func(t *testing.T) {
+		b := NewEvidenceBroadcaster()
+		_, err := b.Subscribe(labelfilter.Filter{Scope: &labelfilter.Scope{Condition: &labelfilter.Condition{Label: "port", Operator: ">", Value: "many"}}}, 10)
+		assert.Error(t, err)
+		assert.Equal(t, 0, b.Subscribers())
+	})
+}
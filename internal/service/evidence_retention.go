@@ -0,0 +1,375 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/compliance-framework/api/internal/converters/labelfilter"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RetentionTier describes how densely evidence is kept until it reaches MaxAge.
+// A zero MaxAge matches all evidence older than the previous tier, and a zero Resolution keeps every row.
+type RetentionTier struct {
+	MaxAge     time.Duration
+	Resolution time.Duration
+}
+
+// RetentionPolicy is an ordered list of retention tiers, from the youngest evidence to the oldest.
+// Evidence older than the last tier is deleted, unless the last tier has no maximum age.
+// The latest evidence in each stream is never removed, regardless of its age.
+type RetentionPolicy []RetentionTier
+
+// ParseRetentionPolicy parses a policy of comma separated age=resolution tiers, such as "7d=all,90d=1h,*=24h".
+// Ages and resolutions accept Go durations as well as a number of days, and an age of * matches all older evidence.
+// An empty string results in an empty policy, which keeps all evidence.
+func ParseRetentionPolicy(policy string) (RetentionPolicy, error) {
+	result := RetentionPolicy{}
+	policy = strings.TrimSpace(policy)
+	if policy == "" {
+		return result, nil
+	}
+
+	for _, part := range strings.Split(policy, ",") {
+		age, resolution, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid retention tier %q: expected age=resolution", part)
+		}
+
+		tier := RetentionTier{}
+		age = strings.TrimSpace(age)
+		if age != "*" {
+			maxAge, err := parseRetentionDuration(age)
+			if err != nil {
+				return nil, fmt.Errorf("invalid retention tier %q: %w", part, err)
+			}
+			tier.MaxAge = maxAge
+		}
+
+		resolution = strings.TrimSpace(resolution)
+		if resolution != "all" {
+			interval, err := parseRetentionDuration(resolution)
+			if err != nil {
+				return nil, fmt.Errorf("invalid retention tier %q: %w", part, err)
+			}
+			tier.Resolution = interval
+		}
+
+		if len(result) > 0 {
+			previous := result[len(result)-1]
+			if previous.MaxAge == 0 {
+				return nil, fmt.Errorf("invalid retention tier %q: no tiers may follow *", part)
+			}
+			if tier.MaxAge != 0 && tier.MaxAge <= previous.MaxAge {
+				return nil, fmt.Errorf("invalid retention tier %q: ages must be increasing", part)
+			}
+		}
+		result = append(result, tier)
+	}
+
+	return result, nil
+}
+
+func parseRetentionDuration(value string) (time.Duration, error) {
+	duration, err := labelfilter.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if duration <= 0 {
+		return 0, fmt.Errorf("duration %q must be positive", value)
+	}
+	return duration, nil
+}
+
+func formatRetentionDuration(d time.Duration) string {
+	if d%(24*time.Hour) == 0 {
+		return fmt.Sprintf("%dd", d/(24*time.Hour))
+	}
+	formatted := d.String()
+	if strings.HasSuffix(formatted, "m0s") {
+		formatted = strings.TrimSuffix(formatted, "0s")
+	}
+	if strings.HasSuffix(formatted, "h0m") {
+		formatted = strings.TrimSuffix(formatted, "0m")
+	}
+	return formatted
+}
+
+func (t RetentionTier) String() string {
+	age, resolution := "*", "all"
+	if t.MaxAge != 0 {
+		age = formatRetentionDuration(t.MaxAge)
+	}
+	if t.Resolution != 0 {
+		resolution = formatRetentionDuration(t.Resolution)
+	}
+	return age + "=" + resolution
+}
+
+func (p RetentionPolicy) String() string {
+	parts := make([]string, 0, len(p))
+	for _, tier := range p {
+		parts = append(parts, tier.String())
+	}
+	return strings.Join(parts, ",")
+}
+
+// EvidenceCompaction describes an evidence row removed, or which would be removed, by the compactor.
+type EvidenceCompaction struct {
+	ID     uuid.UUID `json:"id"`
+	UUID   uuid.UUID `json:"uuid"`
+	Title  string    `json:"title"`
+	End    time.Time `json:"end"`
+	Reason string    `json:"reason"`
+}
+
+// EvidenceCompactionPlan describes what the compactor removes at a point in time.
+type EvidenceCompactionPlan struct {
+	Evidence []EvidenceCompaction
+	// Sightings is the number of repeated sightings removed from evidence which is itself kept.
+	Sightings int64
+}
+
+// evidenceCompactionBatchSize is the number of evidence rows deleted in each transaction.
+const evidenceCompactionBatchSize = 1000
+
+// blobSweepGracePeriod is how long attachment content is kept before it is swept when no evidence references it, so
+// that content stored for evidence which is still being recorded is not swept.
+const blobSweepGracePeriod = time.Hour
+
+// EvidenceCompactor thins out old evidence according to a RetentionPolicy, and sweeps the attachment content which
+// no evidence references any more from the blob store.
+type EvidenceCompactor struct {
+	db     *gorm.DB
+	sugar  *zap.SugaredLogger
+	policy RetentionPolicy
+	blobs  BlobStore
+}
+
+func NewEvidenceCompactor(db *gorm.DB, sugar *zap.SugaredLogger, policy RetentionPolicy, blobs BlobStore) *EvidenceCompactor {
+	return &EvidenceCompactor{
+		db:     db,
+		sugar:  sugar,
+		policy: policy,
+		blobs:  blobs,
+	}
+}
+
+type retentionCandidates struct {
+	reason string
+	ids    *gorm.DB
+}
+
+// candidates builds a query for each tier of the policy, selecting the ids of rows in table which fall outside of it.
+// Rows are bucketed per partition column, using the time in the given column.
+func (c *EvidenceCompactor) candidates(db *gorm.DB, table string, partition string, column string, now time.Time) []retentionCandidates {
+	results := []retentionCandidates{}
+	var newer time.Duration
+	for _, tier := range c.policy {
+		if tier.Resolution != 0 {
+			window := db.Table(table).
+				Select(fmt.Sprintf(`id, row_number() OVER (PARTITION BY %s, date_bin(make_interval(secs => ?), %s, TIMESTAMPTZ '2000-01-01') ORDER BY %s DESC, id) AS row_rank`, partition, column, column), tier.Resolution.Seconds()).
+				Where(column+" < ?", now.Add(-newer))
+			if tier.MaxAge != 0 {
+				window = window.Where(column+" >= ?", now.Add(-tier.MaxAge))
+			}
+			results = append(results, retentionCandidates{
+				reason: fmt.Sprintf("more than one per %s in tier %s", formatRetentionDuration(tier.Resolution), tier),
+				ids:    db.Table("(?) as ranked", window).Select("ranked.id").Where("ranked.row_rank > 1"),
+			})
+		}
+		newer = tier.MaxAge
+	}
+
+	// Without a final * tier, rows older than the last tier are removed entirely.
+	if newer != 0 {
+		results = append(results, retentionCandidates{
+			reason: fmt.Sprintf("older than %s", formatRetentionDuration(newer)),
+			ids:    db.Table(table).Select("id").Where(column+" < ?", now.Add(-newer)),
+		})
+	}
+	return results
+}
+
+// Plan lists the evidence which the policy would remove at the given time, oldest first, and counts the sightings
+// which would be removed from the remaining evidence.
+// Evidence is aged by the time it was last seen. The latest evidence of each stream, and the latest sighting of
+// each evidence, are always kept.
+func (c *EvidenceCompactor) Plan(ctx context.Context, now time.Time) (*EvidenceCompactionPlan, error) {
+	db := c.db.WithContext(ctx)
+	plan := &EvidenceCompactionPlan{
+		Evidence: []EvidenceCompaction{},
+	}
+
+	latest := db.Table("(?) as latest", relational.GetLatestEvidenceStreamsQuery(db)).Select("latest.id")
+	seen := map[uuid.UUID]bool{}
+	evidence := c.candidates(db, "evidences", "uuid", `coalesce(last_seen_at, "end")`, now)
+	for _, candidates := range evidence {
+		rows := []EvidenceCompaction{}
+		err := db.Model(&relational.Evidence{}).
+			Select(`id, uuid, title, "end"`).
+			Where("id IN (?)", candidates.ids).
+			Where("id NOT IN (?)", latest).
+			Scan(&rows).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to plan evidence compaction: %w", err)
+		}
+		for _, row := range rows {
+			if !seen[row.ID] {
+				seen[row.ID] = true
+				row.Reason = candidates.reason
+				plan.Evidence = append(plan.Evidence, row)
+			}
+		}
+	}
+	slices.SortFunc(plan.Evidence, func(a, b EvidenceCompaction) int {
+		return a.End.Compare(b.End)
+	})
+
+	sightings, err := c.sightingsQuery(db, now)
+	if err != nil {
+		return nil, err
+	}
+	if sightings != nil {
+		// Sightings of evidence which is removed entirely are not counted twice.
+		// The removed evidence is selected again by a subquery, rather than bound by id, as there may be any number of it.
+		if len(evidence) > 0 {
+			removed := db.Model(&relational.Evidence{}).
+				Select("id").
+				Where(anyCandidate(db, evidence)).
+				Where("id NOT IN (?)", latest)
+			sightings = sightings.Where("evidence_id NOT IN (?)", removed)
+		}
+		if err := sightings.Count(&plan.Sightings).Error; err != nil {
+			return nil, fmt.Errorf("failed to plan sighting compaction: %w", err)
+		}
+	}
+
+	return plan, nil
+}
+
+// sightingsQuery selects the sightings which the policy removes, or returns nil if there are none to remove.
+func (c *EvidenceCompactor) sightingsQuery(db *gorm.DB, now time.Time) (*gorm.DB, error) {
+	candidates := c.candidates(db, "evidence_sightings", "evidence_id", `"end"`, now)
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	latest := db.Model(&relational.EvidenceSighting{}).
+		Select("DISTINCT ON (evidence_id) id").
+		Order("evidence_id").
+		Order(`"end" DESC`)
+
+	return db.Model(&relational.EvidenceSighting{}).
+		Where(anyCandidate(db, candidates)).
+		Where("id NOT IN (?)", latest), nil
+}
+
+// anyCandidate is a condition matching the rows selected by any of the candidates, which must not be empty.
+func anyCandidate(db *gorm.DB, candidates []retentionCandidates) *gorm.DB {
+	condition := db.Where("id IN (?)", candidates[0].ids)
+	for _, other := range candidates[1:] {
+		condition = condition.Or("id IN (?)", other.ids)
+	}
+	return condition
+}
+
+// Compact removes the evidence listed by Plan, along with its sightings and its label, subject, component,
+// inventory item, activity and attachment associations. Attachment content is left in the blob store, as it may be
+// shared with other evidence, until it is swept by SweepBlobs. Surplus sightings of the remaining evidence are removed
+// afterwards.
+func (c *EvidenceCompactor) Compact(ctx context.Context, now time.Time) (evidence int, sightings int64, err error) {
+	plan, err := c.Plan(ctx, now)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(plan.Evidence))
+	for _, item := range plan.Evidence {
+		ids = append(ids, item.ID)
+	}
+
+	for batch := range slices.Chunk(ids, evidenceCompactionBatchSize) {
+		err := c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			rows := make([]relational.Evidence, 0, len(batch))
+			for _, id := range batch {
+				rows = append(rows, relational.Evidence{UUIDModel: relational.UUIDModel{ID: &id}})
+			}
+			return tx.Select(clause.Associations).Delete(&rows).Error
+		})
+		if err != nil {
+			return evidence, 0, fmt.Errorf("failed to delete evidence: %w", err)
+		}
+		evidence += len(batch)
+	}
+
+	query, err := c.sightingsQuery(c.db.WithContext(ctx), now)
+	if err != nil || query == nil {
+		return evidence, 0, err
+	}
+	result := c.db.WithContext(ctx).
+		Where("id IN (?)", query.Select("id")).
+		Delete(&relational.EvidenceSighting{})
+	if result.Error != nil {
+		return evidence, 0, fmt.Errorf("failed to delete evidence sightings: %w", result.Error)
+	}
+
+	return evidence, result.RowsAffected, nil
+}
+
+// SweepBlobs deletes the attachment content which no evidence references, such as content left behind by compaction
+// or by evidence which failed to be recorded, and returns the number of blobs deleted. Content stored within the grace
+// period before now is kept, as the evidence referencing it may still be being recorded.
+func (c *EvidenceCompactor) SweepBlobs(ctx context.Context, now time.Time) (int64, error) {
+	return c.blobs.Sweep(ctx, now.Add(-blobSweepGracePeriod), func(keys []string) ([]string, error) {
+		var used []string
+		if err := c.db.WithContext(ctx).
+			Model(&relational.EvidenceAttachment{}).
+			Distinct("blob_key").
+			Where("blob_key IN ?", keys).
+			Pluck("blob_key", &used).Error; err != nil {
+			return nil, fmt.Errorf("failed to find attachments: %w", err)
+		}
+		return used, nil
+	})
+}
+
+// Run compacts evidence and sweeps attachment content immediately, and then at every interval until the context is
+// cancelled. Evidence is only compacted when the policy is not empty, but attachment content is always swept.
+func (c *EvidenceCompactor) Run(ctx context.Context, interval time.Duration) {
+	c.sugar.Infow("Starting evidence compaction", "policy", c.policy.String(), "interval", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		started := time.Now()
+		if len(c.policy) > 0 {
+			evidence, sightings, err := c.Compact(ctx, started)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				c.sugar.Errorw("Failed to compact evidence", "error", err, "evidence", evidence)
+			} else if evidence > 0 || sightings > 0 {
+				c.sugar.Infow("Compacted evidence", "evidence", evidence, "sightings", sightings, "duration", time.Since(started))
+			}
+		}
+		blobs, err := c.SweepBlobs(ctx, started)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			c.sugar.Errorw("Failed to sweep evidence attachments", "error", err, "blobs", blobs)
+		} else if blobs > 0 {
+			c.sugar.Infow("Swept evidence attachments", "blobs", blobs)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
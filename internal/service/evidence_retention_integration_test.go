@@ -0,0 +1,149 @@
+//go:build integration
+
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/compliance-framework/api/internal/tests"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+func TestEvidenceRetention(t *testing.T) {
+	suite.Run(t, new(EvidenceRetentionIntegrationSuite))
+}
+
+type EvidenceRetentionIntegrationSuite struct {
+	tests.IntegrationTestSuite
+}
+
+func (suite *EvidenceRetentionIntegrationSuite) createEvidence(stream uuid.UUID, end time.Time) relational.Evidence {
+	evidence := relational.Evidence{
+		UUID:  stream,
+		Title: "Evidence",
+		Start: end.Add(-time.Minute),
+		End:   end,
+		Labels: []relational.Labels{
+			{Name: "provider", Value: "aws"},
+		},
+	}
+	suite.Require().NoError(suite.DB.Create(&evidence).Error)
+	return evidence
+}
+
+func (suite *EvidenceRetentionIntegrationSuite) TestCompact() {
+	err := suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	stream := uuid.New()
+	dormant := uuid.New()
+
+	// Recent evidence is kept in full.
+	recent := []relational.Evidence{
+		suite.createEvidence(stream, now.Add(-1*time.Hour)),
+		suite.createEvidence(stream, now.Add(-1*time.Hour-10*time.Minute)),
+	}
+	// Within the hourly tier, only the latest in each hour is kept.
+	hourlyKept := suite.createEvidence(stream, time.Date(2025, 5, 20, 10, 50, 0, 0, time.UTC))
+	hourlyRemoved := suite.createEvidence(stream, time.Date(2025, 5, 20, 10, 10, 0, 0, time.UTC))
+	// Beyond the last tier, evidence is removed entirely.
+	expired := suite.createEvidence(stream, now.Add(-100*24*time.Hour))
+	// Except for the latest evidence of a stream, however old it is.
+	dormantLatest := suite.createEvidence(dormant, now.Add(-200*24*time.Hour))
+
+	// Repeated sightings are thinned in the same way, without removing the evidence they belong to.
+	repeated := suite.createEvidence(uuid.New(), time.Date(2025, 5, 10, 9, 5, 0, 0, time.UTC))
+	repeatedSightings := []relational.EvidenceSighting{
+		{EvidenceID: *repeated.ID, End: time.Date(2025, 5, 10, 9, 35, 0, 0, time.UTC)},
+		{EvidenceID: *repeated.ID, End: time.Date(2025, 5, 10, 9, 55, 0, 0, time.UTC)},
+		{EvidenceID: *repeated.ID, End: now.Add(-time.Hour)},
+	}
+	suite.Require().NoError(suite.DB.Create(&repeatedSightings).Error)
+	suite.Require().NoError(suite.DB.Model(&repeated).Update("last_seen_at", now.Add(-time.Hour)).Error)
+
+	policy, err := service.ParseRetentionPolicy("7d=all,90d=1h")
+	suite.Require().NoError(err)
+	logger, _ := zap.NewDevelopment()
+	compactor := service.NewEvidenceCompactor(suite.DB, logger.Sugar(), policy, service.NewDatabaseBlobStore(suite.DB))
+
+	suite.Run("Plan lists removable evidence", func() {
+		plan, err := compactor.Plan(context.Background(), now)
+		suite.Require().NoError(err)
+		suite.Require().Len(plan.Evidence, 2)
+		suite.Equal(*expired.ID, plan.Evidence[0].ID)
+		suite.Equal(*hourlyRemoved.ID, plan.Evidence[1].ID)
+		// The initial sighting and the first repeat share an hour with the second repeat.
+		suite.Equal(int64(2), plan.Sightings)
+
+		var count int64
+		suite.DB.Model(&relational.Evidence{}).Count(&count)
+		suite.Equal(int64(7), count)
+	})
+
+	suite.Run("Compact removes evidence and associations", func() {
+		evidence, sightings, err := compactor.Compact(context.Background(), now)
+		suite.Require().NoError(err)
+		suite.Equal(2, evidence)
+		suite.Equal(int64(2), sightings)
+
+		var remaining []uuid.UUID
+		suite.Require().NoError(suite.DB.Model(&relational.Evidence{}).Pluck("id", &remaining).Error)
+		suite.ElementsMatch([]uuid.UUID{*recent[0].ID, *recent[1].ID, *hourlyKept.ID, *dormantLatest.ID, *repeated.ID}, remaining)
+
+		var labels int64
+		suite.DB.Table("evidence_labels").Where("evidence_id IN ?", []uuid.UUID{*expired.ID, *hourlyRemoved.ID}).Count(&labels)
+		suite.Equal(int64(0), labels)
+
+		var orphaned int64
+		suite.DB.Model(&relational.EvidenceSighting{}).Where("evidence_id IN ?", []uuid.UUID{*expired.ID, *hourlyRemoved.ID}).Count(&orphaned)
+		suite.Equal(int64(0), orphaned)
+
+		var remainingSightings []relational.EvidenceSighting
+		suite.Require().NoError(suite.DB.Order(`"end"`).Find(&remainingSightings, "evidence_id = ?", repeated.ID).Error)
+		suite.Require().Len(remainingSightings, 2)
+		suite.Equal(repeatedSightings[1].ID, remainingSightings[0].ID)
+		suite.Equal(repeatedSightings[2].ID, remainingSightings[1].ID)
+	})
+}
+
+func (suite *EvidenceRetentionIntegrationSuite) TestSweepBlobs() {
+	suite.Require().NoError(suite.Migrator.Refresh())
+	ctx := context.Background()
+	store := service.NewDatabaseBlobStore(suite.DB)
+	logger, _ := zap.NewDevelopment()
+	compactor := service.NewEvidenceCompactor(suite.DB, logger.Sugar(), service.RetentionPolicy{}, store)
+
+	used, err := store.Put(ctx, strings.NewReader("used"))
+	suite.Require().NoError(err)
+	unused, err := store.Put(ctx, strings.NewReader("unused"))
+	suite.Require().NoError(err)
+
+	evidence := suite.createEvidence(uuid.New(), time.Now())
+	suite.Require().NoError(suite.DB.Create(&relational.EvidenceAttachment{
+		EvidenceID: *evidence.ID,
+		Name:       "output.json",
+		BlobKey:    used.Key,
+	}).Error)
+
+	deleted, err := compactor.SweepBlobs(ctx, time.Now())
+	suite.Require().NoError(err)
+	suite.Zero(deleted, "Expected blobs stored within the grace period to be kept")
+
+	deleted, err = compactor.SweepBlobs(ctx, time.Now().Add(2*time.Hour))
+	suite.Require().NoError(err)
+	suite.EqualValues(1, deleted)
+
+	_, err = store.Get(ctx, unused.Key)
+	suite.ErrorIs(err, service.ErrBlobNotFound)
+	content, err := store.Get(ctx, used.Key)
+	suite.Require().NoError(err)
+	content.Close()
+}
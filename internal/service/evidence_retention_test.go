@@ -0,0 +1,57 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRetentionPolicy(t *testing.T) {
+	day := 24 * time.Hour
+
+	t.Run("Tiers", func(t *testing.T) {
+		policy, err := ParseRetentionPolicy("7d=all, 90d=1h, *=24h")
+		require.NoError(t, err)
+		assert.Equal(t, RetentionPolicy{
+			{MaxAge: 7 * day},
+			{MaxAge: 90 * day, Resolution: time.Hour},
+			{Resolution: day},
+		}, policy)
+		assert.Equal(t, "7d=all,90d=1h,*=1d", policy.String())
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		policy, err := ParseRetentionPolicy("  ")
+		require.NoError(t, err)
+		assert.Empty(t, policy)
+	})
+
+	t.Run("Expiry", func(t *testing.T) {
+		policy, err := ParseRetentionPolicy("36h=all,30d=15m")
+		require.NoError(t, err)
+		assert.Equal(t, RetentionPolicy{
+			{MaxAge: 36 * time.Hour},
+			{MaxAge: 30 * day, Resolution: 15 * time.Minute},
+		}, policy)
+		assert.Equal(t, "36h=all,30d=15m", policy.String())
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, policy := range []string{
+			"7d",
+			"7d=",
+			"x=all",
+			"7x=all",
+			"7d=0s",
+			"-1d=all",
+			"7d=all,7d=1h",
+			"30d=all,7d=1h",
+			"*=1h,7d=all",
+		} {
+			_, err := ParseRetentionPolicy(policy)
+			assert.Error(t, err, policy)
+		}
+	})
+}
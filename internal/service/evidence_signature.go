@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/compliance-framework/api/internal/provenance"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EvidenceVerificationResult is the outcome of verifying the signature of a piece of evidence.
+type EvidenceVerificationResult struct {
+	Verification relational.EvidenceVerification
+	// KeyID is the key the evidence was signed with, when it was verified.
+	KeyID *uuid.UUID
+	// Reason explains why evidence is unsigned or invalid.
+	Reason error
+}
+
+// EvidenceVerifier checks the signatures of evidence against the signing keys registered for agents.
+type EvidenceVerifier struct {
+	db *gorm.DB
+}
+
+func NewEvidenceVerifier(db *gorm.DB) *EvidenceVerifier {
+	return &EvidenceVerifier{db: db}
+}
+
+// Verify checks the signature sent with the JSON document of a piece of evidence. Evidence is only verified when it
+// is signed with an active key registered for one of the agents, the actor UUIDs in its origins. An error is only
+// returned when the signing key cannot be loaded.
+func (v *EvidenceVerifier) Verify(ctx context.Context, document []byte, signature *provenance.Signature, agents []uuid.UUID, now time.Time) (*EvidenceVerificationResult, error) {
+	if signature == nil {
+		return &EvidenceVerificationResult{
+			Verification: relational.EvidenceVerificationUnsigned,
+			Reason:       errors.New("evidence is not signed"),
+		}, nil
+	}
+	invalid := func(reason error) (*EvidenceVerificationResult, error) {
+		return &EvidenceVerificationResult{Verification: relational.EvidenceVerificationInvalid, Reason: reason}, nil
+	}
+
+	key := &relational.AgentSigningKey{}
+	err := v.db.WithContext(ctx).First(key, "id = ?", signature.KeyID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return invalid(fmt.Errorf("unknown signing key %s", signature.KeyID))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load signing key: %w", err)
+	}
+	if !key.IsActive(now) {
+		return invalid(fmt.Errorf("signing key %s has been revoked", key.ID))
+	}
+	if !slices.Contains(agents, key.AgentUUID) {
+		return invalid(fmt.Errorf("signing key %s belongs to agent %s, which is not among the origins of the evidence", key.ID, key.AgentUUID))
+	}
+
+	public, err := provenance.ParsePublicKey(key.PublicKey)
+	if err != nil {
+		return invalid(fmt.Errorf("signing key %s is unusable: %w", key.ID, err))
+	}
+	if err := provenance.Verify(document, *signature, public); err != nil {
+		return invalid(err)
+	}
+	return &EvidenceVerificationResult{
+		Verification: relational.EvidenceVerificationVerified,
+		KeyID:        key.ID,
+	}, nil
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// ParseHeartbeatRetentionPolicy parses a retention policy for heartbeats, in the format of ParseRetentionPolicy,
+// such as "1d=all,30d=10m,365d=1h". Raw heartbeats are kept for the age of the leading tiers which keep every row.
+// Each following tier keeps a rollup of the heartbeats at its resolution until its age, so resolutions must be
+// increasing, and every tier keeping all rows must come first.
+func ParseHeartbeatRetentionPolicy(policy string) (RetentionPolicy, error) {
+	result, err := ParseRetentionPolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	var previous time.Duration
+	for _, tier := range result {
+		if tier.Resolution == 0 {
+			if previous != 0 {
+				return nil, fmt.Errorf("invalid retention tier %q: tiers keeping all heartbeats must come first", tier)
+			}
+			continue
+		}
+		if tier.Resolution < MinHeartbeatBucket || tier.Resolution%time.Second != 0 {
+			return nil, fmt.Errorf("invalid retention tier %q: resolutions must be whole seconds of at least %s", tier, formatRetentionDuration(MinHeartbeatBucket))
+		}
+		if tier.Resolution <= previous {
+			return nil, fmt.Errorf("invalid retention tier %q: resolutions must be increasing", tier)
+		}
+		previous = tier.Resolution
+	}
+	return result, nil
+}
+
+// HeartbeatCompactor downsamples old heartbeats into rollups, and prunes heartbeats and rollups according to a
+// policy parsed by ParseHeartbeatRetentionPolicy.
+type HeartbeatCompactor struct {
+	db     *gorm.DB
+	sugar  *zap.SugaredLogger
+	policy RetentionPolicy
+}
+
+func NewHeartbeatCompactor(db *gorm.DB, sugar *zap.SugaredLogger, policy RetentionPolicy) *HeartbeatCompactor {
+	return &HeartbeatCompactor{
+		db:     db,
+		sugar:  sugar,
+		policy: policy,
+	}
+}
+
+// rawRetention returns how long raw heartbeats are kept, and whether they are kept forever.
+func (c *HeartbeatCompactor) rawRetention() (time.Duration, bool) {
+	var age time.Duration
+	for _, tier := range c.policy {
+		if tier.Resolution != 0 {
+			break
+		}
+		if tier.MaxAge == 0 {
+			return 0, true
+		}
+		age = tier.MaxAge
+	}
+	return age, false
+}
+
+// rollups returns the tiers of the policy kept as rollups.
+func (c *HeartbeatCompactor) rollups() []RetentionTier {
+	tiers := []RetentionTier{}
+	for _, tier := range c.policy {
+		if tier.Resolution != 0 {
+			tiers = append(tiers, tier)
+		}
+	}
+	return tiers
+}
+
+// rollup adds the buckets of the given resolution which have ended by now, and which have not been rolled up yet,
+// to the rollups. It returns the number of rollups added, and the time until which heartbeats are rolled up.
+// Heartbeats arriving for a bucket after it was rolled up are only counted while raw heartbeats are kept.
+func (c *HeartbeatCompactor) rollup(db *gorm.DB, resolution time.Duration, now time.Time) (int64, time.Time, error) {
+	seconds := int64(resolution / time.Second)
+	until := HeartbeatBucket(now, resolution)
+
+	var latest sql.NullTime
+	if err := db.Model(&HeartbeatRollup{}).
+		Select("max(bucket)").
+		Where("resolution = ?", seconds).
+		Scan(&latest).Error; err != nil {
+		return 0, until, fmt.Errorf("failed to find the latest heartbeat rollup: %w", err)
+	}
+
+	heartbeats := db.Model(&Heartbeat{}).
+		Select("CAST(? AS bigint), date_bin(make_interval(secs => ?), created_at, ?) AS bucket, uuid, count(*)", seconds, seconds, HeartbeatBucketOrigin).
+		Where("created_at < ?", until).
+		Group("bucket, uuid")
+	if latest.Valid {
+		from := latest.Time.Add(resolution)
+		if !from.Before(until) {
+			return 0, until, nil
+		}
+		heartbeats = heartbeats.Where("created_at >= ?", from)
+	}
+
+	result := db.Exec(`
+		INSERT INTO heartbeat_rollups (resolution, bucket, uuid, count) ?
+		ON CONFLICT (resolution, bucket, uuid) DO UPDATE SET count = excluded.count
+	`, heartbeats)
+	if result.Error != nil {
+		return 0, until, fmt.Errorf("failed to roll up heartbeats: %w", result.Error)
+	}
+	return result.RowsAffected, until, nil
+}
+
+// Compact rolls up heartbeats at each resolution of the policy, and then removes raw heartbeats and rollups which
+// are older than the policy keeps them. Raw heartbeats are only removed once they have been rolled up. It returns
+// the number of rollups added, and the number of heartbeats and rollups removed.
+func (c *HeartbeatCompactor) Compact(ctx context.Context, now time.Time) (rollups int64, pruned int64, err error) {
+	db := c.db.WithContext(ctx)
+
+	rawAge, keepRaw := c.rawRetention()
+	cutoff := now.Add(-rawAge)
+	for _, tier := range c.rollups() {
+		added, until, err := c.rollup(db, tier.Resolution, now)
+		if err != nil {
+			return rollups, pruned, err
+		}
+		rollups += added
+		if until.Before(cutoff) {
+			cutoff = until
+		}
+	}
+
+	if !keepRaw {
+		result := db.Where("created_at < ?", cutoff).Delete(&Heartbeat{})
+		if result.Error != nil {
+			return rollups, pruned, fmt.Errorf("failed to delete heartbeats: %w", result.Error)
+		}
+		pruned += result.RowsAffected
+	}
+
+	for _, tier := range c.rollups() {
+		if tier.MaxAge == 0 {
+			continue
+		}
+		result := db.
+			Where("resolution = ?", int64(tier.Resolution/time.Second)).
+			Where("bucket < ?", now.Add(-tier.MaxAge)).
+			Delete(&HeartbeatRollup{})
+		if result.Error != nil {
+			return rollups, pruned, fmt.Errorf("failed to delete heartbeat rollups: %w", result.Error)
+		}
+		pruned += result.RowsAffected
+	}
+
+	return rollups, pruned, nil
+}
+
+// Run compacts heartbeats immediately, and then at every interval until the context is cancelled.
+func (c *HeartbeatCompactor) Run(ctx context.Context, interval time.Duration) {
+	if len(c.policy) == 0 {
+		return
+	}
+
+	c.sugar.Infow("Starting heartbeat compaction", "policy", c.policy.String(), "interval", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		started := time.Now()
+		rollups, pruned, err := c.Compact(ctx, started)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			c.sugar.Errorw("Failed to compact heartbeats", "error", err)
+		} else if rollups > 0 || pruned > 0 {
+			c.sugar.Infow("Compacted heartbeats", "rollups", rollups, "pruned", pruned, "duration", time.Since(started))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
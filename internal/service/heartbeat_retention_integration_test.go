@@ -0,0 +1,123 @@
+//go:build integration
+
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/tests"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+func TestHeartbeatRetention(t *testing.T) {
+	suite.Run(t, new(HeartbeatRetentionIntegrationSuite))
+}
+
+type HeartbeatRetentionIntegrationSuite struct {
+	tests.IntegrationTestSuite
+}
+
+func (suite *HeartbeatRetentionIntegrationSuite) createHeartbeats(agent uuid.UUID, times ...time.Time) {
+	for _, at := range times {
+		suite.Require().NoError(suite.DB.Create(&service.Heartbeat{UUID: agent, CreatedAt: at}).Error)
+	}
+}
+
+func (suite *HeartbeatRetentionIntegrationSuite) overTime(bucket string, from time.Time, to time.Time) []service.HeartbeatInterval {
+	window, err := service.ParseHeartbeatWindow(bucket, from.Format(time.RFC3339), to.Format(time.RFC3339), to)
+	suite.Require().NoError(err)
+	intervals := []service.HeartbeatInterval{}
+	suite.Require().NoError(service.GetHeartbeatOverTimeQuery(suite.DB, *window).Scan(&intervals).Error)
+	for i := range intervals {
+		intervals[i].Interval = intervals[i].Interval.UTC()
+	}
+	return intervals
+}
+
+func (suite *HeartbeatRetentionIntegrationSuite) TestCompact() {
+	err := suite.Migrator.Refresh()
+	suite.Require().NoError(err)
+
+	now := time.Date(2025, 6, 1, 12, 5, 0, 0, time.UTC)
+	first := uuid.New()
+	second := uuid.New()
+
+	// Two agents sending heartbeats two days ago, which are rolled up and pruned.
+	old := time.Date(2025, 5, 30, 10, 0, 0, 0, time.UTC)
+	suite.createHeartbeats(first, old, old.Add(5*time.Minute), old.Add(15*time.Minute), old.Add(59*time.Minute))
+	suite.createHeartbeats(second, old.Add(time.Minute))
+	// Recent heartbeats are kept, and only rolled up once their bucket has ended.
+	suite.createHeartbeats(first, now.Add(-10*time.Minute), now.Add(-time.Minute))
+	// Heartbeats beyond the last tier are removed along with their rollups.
+	suite.createHeartbeats(second, now.Add(-10*24*time.Hour))
+
+	policy, err := service.ParseHeartbeatRetentionPolicy("1d=all,3d=10m,7d=1h")
+	suite.Require().NoError(err)
+	logger, _ := zap.NewDevelopment()
+	compactor := service.NewHeartbeatCompactor(suite.DB, logger.Sugar(), policy)
+
+	before := suite.overTime("1h", old.Add(-time.Hour), now)
+
+	suite.Run("Compact rolls up and prunes heartbeats", func() {
+		rollups, pruned, err := compactor.Compact(context.Background(), now)
+		suite.Require().NoError(err)
+
+		// 10 minute rollups: 3 for the first agent and 1 for the second two days ago, 1 ten minutes ago, and
+		// 1 ten days ago. Hourly rollups: 2 two days ago, 1 ten minutes ago and 1 ten days ago.
+		suite.Equal(int64(10), rollups)
+		// 6 heartbeats older than a day, and the 2 rollups of ten days ago.
+		suite.Equal(int64(8), pruned)
+
+		var heartbeats []time.Time
+		suite.Require().NoError(suite.DB.Model(&service.Heartbeat{}).Order("created_at").Pluck("created_at", &heartbeats).Error)
+		suite.Require().Len(heartbeats, 2)
+		suite.True(now.Add(-10 * time.Minute).Equal(heartbeats[0]))
+
+		var buckets []time.Time
+		suite.Require().NoError(suite.DB.Model(&service.HeartbeatRollup{}).
+			Where("resolution = ? AND uuid = ?", 600, first).
+			Order("bucket").
+			Pluck("bucket", &buckets).Error)
+		suite.Require().Len(buckets, 4)
+		suite.True(old.Equal(buckets[0]))
+		suite.True(old.Add(50 * time.Minute).Equal(buckets[2]))
+		// The bucket in progress is not rolled up.
+		suite.True(time.Date(2025, 6, 1, 11, 50, 0, 0, time.UTC).Equal(buckets[3]))
+
+		var count int64
+		suite.Require().NoError(suite.DB.Model(&service.HeartbeatRollup{}).
+			Select("count").
+			Where("resolution = ? AND uuid = ? AND bucket = ?", 3600, first, old).
+			Scan(&count).Error)
+		suite.Equal(int64(4), count)
+	})
+
+	suite.Run("Pruned heartbeats are counted from rollups", func() {
+		suite.Equal(before, suite.overTime("1h", old.Add(-time.Hour), now))
+		suite.Equal([]service.HeartbeatInterval{
+			{Interval: old, Total: 2},
+			{Interval: old.Add(10 * time.Minute), Total: 1},
+			{Interval: old.Add(50 * time.Minute), Total: 1},
+		}, suite.overTime("10m", old, old.Add(time.Hour)))
+
+		// Buckets which the rollups do not fit into are only counted while raw heartbeats are kept.
+		suite.Empty(suite.overTime("15m", old, old.Add(time.Hour)))
+	})
+
+	suite.Run("Compact is incremental", func() {
+		rollups, pruned, err := compactor.Compact(context.Background(), now)
+		suite.Require().NoError(err)
+		suite.Zero(rollups)
+		suite.Zero(pruned)
+
+		rollups, _, err = compactor.Compact(context.Background(), now.Add(10*time.Minute))
+		suite.Require().NoError(err)
+		// The 10 minute bucket which was in progress.
+		suite.Equal(int64(1), rollups)
+	})
+}
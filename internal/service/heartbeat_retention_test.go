@@ -0,0 +1,56 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHeartbeatRetentionPolicy(t *testing.T) {
+	day := 24 * time.Hour
+
+	t.Run("Raw heartbeats and rollups", func(t *testing.T) {
+		policy, err := ParseHeartbeatRetentionPolicy("1d=all,30d=10m,365d=1h")
+		require.NoError(t, err)
+		compactor := NewHeartbeatCompactor(nil, nil, policy)
+
+		age, forever := compactor.rawRetention()
+		assert.Equal(t, day, age)
+		assert.False(t, forever)
+		assert.Equal(t, []RetentionTier{
+			{MaxAge: 30 * day, Resolution: 10 * time.Minute},
+			{MaxAge: 365 * day, Resolution: time.Hour},
+		}, compactor.rollups())
+	})
+
+	t.Run("Rollups only", func(t *testing.T) {
+		policy, err := ParseHeartbeatRetentionPolicy("30d=5m,*=1d")
+		require.NoError(t, err)
+		age, forever := NewHeartbeatCompactor(nil, nil, policy).rawRetention()
+		assert.Zero(t, age)
+		assert.False(t, forever)
+	})
+
+	t.Run("Keep all", func(t *testing.T) {
+		policy, err := ParseHeartbeatRetentionPolicy("*=all")
+		require.NoError(t, err)
+		_, forever := NewHeartbeatCompactor(nil, nil, policy).rawRetention()
+		assert.True(t, forever)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, policy := range []string{
+			"7d=1h,30d=all",
+			"7d=1h,30d=10m",
+			"7d=1h,30d=1h",
+			"7d=30s",
+			"7d=90500ms",
+			"7d",
+		} {
+			_, err := ParseHeartbeatRetentionPolicy(policy)
+			assert.Error(t, err, policy)
+		}
+	})
+}
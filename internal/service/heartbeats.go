@@ -1,10 +1,13 @@
 package service
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/compliance-framework/api/internal/converters/labelfilter"
 	"github.com/compliance-framework/api/internal/service/relational"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type Heartbeat struct {
@@ -13,3 +16,140 @@ type Heartbeat struct {
 	UUID      uuid.UUID `gorm:"index"`
 	CreatedAt time.Time `gorm:"index"`
 }
+
+// HeartbeatRollup counts the heartbeats an agent sent within a bucket, once raw heartbeats have been downsampled.
+// Rollups are kept for each resolution of the heartbeat retention policy.
+type HeartbeatRollup struct {
+	// Resolution is the size of the bucket, in seconds.
+	Resolution int64     `gorm:"primaryKey;autoIncrement:false"`
+	Bucket     time.Time `gorm:"primaryKey"`
+	UUID       uuid.UUID `gorm:"primaryKey"`
+	Count      int64     `gorm:"not null"`
+}
+
+// HeartbeatBucketOrigin is the time heartbeat buckets are aligned to, so that buckets of the same size always
+// start at the same times, and smaller buckets fit exactly into larger ones which are a multiple of their size.
+var HeartbeatBucketOrigin = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// HeartbeatBucket returns the start of the bucket of the given size containing t. A time on the boundary between
+// two buckets starts the later one. Buckets are aligned as date_bin aligns them in the database when given
+// HeartbeatBucketOrigin.
+func HeartbeatBucket(t time.Time, size time.Duration) time.Time {
+	offset := t.Sub(HeartbeatBucketOrigin) % size
+	if offset < 0 {
+		offset += size
+	}
+	return t.Add(-offset)
+}
+
+const (
+	// DefaultHeartbeatBucket is the bucket size heartbeats are counted in when none is requested.
+	DefaultHeartbeatBucket = 2 * time.Minute
+	// DefaultHeartbeatRange is how far back heartbeats are counted when no start time is requested.
+	DefaultHeartbeatRange = 24 * time.Hour
+	// MinHeartbeatBucket is the smallest bucket heartbeats may be counted in.
+	MinHeartbeatBucket = time.Minute
+	// MaxHeartbeatBuckets is the largest number of buckets a time range may be divided into.
+	MaxHeartbeatBuckets = 10000
+)
+
+// HeartbeatWindow is a time range heartbeats are counted over, in buckets of a fixed size.
+type HeartbeatWindow struct {
+	From   time.Time
+	To     time.Time
+	Bucket time.Duration
+}
+
+// ParseHeartbeatWindow reads a bucket size, such as 5m or 1d, and a time range whose ends are either RFC3339
+// timestamps or durations before now. Each may be empty, in which case heartbeats of the last
+// DefaultHeartbeatRange are counted in buckets of DefaultHeartbeatBucket.
+func ParseHeartbeatWindow(bucket string, from string, to string, now time.Time) (*HeartbeatWindow, error) {
+	window := &HeartbeatWindow{
+		To:     now,
+		Bucket: DefaultHeartbeatBucket,
+	}
+	if bucket != "" {
+		size, err := parseRetentionDuration(bucket)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bucket parameter: %w", err)
+		}
+		window.Bucket = size
+	}
+	if to != "" {
+		at, err := labelfilter.ResolveTime(to, now)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to parameter: %w", err)
+		}
+		window.To = at
+	}
+	window.From = window.To.Add(-DefaultHeartbeatRange)
+	if from != "" {
+		at, err := labelfilter.ResolveTime(from, now)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from parameter: %w", err)
+		}
+		window.From = at
+	}
+
+	if err := window.Validate(); err != nil {
+		return nil, err
+	}
+	return window, nil
+}
+
+// Validate checks the bucket size is a whole number of seconds of at least MinHeartbeatBucket, and that the time
+// range is divided into no more than MaxHeartbeatBuckets buckets.
+func (w *HeartbeatWindow) Validate() error {
+	if w.Bucket < MinHeartbeatBucket {
+		return fmt.Errorf("bucket must be at least %s", formatRetentionDuration(MinHeartbeatBucket))
+	}
+	if w.Bucket%time.Second != 0 {
+		return fmt.Errorf("bucket must be a whole number of seconds")
+	}
+	if !w.From.Before(w.To) {
+		return fmt.Errorf("from must be before to")
+	}
+	if buckets := w.Buckets(); buckets > MaxHeartbeatBuckets {
+		return fmt.Errorf("time range spans %d buckets of %s, at most %d are allowed", buckets, formatRetentionDuration(w.Bucket), MaxHeartbeatBuckets)
+	}
+	return nil
+}
+
+// Start returns the start of the first bucket, which begins at or before From.
+func (w *HeartbeatWindow) Start() time.Time {
+	return HeartbeatBucket(w.From, w.Bucket)
+}
+
+// Buckets returns the number of buckets overlapping the time range, including partial buckets at either end.
+func (w *HeartbeatWindow) Buckets() int64 {
+	return int64((w.To.Sub(w.Start()) + w.Bucket - 1) / w.Bucket)
+}
+
+// HeartbeatInterval is the number of agents which sent heartbeats within a bucket.
+type HeartbeatInterval struct {
+	Interval time.Time `json:"interval"`
+	Total    int64     `json:"total"`
+}
+
+// GetHeartbeatOverTimeQuery counts the distinct agents which sent heartbeats within each bucket of the window,
+// oldest first. Buckets without heartbeats are left out. Rollups whose resolution divides the bucket size are
+// counted alongside raw heartbeats, so that buckets remain complete once raw heartbeats have been pruned; as agents
+// are counted once per bucket, heartbeats which are also rolled up are not counted twice.
+// Intervals are scanned into HeartbeatInterval.
+func GetHeartbeatOverTimeQuery(db *gorm.DB, window HeartbeatWindow) *gorm.DB {
+	start := window.Start()
+	seconds := int64(window.Bucket / time.Second)
+
+	raw := db.Model(&Heartbeat{}).
+		Select("uuid, created_at AS at").
+		Where("created_at >= ? AND created_at < ?", start, window.To)
+	rollups := db.Model(&HeartbeatRollup{}).
+		Select("uuid, bucket AS at").
+		Where("? % resolution = 0", seconds).
+		Where("bucket >= ? AND bucket < ?", start, window.To)
+
+	return db.Table("(? UNION ALL ?) AS s", raw, rollups).
+		Select(`date_bin(make_interval(secs => ?), s.at, ?) AS "interval", count(DISTINCT s.uuid) AS total`, seconds, HeartbeatBucketOrigin).
+		Group(`"interval"`).
+		Order(`"interval"`)
+}
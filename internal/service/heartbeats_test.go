@@ -0,0 +1,111 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeartbeatBucket(t *testing.T) {
+	at := func(hour, minute, second int) time.Time {
+		return time.Date(2025, 6, 1, hour, minute, second, 0, time.UTC)
+	}
+
+	t.Run("Times within a bucket start it", func(t *testing.T) {
+		assert.Equal(t, at(12, 0, 0), HeartbeatBucket(at(12, 1, 59), 2*time.Minute))
+		assert.Equal(t, at(12, 0, 0), HeartbeatBucket(at(12, 59, 59), time.Hour))
+	})
+
+	t.Run("Times on a boundary start the later bucket", func(t *testing.T) {
+		assert.Equal(t, at(12, 2, 0), HeartbeatBucket(at(12, 2, 0), 2*time.Minute))
+		assert.Equal(t, at(12, 0, 0), HeartbeatBucket(at(12, 2, 0).Add(-time.Nanosecond), 2*time.Minute))
+		assert.Equal(t, at(0, 0, 0), HeartbeatBucket(at(0, 0, 0), 24*time.Hour))
+	})
+
+	t.Run("Buckets are aligned to the origin rather than the hour", func(t *testing.T) {
+		// 2025-06-01 is 9283 days, or 13367520 minutes, after the origin: 1 day more than a multiple of a week, and
+		// 12:00 is 4 minutes more than a multiple of 7 minutes.
+		assert.Equal(t, at(0, 0, 0).Add(-24*time.Hour), HeartbeatBucket(at(12, 0, 0), 7*24*time.Hour))
+		assert.Equal(t, at(11, 56, 0), HeartbeatBucket(at(12, 0, 0), 7*time.Minute))
+	})
+
+	t.Run("Smaller buckets fit into larger ones", func(t *testing.T) {
+		heartbeat := at(17, 43, 12)
+		assert.Equal(t, HeartbeatBucket(heartbeat, 2*time.Hour), HeartbeatBucket(HeartbeatBucket(heartbeat, 10*time.Minute), 2*time.Hour))
+	})
+
+	t.Run("Times before the origin", func(t *testing.T) {
+		before := HeartbeatBucketOrigin.Add(-90 * time.Second)
+		assert.Equal(t, HeartbeatBucketOrigin.Add(-2*time.Minute), HeartbeatBucket(before, time.Minute))
+		assert.Equal(t, HeartbeatBucketOrigin.Add(-time.Minute), HeartbeatBucket(HeartbeatBucketOrigin.Add(-time.Minute), time.Minute))
+	})
+
+	t.Run("Time zones do not move buckets", func(t *testing.T) {
+		zone := time.FixedZone("UTC+5:30", 5*60*60+30*60)
+		bucket := HeartbeatBucket(at(12, 0, 0).In(zone), 24*time.Hour)
+		assert.True(t, at(0, 0, 0).Equal(bucket))
+	})
+}
+
+func TestParseHeartbeatWindow(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 1, 30, 0, time.UTC)
+
+	t.Run("Defaults", func(t *testing.T) {
+		window, err := ParseHeartbeatWindow("", "", "", now)
+		require.NoError(t, err)
+		assert.Equal(t, DefaultHeartbeatBucket, window.Bucket)
+		assert.Equal(t, now, window.To)
+		assert.Equal(t, now.Add(-DefaultHeartbeatRange), window.From)
+		assert.Equal(t, time.Date(2025, 5, 31, 12, 0, 0, 0, time.UTC), window.Start())
+		// 24 hours of 2 minute buckets, plus the partial bucket the range starts in.
+		assert.Equal(t, int64(721), window.Buckets())
+	})
+
+	t.Run("Range", func(t *testing.T) {
+		window, err := ParseHeartbeatWindow("1d", "7d", "2025-06-01T00:00:00Z", now)
+		require.NoError(t, err)
+		assert.Equal(t, 24*time.Hour, window.Bucket)
+		assert.Equal(t, now.Add(-7*24*time.Hour), window.From)
+		assert.Equal(t, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), window.To)
+		assert.Equal(t, time.Date(2025, 5, 25, 0, 0, 0, 0, time.UTC), window.Start())
+		assert.Equal(t, int64(7), window.Buckets())
+	})
+
+	t.Run("Ranges ending on a boundary do not start another bucket", func(t *testing.T) {
+		window, err := ParseHeartbeatWindow("1h", "2025-06-01T10:00:00Z", "2025-06-01T12:00:00Z", now)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), window.Buckets())
+
+		window, err = ParseHeartbeatWindow("1h", "2025-06-01T10:00:00Z", "2025-06-01T12:00:01Z", now)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), window.Buckets())
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, params := range [][3]string{
+			{"fortnight", "", ""},
+			{"-5m", "", ""},
+			{"30s", "", ""},
+			{"90500ms", "", ""},
+			{"", "yesterday", ""},
+			{"", "", "tomorrow"},
+			{"", "1h", "2h"},
+			{"", "1h", "1h"},
+			{"1m", "30d", ""},
+		} {
+			_, err := ParseHeartbeatWindow(params[0], params[1], params[2], now)
+			assert.Error(t, err, params)
+		}
+	})
+
+	t.Run("Largest range", func(t *testing.T) {
+		window, err := ParseHeartbeatWindow("1m", "2025-06-01T00:00:00Z", "2025-06-07T22:40:00Z", now)
+		require.NoError(t, err)
+		assert.Equal(t, int64(MaxHeartbeatBuckets), window.Buckets())
+
+		_, err = ParseHeartbeatWindow("1m", "2025-06-01T00:00:00Z", "2025-06-07T22:40:01Z", now)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/compliance-framework/api/internal/config"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AccountLockout locks users after too many consecutive failed logins, and unlocks them once the lockout duration
+// has passed. Every lock and unlock is recorded as an audit event.
+type AccountLockout struct {
+	db          *gorm.DB
+	maxFailures int
+	duration    time.Duration
+}
+
+func NewAccountLockout(db *gorm.DB, config *config.Config) *AccountLockout {
+	return &AccountLockout{
+		db:          db,
+		maxFailures: config.LoginMaxFailures,
+		duration:    config.LoginLockoutDuration,
+	}
+}
+
+// IsLocked reports whether the user is locked at the given time. Users whose lockout has expired are unlocked first.
+func (l *AccountLockout) IsLocked(user *relational.User, now time.Time) (bool, error) {
+	if !user.IsLocked {
+		return false, nil
+	}
+	if !user.LockExpired(now, l.duration) {
+		return true, nil
+	}
+	if err := l.Unlock(user, relational.AuditSystemActor); err != nil {
+		return true, err
+	}
+	return false, nil
+}
+
+// RecordFailure counts a failed login of the user from the IP address, and locks the user once they have failed the
+// maximum number of times in a row. It reports whether the failure locked the user.
+func (l *AccountLockout) RecordFailure(user *relational.User, ip string, now time.Time) (bool, error) {
+	var locked bool
+	err := l.db.Transaction(func(tx *gorm.DB) error {
+		// Concurrent failures are counted one after the other, so that the user is locked exactly once.
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("failed_logins", "is_locked", "locked_at").
+			First(user, "id = ?", user.ID).Error; err != nil {
+			return err
+		}
+
+		user.FailedLogins++
+		updates := map[string]any{"failed_logins": user.FailedLogins}
+		if l.maxFailures > 0 && user.FailedLogins >= l.maxFailures && !user.IsLocked {
+			locked = true
+			user.IsLocked = true
+			user.LockedAt = &now
+			updates["is_locked"] = true
+			updates["locked_at"] = now
+		}
+		if err := tx.Model(&relational.User{}).Where("id = ?", user.ID).Updates(updates).Error; err != nil {
+			return err
+		}
+		if !locked {
+			return nil
+		}
+		return recordAuditEvent(tx, relational.AuditEventUserLocked, relational.AuditSystemActor, user, ip, map[string]any{
+			"failedLogins": user.FailedLogins,
+		})
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to record failed login: %w", err)
+	}
+	return locked, nil
+}
+
+// RecordSuccess resets the failed logins of the user, and records when they logged in.
+func (l *AccountLockout) RecordSuccess(user *relational.User, now time.Time) error {
+	user.FailedLogins = 0
+	user.LastLogin = &now
+	return l.db.Model(&relational.User{}).Where("id = ?", user.ID).Updates(map[string]any{
+		"failed_logins": 0,
+		"last_login":    now,
+	}).Error
+}
+
+// Unlock unlocks the user on behalf of the actor, and resets their failed logins. Unlocking a user who is not locked
+// only resets their failed logins.
+func (l *AccountLockout) Unlock(user *relational.User, actor string) error {
+	return l.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&relational.User{}).
+			Where("id = ? AND is_locked", user.ID).
+			Updates(map[string]any{"is_locked": false, "locked_at": nil, "failed_logins": 0})
+		if result.Error != nil {
+			return result.Error
+		}
+		wasLocked := result.RowsAffected > 0
+		if !wasLocked {
+			if err := tx.Model(&relational.User{}).Where("id = ?", user.ID).Update("failed_logins", 0).Error; err != nil {
+				return err
+			}
+		}
+
+		lockedAt := user.LockedAt
+		user.IsLocked = false
+		user.LockedAt = nil
+		user.FailedLogins = 0
+		if !wasLocked {
+			return nil
+		}
+		details := map[string]any{}
+		if lockedAt != nil {
+			details["lockedAt"] = lockedAt
+		}
+		return recordAuditEvent(tx, relational.AuditEventUserUnlocked, actor, user, "", details)
+	})
+}
+
+// recordAuditEvent records an event concerning the user.
+func recordAuditEvent(db *gorm.DB, eventType relational.AuditEventType, actor string, user *relational.User, ip string, details map[string]any) error {
+	if user.ID == nil {
+		return errors.New("cannot record an audit event for a user without an ID")
+	}
+	details["email"] = user.Email
+	event := relational.AuditEvent{
+		Type:      eventType,
+		Actor:     actor,
+		UserID:    user.ID,
+		IPAddress: ip,
+		Details:   datatypes.NewJSONType(details),
+	}
+	if err := db.Create(&event).Error; err != nil {
+		return fmt.Errorf("failed to record audit event: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"sync"
+	"time"
+)
+
+// LoginThrottle limits how many logins may be attempted for a key, such as an IP address or an account, within a
+// sliding window. Attempts are counted in memory, so each instance of the API limits them separately.
+type LoginThrottle struct {
+	limit  int
+	window time.Duration
+
+	mu        sync.Mutex
+	attempts  map[string][]time.Time
+	lastSweep time.Time
+}
+
+// NewLoginThrottle allows limit attempts per key within the window. A limit of zero allows every attempt.
+func NewLoginThrottle(limit int, window time.Duration) *LoginThrottle {
+	return &LoginThrottle{
+		limit:    limit,
+		window:   window,
+		attempts: map[string][]time.Time{},
+	}
+}
+
+// Allow records an attempt for the key at the given time, and reports whether it is within the limit. Rejected
+// attempts are not recorded, and the duration after which another attempt will be allowed is returned for them.
+func (t *LoginThrottle) Allow(key string, now time.Time) (bool, time.Duration) {
+	if t.limit <= 0 {
+		return true, 0
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	since := now.Add(-t.window)
+	if now.Sub(t.lastSweep) >= t.window {
+		t.sweep(since)
+		t.lastSweep = now
+	}
+
+	attempts := recentAttempts(t.attempts[key], since)
+	if len(attempts) >= t.limit {
+		t.attempts[key] = attempts
+		return false, attempts[len(attempts)-t.limit].Sub(since)
+	}
+	t.attempts[key] = append(attempts, now)
+	return true, 0
+}
+
+// sweep forgets the keys without attempts since the given time, so that the attempts kept stay bounded.
+func (t *LoginThrottle) sweep(since time.Time) {
+	for key, attempts := range t.attempts {
+		if len(recentAttempts(attempts, since)) == 0 {
+			delete(t.attempts, key)
+		}
+	}
+}
+
+// recentAttempts returns the attempts made after the given time, which are kept in the order they were made.
+func recentAttempts(attempts []time.Time, since time.Time) []time.Time {
+	for i, attempt := range attempts {
+		if attempt.After(since) {
+			return attempts[i:]
+		}
+	}
+	return nil
+}
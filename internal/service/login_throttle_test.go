@@ -0,0 +1,59 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoginThrottle(t *testing.T) {
+	start := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Attempts beyond the limit are rejected until the window has passed", func(t *testing.T) {
+		throttle := NewLoginThrottle(2, time.Minute)
+
+		allowed, _ := throttle.Allow("ip", start)
+		assert.True(t, allowed)
+		allowed, _ = throttle.Allow("ip", start.Add(20*time.Second))
+		assert.True(t, allowed)
+
+		allowed, retryAfter := throttle.Allow("ip", start.Add(30*time.Second))
+		assert.False(t, allowed)
+		assert.Equal(t, 30*time.Second, retryAfter)
+
+		allowed, _ = throttle.Allow("ip", start.Add(time.Minute))
+		assert.True(t, allowed, "the first attempt has left the window")
+		allowed, retryAfter = throttle.Allow("ip", start.Add(time.Minute+time.Second))
+		assert.False(t, allowed)
+		assert.Equal(t, 19*time.Second, retryAfter)
+	})
+
+	t.Run("Keys are limited separately", func(t *testing.T) {
+		throttle := NewLoginThrottle(1, time.Minute)
+
+		allowed, _ := throttle.Allow("a", start)
+		assert.True(t, allowed)
+		allowed, _ = throttle.Allow("b", start)
+		assert.True(t, allowed)
+		allowed, _ = throttle.Allow("a", start)
+		assert.False(t, allowed)
+	})
+
+	t.Run("Keys without recent attempts are forgotten", func(t *testing.T) {
+		throttle := NewLoginThrottle(1, time.Minute)
+
+		throttle.Allow("a", start)
+		throttle.Allow("b", start.Add(2*time.Minute))
+		assert.NotContains(t, throttle.attempts, "a")
+		assert.Contains(t, throttle.attempts, "b")
+	})
+
+	t.Run("A zero limit allows every attempt", func(t *testing.T) {
+		throttle := NewLoginThrottle(0, time.Minute)
+		for range 100 {
+			allowed, _ := throttle.Allow("ip", start)
+			assert.True(t, allowed)
+		}
+	})
+}
@@ -3,9 +3,17 @@ package service
 import (
 	"github.com/compliance-framework/api/internal/service/relational"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 func MigrateUp(db *gorm.DB) error {
+	// Data is only backfilled by the migration which creates the table introducing it, so that the backfill does not
+	// run again on every start, nor apply to data created since.
+	created := map[string]bool{}
+	for _, table := range []string{"evidence_sightings", "ccf_roles", "ccf_system_grants", "ccf_user_roles"} {
+		created[table] = !db.Migrator().HasTable(table)
+	}
+
 	err := db.AutoMigrate(
 		&relational.ResponsiblePartyParties{},
 		&relational.Location{},
@@ -99,10 +107,28 @@ func MigrateUp(db *gorm.DB) error {
 		&relational.Result{},
 		&relational.AssessmentLog{},
 		&relational.AssessmentLogEntry{},
+		&relational.AccessRole{},
+		&relational.AccessRolePermission{},
 		&relational.User{},
+		&relational.AgentCredential{},
+		&relational.AgentSigningKey{},
+		&relational.Agent{},
+		&relational.AlertRule{},
+		&relational.Alert{},
+		&relational.WebhookSubscription{},
+		&relational.WebhookDelivery{},
+		&relational.SystemGrant{},
+		&relational.Session{},
+		&relational.RevokedToken{},
+		&relational.AuditEvent{},
 
 		&Heartbeat{},
+		&HeartbeatRollup{},
 		&relational.Evidence{},
+		&relational.EvidenceSighting{},
+		&relational.EvidenceTransition{},
+		&relational.EvidenceAttachment{},
+		&relational.Blob{},
 		&relational.Labels{},
 		&relational.SelectSubjectById{},
 		&relational.Filter{},
@@ -111,7 +137,114 @@ func MigrateUp(db *gorm.DB) error {
 		&relational.TermsAndConditions{},
 		&relational.Attestation{},
 	)
-	return err
+	if err != nil {
+		return err
+	}
+
+	if created["evidence_sightings"] {
+		if err := backfillEvidenceSightings(db); err != nil {
+			return err
+		}
+	}
+	if err := backfillEvidenceTransitions(db); err != nil {
+		return err
+	}
+	if err := backfillAgents(db); err != nil {
+		return err
+	}
+	if created["ccf_system_grants"] && !created["ccf_roles"] {
+		if err := backfillSystemAccess(db); err != nil {
+			return err
+		}
+	}
+	if err := SeedAccessRoles(db); err != nil {
+		return err
+	}
+	if created["ccf_user_roles"] {
+		return backfillUserRoles(db)
+	}
+	return nil
+}
+
+// backfillEvidenceSightings records the initial sighting for evidence created before sightings were introduced.
+func backfillEvidenceSightings(db *gorm.DB) error {
+	return db.Exec(`
+		INSERT INTO evidence_sightings (id, evidence_id, start, "end", expires)
+		SELECT gen_random_uuid(), e.id, e.start, e."end", e.expires
+		FROM evidences e
+	`).Error
+}
+
+// backfillEvidenceTransitions records the transitions of evidence created before transitions were introduced,
+// by comparing the state of each evidence with the evidence preceding it in its stream.
+// It only runs while no transitions have been recorded, so that history is not duplicated.
+func backfillEvidenceTransitions(db *gorm.DB) error {
+	return db.Exec(`
+		INSERT INTO evidence_transitions (id, uuid, previous_state, state, "timestamp", previous_evidence_id, evidence_id)
+		SELECT gen_random_uuid(), t.uuid, coalesce(t.previous_state, ''), t.state, t."end", t.previous_id, t.id
+		FROM (
+			SELECT e.id, e.uuid, e."end", coalesce(e.status->>'state', '') AS state,
+				lag(coalesce(e.status->>'state', '')) OVER w AS previous_state,
+				lag(e.id) OVER w AS previous_id
+			FROM evidences e
+			WINDOW w AS (PARTITION BY e.uuid ORDER BY e."end", e.id)
+		) t
+		WHERE (t.previous_id IS NULL OR t.previous_state <> t.state)
+			AND NOT EXISTS (SELECT 1 FROM evidence_transitions)
+	`).Error
+}
+
+// backfillAgents registers the agents which sent heartbeats before the agent registry was introduced. Their
+// details are filled in by their next heartbeat.
+func backfillAgents(db *gorm.DB) error {
+	return db.Exec(`
+		INSERT INTO ccf_agents (uuid, name, version, hostname, plugins, labels, first_seen_at, last_seen_at)
+		SELECT h.uuid, '', '', '', '[]', '{}', min(h.created_at), max(h.created_at)
+		FROM heartbeats h
+		GROUP BY h.uuid
+		ON CONFLICT (uuid) DO NOTHING
+	`).Error
+}
+
+// SeedAccessRoles creates the default roles which do not exist yet. Existing roles are left as they are, so that
+// changes made to their permissions are kept, except that admins are granted permissions added since.
+func SeedAccessRoles(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, role := range relational.DefaultAccessRoles() {
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Omit("Permissions").Create(&role)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 && role.Name != relational.RoleAdmin {
+				continue
+			}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&role.Permissions).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// backfillSystemAccess gives the roles created before system grants were introduced access to every system, as they
+// had before, so that introducing grants does not hide systems from their users.
+func backfillSystemAccess(db *gorm.DB) error {
+	return db.Exec(`
+		INSERT INTO ccf_role_permissions (role_name, permission)
+		SELECT r.name, ?
+		FROM ccf_roles r
+		ON CONFLICT DO NOTHING
+	`, relational.PermissionSystemAll).Error
+}
+
+// backfillUserRoles makes the users created before roles were introduced admins, as they had full access.
+// It only runs when roles are introduced, as users without roles are otherwise meant to have none.
+func backfillUserRoles(db *gorm.DB) error {
+	return db.Exec(`
+		INSERT INTO ccf_user_roles (user_id, role_name)
+		SELECT u.id, ?
+		FROM ccf_users u
+	`, relational.RoleAdmin).Error
 }
 
 func MigrateDown(db *gorm.DB) error {
@@ -240,10 +373,29 @@ func MigrateDown(db *gorm.DB) error {
 		"poam_findings",
 		"poam_risks",
 
+		"ccf_user_roles",
 		&relational.User{},
+		&relational.AccessRolePermission{},
+		&relational.AccessRole{},
+		&relational.AgentCredential{},
+		&relational.AgentSigningKey{},
+		&relational.Agent{},
+		&relational.Alert{},
+		&relational.AlertRule{},
+		&relational.AuditEvent{},
+		&relational.RevokedToken{},
+		&relational.Session{},
+		&relational.SystemGrant{},
+		&relational.WebhookDelivery{},
+		&relational.WebhookSubscription{},
 
 		&Heartbeat{},
+		&HeartbeatRollup{},
 		&relational.Evidence{},
+		&relational.EvidenceSighting{},
+		&relational.EvidenceTransition{},
+		&relational.EvidenceAttachment{},
+		&relational.Blob{},
 		"evidence_activities",
 		"evidence_components",
 		"evidence_inventory_items",
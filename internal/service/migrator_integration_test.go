@@ -0,0 +1,57 @@
+//go:build integration
+
+package service_test
+
+import (
+	"testing"
+
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/compliance-framework/api/internal/tests"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestMigrator(t *testing.T) {
+	suite.Run(t, new(MigratorIntegrationSuite))
+}
+
+type MigratorIntegrationSuite struct {
+	tests.IntegrationTestSuite
+}
+
+func (suite *MigratorIntegrationSuite) TestUserRolesAreNotBackfilledAgain() {
+	suite.Require().NoError(suite.Migrator.Refresh())
+	suite.Require().NoError(suite.DB.Exec("DELETE FROM ccf_user_roles").Error)
+
+	suite.Require().NoError(service.MigrateUp(suite.DB))
+
+	var roles int64
+	suite.Require().NoError(suite.DB.Table("ccf_user_roles").Count(&roles).Error)
+	suite.Zero(roles, "Expected users without roles not to be made admins once roles exist")
+
+	var user relational.User
+	suite.Require().NoError(suite.DB.Preload("Roles").First(&user, "email = ?", "test@example.com").Error)
+	suite.Empty(user.Roles)
+}
+
+func (suite *MigratorIntegrationSuite) TestRolesKeepAccessToEverySystem() {
+	suite.Require().NoError(suite.Migrator.Refresh())
+	suite.Require().NoError(suite.DB.Migrator().DropTable(&relational.SystemGrant{}))
+	suite.Require().NoError(suite.DB.Create(&relational.AccessRole{Name: "auditor"}).Error)
+
+	suite.Require().NoError(service.MigrateUp(suite.DB))
+
+	hasSystemAll := func(role string) bool {
+		var count int64
+		suite.Require().NoError(suite.DB.Model(&relational.AccessRolePermission{}).
+			Where("role_name = ? AND permission = ?", role, relational.PermissionSystemAll).
+			Count(&count).Error)
+		return count > 0
+	}
+	suite.True(hasSystemAll("auditor"), "Expected roles created before system grants to keep access to every system")
+	suite.True(hasSystemAll(relational.RoleReadOnly))
+
+	suite.Require().NoError(suite.DB.Where("role_name = ?", "auditor").Delete(&relational.AccessRolePermission{}).Error)
+	suite.Require().NoError(service.MigrateUp(suite.DB))
+	suite.False(hasSystemAll("auditor"), "Expected access to every system to only be given once")
+}
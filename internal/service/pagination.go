@@ -78,6 +78,8 @@ type ListResponse[T any] struct {
 	Page       int   `json:"page"`
 	Limit      int   `json:"limit"`
 	TotalPages int   `json:"totalPages"`
+	// NextCursor fetches the following page, for lists which support cursor pagination. It is empty on the last page.
+	NextCursor string `json:"nextCursor,omitempty"`
 }
 
 // NewListResponse creates a new paginated list response
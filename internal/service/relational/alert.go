@@ -0,0 +1,137 @@
+package relational
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/compliance-framework/api/internal/converters/labelfilter"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// AlertRuleKind is the condition an alert rule watches for.
+type AlertRuleKind string
+
+const (
+	// AlertRuleAgentOffline fires for each agent which has not sent a heartbeat within the window of the rule. The
+	// target is the UUID of an agent, or empty to watch every agent in the registry.
+	AlertRuleAgentOffline AlertRuleKind = "agent-offline"
+	// AlertRuleEvidenceGap fires for each evidence stream which has not been seen within the window of the rule. The
+	// target is the UUID of a stream, or empty to watch every stream.
+	AlertRuleEvidenceGap AlertRuleKind = "evidence-gap"
+	// AlertRuleComplianceBelow fires when the percentage of satisfied evidence among the latest evidence matching the
+	// filters of a control drops below the threshold of the rule. The target is the ID of the control.
+	AlertRuleComplianceBelow AlertRuleKind = "compliance-below"
+)
+
+var AlertRuleKinds = []AlertRuleKind{AlertRuleAgentOffline, AlertRuleEvidenceGap, AlertRuleComplianceBelow}
+
+// Notifiers alerts can be sent through. Which of them are available depends on the configuration.
+const (
+	AlertNotifierLog     = "log"
+	AlertNotifierWebhook = "webhook"
+	AlertNotifierSMTP    = "smtp"
+)
+
+var AlertNotifiers = []string{AlertNotifierLog, AlertNotifierWebhook, AlertNotifierSMTP}
+
+// AlertRule describes a condition which is evaluated periodically, firing an alert for each subject it holds for.
+type AlertRule struct {
+	UUIDModel
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	Name   string        `json:"name" gorm:"not null"`
+	Kind   AlertRuleKind `json:"kind" gorm:"not null"`
+	Target string        `json:"target"`
+	// Window is how long an agent may go without a heartbeat, or a stream without evidence, as a duration such as 10m
+	// or a number of days such as 7d.
+	Window string `json:"window,omitempty"`
+	// Threshold is the lowest acceptable percentage of satisfied evidence.
+	Threshold float64 `json:"threshold,omitempty"`
+	// Notifiers are the notifiers alerts are sent through, or every configured notifier when empty.
+	Notifiers datatypes.JSONSlice[string] `json:"notifiers"`
+	// Enabled rules are evaluated. The alerts of disabled rules are resolved.
+	Enabled bool `json:"enabled" gorm:"not null"`
+}
+
+func (AlertRule) TableName() string {
+	return "ccf_alert_rules"
+}
+
+// WindowDuration parses the window of the rule.
+func (r *AlertRule) WindowDuration() (time.Duration, error) {
+	window, err := labelfilter.ParseDuration(r.Window)
+	if err != nil {
+		return 0, fmt.Errorf("invalid window %q: %w", r.Window, err)
+	}
+	if window <= 0 {
+		return 0, fmt.Errorf("invalid window %q: must be positive", r.Window)
+	}
+	return window, nil
+}
+
+// Validate checks the rule has the target, window or threshold its kind needs, and only names known notifiers.
+func (r *AlertRule) Validate() error {
+	switch r.Kind {
+	case AlertRuleAgentOffline, AlertRuleEvidenceGap:
+		if r.Target != "" {
+			if _, err := uuid.Parse(r.Target); err != nil {
+				return fmt.Errorf("target of a %s rule must be a UUID: %w", r.Kind, err)
+			}
+		}
+		if _, err := r.WindowDuration(); err != nil {
+			return err
+		}
+	case AlertRuleComplianceBelow:
+		if r.Target == "" {
+			return fmt.Errorf("target of a %s rule must be a control ID", r.Kind)
+		}
+		if r.Threshold <= 0 || r.Threshold > 100 {
+			return errors.New("threshold must be a percentage above 0 and up to 100")
+		}
+	default:
+		kinds := make([]string, 0, len(AlertRuleKinds))
+		for _, kind := range AlertRuleKinds {
+			kinds = append(kinds, string(kind))
+		}
+		return fmt.Errorf("unknown alert rule kind %q, expected one of: %s", r.Kind, strings.Join(kinds, ", "))
+	}
+
+	for _, notifier := range r.Notifiers {
+		if !slices.Contains(AlertNotifiers, notifier) {
+			return fmt.Errorf("unknown notifier %q, expected one of: %s", notifier, strings.Join(AlertNotifiers, ", "))
+		}
+	}
+	return nil
+}
+
+// AlertState is whether the condition of an alert still holds.
+type AlertState string
+
+const (
+	AlertStateFiring   AlertState = "firing"
+	AlertStateResolved AlertState = "resolved"
+)
+
+// Alert is an occurrence of an alert rule firing for a subject: the agent, evidence stream or control the
+// condition holds for. It is resolved once the condition no longer holds, and fires again as a new alert.
+// A rule has at most one firing alert for each subject.
+type Alert struct {
+	UUIDModel
+
+	RuleID     uuid.UUID  `json:"ruleId" gorm:"index;not null;uniqueIndex:ccf_alerts_firing_idx,where:state = 'firing'"`
+	Rule       *AlertRule `json:"rule,omitempty"`
+	Subject    string     `json:"subject" gorm:"not null;uniqueIndex:ccf_alerts_firing_idx,where:state = 'firing'"`
+	State      AlertState `json:"state" gorm:"index;not null"`
+	Summary    string     `json:"summary"`
+	FiredAt    time.Time  `json:"firedAt" gorm:"index"`
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+}
+
+func (Alert) TableName() string {
+	return "ccf_alerts"
+}
@@ -0,0 +1,53 @@
+package relational
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+func TestAlertRule(t *testing.T) {
+	t.Run("Valid rules", func(t *testing.T) {
+		for _, rule := range []AlertRule{
+			{Kind: AlertRuleAgentOffline, Window: "10m"},
+			{Kind: AlertRuleAgentOffline, Target: uuid.NewString(), Window: "1h"},
+			{Kind: AlertRuleEvidenceGap, Target: uuid.NewString(), Window: "24h"},
+			{Kind: AlertRuleEvidenceGap, Window: "7d"},
+			{Kind: AlertRuleComplianceBelow, Target: "ac-1", Threshold: 80},
+			{Kind: AlertRuleComplianceBelow, Target: "ac-1", Threshold: 100, Notifiers: datatypes.NewJSONSlice([]string{"log", "smtp"})},
+		} {
+			assert.NoError(t, rule.Validate(), rule)
+		}
+	})
+
+	t.Run("Invalid rules", func(t *testing.T) {
+		for _, rule := range []AlertRule{
+			{},
+			{Kind: "disk-full", Window: "10m"},
+			{Kind: AlertRuleAgentOffline},
+			{Kind: AlertRuleAgentOffline, Window: "0s"},
+			{Kind: AlertRuleAgentOffline, Window: "ten minutes"},
+			{Kind: AlertRuleEvidenceGap, Target: "ssh", Window: "10m"},
+			{Kind: AlertRuleComplianceBelow, Threshold: 80},
+			{Kind: AlertRuleComplianceBelow, Target: "ac-1"},
+			{Kind: AlertRuleComplianceBelow, Target: "ac-1", Threshold: 101},
+			{Kind: AlertRuleAgentOffline, Window: "10m", Notifiers: datatypes.NewJSONSlice([]string{"pager"})},
+		} {
+			assert.Error(t, rule.Validate(), rule)
+		}
+	})
+
+	t.Run("Window", func(t *testing.T) {
+		window, err := (&AlertRule{Window: "90s"}).WindowDuration()
+		require.NoError(t, err)
+		assert.Equal(t, 90*time.Second, window)
+
+		window, err = (&AlertRule{Window: "1d"}).WindowDuration()
+		require.NoError(t, err)
+		assert.Equal(t, 24*time.Hour, window)
+	})
+}
@@ -0,0 +1,45 @@
+package relational
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// AuditEventType identifies the kind of security relevant change an audit event records.
+type AuditEventType string
+
+const (
+	// AuditEventUserLocked is recorded when a user is locked after too many failed logins.
+	AuditEventUserLocked AuditEventType = "user.locked"
+	// AuditEventUserUnlocked is recorded when a locked user is unlocked, by an admin or once their lockout expires.
+	AuditEventUserUnlocked AuditEventType = "user.unlocked"
+)
+
+var AuditEventTypes = []AuditEventType{
+	AuditEventUserLocked,
+	AuditEventUserUnlocked,
+}
+
+// AuditSystemActor is the actor of audit events recorded by the API itself rather than on behalf of someone.
+const AuditSystemActor = "system"
+
+// AuditEvent records a security relevant change. Events are kept when the user they concern is deleted.
+type AuditEvent struct {
+	UUIDModel
+	CreatedAt time.Time `json:"createdAt" gorm:"index"`
+
+	Type AuditEventType `json:"type" gorm:"not null;index"`
+	// Actor is the email of the user, or the name of the command, which made the change, or AuditSystemActor.
+	Actor string `json:"actor" gorm:"not null"`
+	// UserID is the user the event concerns, if any.
+	UserID *uuid.UUID `json:"userId,omitempty" gorm:"type:uuid;index"`
+	// IPAddress is the address of the client whose request caused the event, if any.
+	IPAddress string                             `json:"ipAddress,omitempty"`
+	Details   datatypes.JSONType[map[string]any] `json:"details"`
+}
+
+func (AuditEvent) TableName() string {
+	return "ccf_audit_events"
+}
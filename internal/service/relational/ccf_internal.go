@@ -1,9 +1,20 @@
 package relational
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
@@ -15,7 +26,7 @@ type User struct {
 	DeletedAt gorm.DeletedAt `json:"deletedAt" gorm:"index"` // Soft delete
 
 	Email        string `json:"email" gorm:"uniqueIndex;not null"`
-	PasswordHash string `gorm:"not null"`
+	PasswordHash string `json:"-" gorm:"not null"`
 
 	FirstName string `json:"firstName"`
 	LastName  string `json:"lastName"`
@@ -24,9 +35,17 @@ type User struct {
 	IsActive     bool       `json:"isActive" gorm:"default:true"`
 	IsLocked     bool       `json:"isLocked" gorm:"default:false"`
 	FailedLogins int        `json:"failedLogins" gorm:"default:0"`
+	// LockedAt is when the user was locked after too many failed logins, which the lockout duration is counted from.
+	LockedAt *time.Time `json:"lockedAt,omitempty"`
 
-	ResetToken       *string
-	ResetTokenExpiry *time.Time
+	ResetToken       *string    `json:"-"`
+	ResetTokenExpiry *time.Time `json:"-"`
+
+	// OIDCIssuer and OIDCSubject identify users who log in with an OpenID Connect provider.
+	OIDCIssuer  *string `json:"oidcIssuer,omitempty" gorm:"uniqueIndex:idx_ccf_users_oidc"`
+	OIDCSubject *string `json:"oidcSubject,omitempty" gorm:"uniqueIndex:idx_ccf_users_oidc"`
+
+	Roles []AccessRole `json:"roles,omitempty" gorm:"many2many:ccf_user_roles;joinForeignKey:UserID;joinReferences:RoleName"`
 }
 
 func (User) TableName() string {
@@ -47,3 +66,200 @@ func (u *User) CheckPassword(password string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password))
 	return err == nil
 }
+
+// LockExpired reports whether a locked user may be unlocked at the given time, after being locked for the duration.
+// Users stay locked when the duration is zero, or when they were locked without recording when, such as before
+// lockouts expired, so that they are not unlocked unexpectedly.
+func (u *User) LockExpired(now time.Time, duration time.Duration) bool {
+	if !u.IsLocked || u.LockedAt == nil || duration <= 0 {
+		return false
+	}
+	return !now.Before(u.LockedAt.Add(duration))
+}
+
+type AgentCredentialScope string
+
+const (
+	// AgentCredentialScopeIngest allows an agent to submit evidence and heartbeats.
+	AgentCredentialScopeIngest AgentCredentialScope = "ingest"
+	// AgentCredentialScopeRead allows an agent to read evidence and filters.
+	AgentCredentialScopeRead AgentCredentialScope = "read"
+)
+
+var AgentCredentialScopes = []AgentCredentialScope{AgentCredentialScopeIngest, AgentCredentialScopeRead}
+
+// agentKeyPrefix identifies API keys issued to agents, so they can be told apart from user JWTs.
+const agentKeyPrefix = "ccf_"
+
+// AgentCredential is an API key issued to an agent. Only a hash of the secret is stored.
+// Keys take the form ccf_<prefix>_<secret>, where the prefix is used to look up the credential.
+type AgentCredential struct {
+	UUIDModel
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	Name       string               `json:"name" gorm:"not null"`
+	Prefix     string               `json:"prefix" gorm:"uniqueIndex;not null"`
+	SecretHash string               `json:"-" gorm:"not null"`
+	Scope      AgentCredentialScope `json:"scope" gorm:"not null"`
+
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+}
+
+func (AgentCredential) TableName() string {
+	return "ccf_agent_credentials"
+}
+
+// NewAgentCredential creates a credential with a freshly generated key. The key is returned alongside the credential
+// and cannot be recovered afterwards.
+func NewAgentCredential(name string, scope AgentCredentialScope, expiresAt *time.Time) (*AgentCredential, string, error) {
+	if !slices.Contains(AgentCredentialScopes, scope) {
+		return nil, "", fmt.Errorf("unknown agent credential scope: %s", scope)
+	}
+
+	prefix := make([]byte, 6)
+	if _, err := rand.Read(prefix); err != nil {
+		return nil, "", err
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+
+	credential := &AgentCredential{
+		Name:      name,
+		Prefix:    hex.EncodeToString(prefix),
+		Scope:     scope,
+		ExpiresAt: expiresAt,
+	}
+	encodedSecret := base64.RawURLEncoding.EncodeToString(secret)
+	credential.SecretHash = hashAgentSecret(encodedSecret)
+
+	return credential, agentKeyPrefix + credential.Prefix + "_" + encodedSecret, nil
+}
+
+// IsAgentKey reports whether the token looks like an agent API key rather than a JWT.
+func IsAgentKey(token string) bool {
+	return strings.HasPrefix(token, agentKeyPrefix)
+}
+
+// ParseAgentKey splits an agent API key into its lookup prefix and secret.
+func ParseAgentKey(key string) (string, string, error) {
+	parts := strings.SplitN(strings.TrimPrefix(key, agentKeyPrefix), "_", 2)
+	if !IsAgentKey(key) || len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.New("malformed agent key")
+	}
+	return parts[0], parts[1], nil
+}
+
+func (c *AgentCredential) CheckSecret(secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(c.SecretHash), []byte(hashAgentSecret(secret))) == 1
+}
+
+// IsActive reports whether the credential is neither revoked nor expired at the given time.
+func (c *AgentCredential) IsActive(at time.Time) bool {
+	if c.RevokedAt != nil && !c.RevokedAt.After(at) {
+		return false
+	}
+	if c.ExpiresAt != nil && !c.ExpiresAt.After(at) {
+		return false
+	}
+	return true
+}
+
+func hashAgentSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// AgentSigningKey is an ed25519 public key an agent signs its evidence with. Keys are registered for the UUID the
+// agent uses as an actor in the origins of its evidence, and evidence is only verified when the key's agent is
+// among them.
+type AgentSigningKey struct {
+	UUIDModel
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	Name      string    `json:"name" gorm:"not null"`
+	AgentUUID uuid.UUID `json:"agentUuid" gorm:"index;not null"`
+	Algorithm string    `json:"algorithm" gorm:"not null"`
+	// PublicKey is the standard base64 encoding of the raw public key.
+	PublicKey string `json:"publicKey" gorm:"not null"`
+
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+}
+
+func (AgentSigningKey) TableName() string {
+	return "ccf_agent_signing_keys"
+}
+
+// IsActive reports whether the key had not been revoked at the given time.
+func (k *AgentSigningKey) IsActive(at time.Time) bool {
+	return k.RevokedAt == nil || k.RevokedAt.After(at)
+}
+
+// AgentPlugin is a plugin an agent runs to collect evidence.
+type AgentPlugin struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+// AgentDetails describes an agent, as reported in its heartbeats.
+type AgentDetails struct {
+	Name     string                                `json:"name"`
+	Version  string                                `json:"version"`
+	Hostname string                                `json:"hostname"`
+	Plugins  datatypes.JSONSlice[AgentPlugin]      `json:"plugins"`
+	Labels   datatypes.JSONType[map[string]string] `json:"labels"`
+}
+
+// Agent is an agent known from its heartbeats. Its UUID is the one it sends heartbeats with, and uses as an actor
+// in the origins of its evidence.
+type Agent struct {
+	UUID uuid.UUID `json:"uuid" gorm:"primaryKey"`
+	AgentDetails
+
+	FirstSeenAt time.Time `json:"firstSeenAt" gorm:"not null"`
+	LastSeenAt  time.Time `json:"lastSeenAt" gorm:"index;not null"`
+}
+
+func (Agent) TableName() string {
+	return "ccf_agents"
+}
+
+// Observe records a heartbeat sent at the given time. The details of the agent are replaced by those of the latest
+// heartbeat, except for details it leaves out, so that heartbeats from older agents do not clear them.
+func (a *Agent) Observe(at time.Time, details AgentDetails) {
+	if a.FirstSeenAt.IsZero() || at.Before(a.FirstSeenAt) {
+		a.FirstSeenAt = at
+	}
+	if at.Before(a.LastSeenAt) {
+		return
+	}
+	a.LastSeenAt = at
+
+	if details.Name != "" {
+		a.Name = details.Name
+	}
+	if details.Version != "" {
+		a.Version = details.Version
+	}
+	if details.Hostname != "" {
+		a.Hostname = details.Hostname
+	}
+	if details.Plugins != nil {
+		a.Plugins = details.Plugins
+	}
+	if details.Labels.Data() != nil {
+		a.Labels = details.Labels
+	}
+}
+
+// IsOnline reports whether the agent has sent a heartbeat within offlineAfter of the given time.
+func (a *Agent) IsOnline(at time.Time, offlineAfter time.Duration) bool {
+	return at.Sub(a.LastSeenAt) <= offlineAfter
+}
@@ -0,0 +1,114 @@
+package relational
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+func TestAgentCredential(t *testing.T) {
+	t.Run("Generated keys can be parsed and checked", func(t *testing.T) {
+		credential, key, err := NewAgentCredential("agent", AgentCredentialScopeIngest, nil)
+		require.NoError(t, err)
+
+		assert.True(t, IsAgentKey(key))
+		assert.NotContains(t, credential.SecretHash, key)
+
+		prefix, secret, err := ParseAgentKey(key)
+		require.NoError(t, err)
+		assert.Equal(t, credential.Prefix, prefix)
+		assert.True(t, credential.CheckSecret(secret))
+		assert.False(t, credential.CheckSecret(secret+"x"))
+	})
+
+	t.Run("Keys are unique", func(t *testing.T) {
+		_, first, err := NewAgentCredential("agent", AgentCredentialScopeRead, nil)
+		require.NoError(t, err)
+		_, second, err := NewAgentCredential("agent", AgentCredentialScopeRead, nil)
+		require.NoError(t, err)
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("Unknown scopes are rejected", func(t *testing.T) {
+		_, _, err := NewAgentCredential("agent", "admin", nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("Malformed keys are rejected", func(t *testing.T) {
+		for _, key := range []string{"", "ccf_", "ccf_abc", "ccf__secret", "abc_secret", "eyJhbGciOiJSUzI1NiJ9.e30.sig"} {
+			_, _, err := ParseAgentKey(key)
+			assert.Error(t, err, key)
+		}
+	})
+
+	t.Run("Secrets may contain underscores", func(t *testing.T) {
+		prefix, secret, err := ParseAgentKey("ccf_abc_se_cr_et")
+		require.NoError(t, err)
+		assert.Equal(t, "abc", prefix)
+		assert.True(t, strings.HasPrefix(secret, "se_"))
+	})
+
+	t.Run("Active", func(t *testing.T) {
+		now := time.Now()
+		past := now.Add(-time.Hour)
+		future := now.Add(time.Hour)
+
+		assert.True(t, (&AgentCredential{}).IsActive(now))
+		assert.True(t, (&AgentCredential{ExpiresAt: &future}).IsActive(now))
+		assert.False(t, (&AgentCredential{ExpiresAt: &past}).IsActive(now))
+		assert.False(t, (&AgentCredential{RevokedAt: &past}).IsActive(now))
+	})
+}
+
+func TestAgent(t *testing.T) {
+	now := time.Now()
+
+	t.Run("Details of the latest heartbeat are kept", func(t *testing.T) {
+		agent := &Agent{}
+		agent.Observe(now, AgentDetails{
+			Name:     "aws",
+			Version:  "1.0.0",
+			Hostname: "runner-1",
+			Plugins:  datatypes.NewJSONSlice([]AgentPlugin{{Name: "ssh", Version: "0.1.0"}}),
+			Labels:   datatypes.NewJSONType(map[string]string{"env": "prod"}),
+		})
+		agent.Observe(now.Add(-time.Minute), AgentDetails{Version: "0.9.0"})
+
+		assert.Equal(t, now.Add(-time.Minute), agent.FirstSeenAt)
+		assert.Equal(t, now, agent.LastSeenAt)
+		assert.Equal(t, "1.0.0", agent.Version)
+
+		agent.Observe(now.Add(time.Minute), AgentDetails{Version: "1.1.0"})
+		assert.Equal(t, now.Add(time.Minute), agent.LastSeenAt)
+		assert.Equal(t, "1.1.0", agent.Version)
+		assert.Equal(t, "aws", agent.Name)
+		assert.Equal(t, "runner-1", agent.Hostname)
+		assert.Len(t, agent.Plugins, 1)
+		assert.Equal(t, map[string]string{"env": "prod"}, agent.Labels.Data())
+
+		agent.Observe(now.Add(2*time.Minute), AgentDetails{Plugins: datatypes.NewJSONSlice([]AgentPlugin{})})
+		assert.Empty(t, agent.Plugins)
+	})
+
+	t.Run("Online", func(t *testing.T) {
+		agent := &Agent{LastSeenAt: now.Add(-2 * time.Minute)}
+		assert.True(t, agent.IsOnline(now, 5*time.Minute))
+		assert.False(t, agent.IsOnline(now, time.Minute))
+	})
+}
+
+func TestUserLockExpired(t *testing.T) {
+	now := time.Now()
+	lockedAt := now.Add(-10 * time.Minute)
+
+	assert.False(t, (&User{}).LockExpired(now, time.Minute), "unlocked users")
+	assert.True(t, (&User{IsLocked: true, LockedAt: &lockedAt}).LockExpired(now, 10*time.Minute))
+	assert.True(t, (&User{IsLocked: true, LockedAt: &lockedAt}).LockExpired(now, 5*time.Minute))
+	assert.False(t, (&User{IsLocked: true, LockedAt: &lockedAt}).LockExpired(now, 15*time.Minute))
+	assert.False(t, (&User{IsLocked: true, LockedAt: &lockedAt}).LockExpired(now, 0), "locked until unlocked by an admin")
+	assert.False(t, (&User{IsLocked: true}).LockExpired(now, time.Minute), "locked without a time")
+}
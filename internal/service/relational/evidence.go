@@ -1,15 +1,21 @@
 package relational
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/compliance-framework/api/internal"
 	"github.com/compliance-framework/api/internal/converters/labelfilter"
 	oscalTypes_1_1_3 "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 	"github.com/google/uuid"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Evidence struct {
@@ -32,6 +38,11 @@ type Evidence struct {
 	End     time.Time  `gorm:"index:evidence_stream_collected_idx,priority:2,sort:desc" json:"end"`
 	Expires *time.Time `json:"expires,omitempty"`
 
+	// When the same observation is made again, no new row is created. Instead, the repeat is recorded as a sighting
+	// and LastSeenAt is moved forward. End remains the time the observation was first made.
+	LastSeenAt *time.Time         `json:"last-seen-at,omitempty"`
+	Sightings  []EvidenceSighting `json:"-"`
+
 	Props datatypes.JSONSlice[Prop] `json:"props"`
 	Links datatypes.JSONSlice[Link] `json:"links"`
 
@@ -50,6 +61,222 @@ type Evidence struct {
 
 	// Did we satisfy what was being tested for, or did we fail ?
 	Status datatypes.JSONType[oscalTypes_1_1_3.ObjectiveStatus] `json:"status"`
+
+	// Raw artifacts collected alongside the evidence.
+	Attachments []EvidenceAttachment `json:"attachments,omitempty"`
+
+	// Verification records whether the evidence was signed by the agent named in its origins, and SigningKeyID the
+	// key it was signed with. Evidence created before signatures were verified has no verification.
+	Verification EvidenceVerification `gorm:"index" json:"verification,omitempty"`
+	SigningKeyID *uuid.UUID           `json:"signing-key-id,omitempty"`
+}
+
+// EvidenceVerification is the outcome of verifying the signature of evidence on ingest.
+type EvidenceVerification string
+
+const (
+	// EvidenceVerificationVerified indicates the evidence was signed with an active key of an agent in its origins.
+	EvidenceVerificationVerified EvidenceVerification = "verified"
+	// EvidenceVerificationUnsigned indicates the evidence was sent without a signature.
+	EvidenceVerificationUnsigned EvidenceVerification = "unsigned"
+	// EvidenceVerificationInvalid indicates the signature did not match the evidence, or was made with an unknown or
+	// revoked key, or a key of an agent missing from the origins of the evidence.
+	EvidenceVerificationInvalid EvidenceVerification = "invalid"
+)
+
+// AfterCreate records the initial sighting of newly created evidence, unless sightings were created alongside it.
+func (e *Evidence) AfterCreate(tx *gorm.DB) error {
+	if len(e.Sightings) > 0 {
+		return nil
+	}
+	return tx.Session(&gorm.Session{NewDB: true}).Create(&EvidenceSighting{
+		EvidenceID: *e.ID,
+		Start:      e.Start,
+		End:        e.End,
+		Expires:    e.Expires,
+	}).Error
+}
+
+// SameObservation reports whether other describes the same observation as e, disregarding when it was made.
+// Evidence is compared on its status, labels, subjects, components, props, the content of its attachments and
+// the verification of its signature.
+func (e *Evidence) SameObservation(other *Evidence) bool {
+	return jsonEqual(e.Status, other.Status) &&
+		e.Verification == other.Verification &&
+		uuidKey(e.SigningKeyID) == uuidKey(other.SigningKeyID) &&
+		(len(e.Props) == 0 && len(other.Props) == 0 || jsonEqual(e.Props, other.Props)) &&
+		slices.Equal(evidenceLabelKeys(e.Labels), evidenceLabelKeys(other.Labels)) &&
+		slices.Equal(evidenceSubjectKeys(e.Subjects), evidenceSubjectKeys(other.Subjects)) &&
+		slices.Equal(evidenceComponentKeys(e.Components), evidenceComponentKeys(other.Components)) &&
+		slices.Equal(evidenceAttachmentKeys(e.Attachments), evidenceAttachmentKeys(other.Attachments))
+}
+
+func jsonEqual(a, b any) bool {
+	aj, aErr := json.Marshal(a)
+	bj, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && bytes.Equal(aj, bj)
+}
+
+func evidenceLabelKeys(labels []Labels) []string {
+	keys := make([]string, 0, len(labels))
+	for _, label := range labels {
+		keys = append(keys, label.Name+"="+label.Value)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func evidenceSubjectKeys(subjects []AssessmentSubject) []string {
+	keys := make([]string, 0, len(subjects))
+	for _, subject := range subjects {
+		ids := make([]string, 0, len(subject.IncludeSubjects))
+		for _, include := range subject.IncludeSubjects {
+			ids = append(ids, include.SubjectUUID.String())
+		}
+		slices.Sort(ids)
+		keys = append(keys, subject.Type+"/"+strings.Join(ids, ","))
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func evidenceComponentKeys(components []SystemComponent) []string {
+	keys := make([]string, 0, len(components))
+	for _, component := range components {
+		if component.ID != nil {
+			keys = append(keys, component.ID.String())
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// FilterValues returns the values of a label filter field of the evidence, so that filters can be matched against
+// evidence in memory as well as in the database. name selects the label or prop for those fields.
+func (e *Evidence) FilterValues(field string, name string) []string {
+	values := []string{}
+	switch field {
+	case labelfilter.FieldLabel:
+		for _, label := range e.Labels {
+			if strings.EqualFold(label.Name, name) {
+				values = append(values, label.Value)
+			}
+		}
+	case labelfilter.FieldStatus:
+		if state := e.Status.Data().State; state != "" {
+			values = append(values, state)
+		}
+	case labelfilter.FieldTitle:
+		values = append(values, e.Title)
+	case labelfilter.FieldVerification:
+		if e.Verification != "" {
+			values = append(values, string(e.Verification))
+		}
+	case labelfilter.FieldSubject:
+		for _, subject := range e.Subjects {
+			for _, include := range subject.IncludeSubjects {
+				values = append(values, include.SubjectUUID.String())
+			}
+		}
+	case labelfilter.FieldComponent:
+		for _, component := range e.Components {
+			if component.ID != nil {
+				values = append(values, component.ID.String())
+			}
+		}
+	case labelfilter.FieldInventoryItem:
+		for _, item := range e.InventoryItems {
+			if item.ID != nil {
+				values = append(values, item.ID.String())
+			}
+		}
+	case labelfilter.FieldOrigin:
+		for _, origin := range e.Origins {
+			for _, actor := range origin.Actors {
+				values = append(values, actor.ActorUuid)
+			}
+		}
+	case labelfilter.FieldProp:
+		for _, prop := range e.Props {
+			if strings.EqualFold(prop.Name, name) {
+				values = append(values, prop.Value)
+			}
+		}
+	}
+	return values
+}
+
+// FilterTime returns the start or end time of the evidence for label filters. The end of evidence which has been seen
+// again is when it was last seen, as it is when searching.
+func (e *Evidence) FilterTime(field string) time.Time {
+	if field == labelfilter.FieldStart {
+		return e.Start
+	}
+	if e.LastSeenAt != nil {
+		return *e.LastSeenAt
+	}
+	return e.End
+}
+
+func evidenceAttachmentKeys(attachments []EvidenceAttachment) []string {
+	keys := make([]string, 0, len(attachments))
+	for _, attachment := range attachments {
+		keys = append(keys, attachment.Name+"="+attachment.BlobKey)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// EvidenceSighting records a single time evidence was collected. Evidence which is collected repeatedly without
+// changing has a single Evidence row with many sightings.
+type EvidenceSighting struct {
+	UUIDModel
+
+	EvidenceID uuid.UUID  `gorm:"index:evidence_sighting_idx,priority:1;not null" json:"evidence-id"`
+	Start      time.Time  `json:"start"`
+	End        time.Time  `gorm:"index:evidence_sighting_idx,priority:2,sort:desc" json:"end"`
+	Expires    *time.Time `json:"expires,omitempty"`
+}
+
+// EvidenceStatusStale is reported in place of the status of evidence which has expired, or which has not been
+// seen within the staleness window, as the observation can no longer be relied upon.
+const EvidenceStatusStale = "stale"
+
+// IsStale reports whether the evidence had expired at the given time, or had not been seen within the staleness
+// window before it. A zero staleness window disables the latter check.
+func (e *Evidence) IsStale(at time.Time, staleness time.Duration) bool {
+	if e.Expires != nil && e.Expires.Before(at) {
+		return true
+	}
+	lastSeen := e.End
+	if e.LastSeenAt != nil {
+		lastSeen = *e.LastSeenAt
+	}
+	return staleness > 0 && lastSeen.Before(at.Add(-staleness))
+}
+
+// EvidenceStaleCondition returns a condition matching evidence, aliased as l, which was stale at the given time.
+// Staleness is determined by the most recent sighting of the evidence up to that time, so it can be used to
+// look back in time as well as at the present.
+func EvidenceStaleCondition(at time.Time, staleness time.Duration) clause.Expr {
+	condition := "latest.expires < ?"
+	args := []any{at}
+	if staleness > 0 {
+		condition += ` OR latest."end" < ?`
+		args = append(args, at.Add(-staleness))
+	}
+	return gorm.Expr(`EXISTS (
+		SELECT 1 FROM (
+			SELECT s."end", s.expires FROM evidence_sightings s
+			WHERE s.evidence_id = l.id AND s."end" <= ?
+			ORDER BY s."end" DESC LIMIT 1
+		) latest WHERE `+condition+`)`, append([]any{at}, args...)...)
+}
+
+// EvidenceStateExpression returns an expression for the objective state of evidence, aliased as l, at the given time.
+// Stale evidence is reported as EvidenceStatusStale rather than its recorded state.
+func EvidenceStateExpression(at time.Time, staleness time.Duration) clause.Expr {
+	return gorm.Expr("CASE WHEN ? THEN ? ELSE l.status->>'state' END", EvidenceStaleCondition(at, staleness), EvidenceStatusStale)
 }
 
 func GetLatestEvidenceStreamsQuery(db *gorm.DB) *gorm.DB {
@@ -61,6 +288,31 @@ func GetLatestEvidenceStreamsQuery(db *gorm.DB) *gorm.DB {
 	return query
 }
 
+// EvidenceOriginStream is an evidence stream with an actor in its origins, described by its latest evidence.
+type EvidenceOriginStream struct {
+	ActorUUID uuid.UUID `json:"-"`
+	UUID      uuid.UUID `json:"uuid"`
+	// ID is the ID of the latest evidence of the stream.
+	ID    uuid.UUID `json:"id"`
+	Title string    `json:"title"`
+	End   time.Time `json:"end"`
+	State string    `json:"state"`
+}
+
+// GetEvidenceStreamsByOriginQuery selects the streams whose latest evidence has one of the actors in its origins,
+// once for each of the actors. Streams are scanned into EvidenceOriginStream.
+func GetEvidenceStreamsByOriginQuery(db *gorm.DB, actors []uuid.UUID) *gorm.DB {
+	ids := make([]string, 0, len(actors))
+	for _, actor := range actors {
+		ids = append(ids, actor.String())
+	}
+	return db.Table("(?) AS l", GetLatestEvidenceStreamsQuery(db)).
+		Select(`DISTINCT lower(a.actor->>'actor-uuid') AS actor_uuid, l.uuid, l.id, l.title, l."end", coalesce(l.status->>'state', '') AS state`).
+		Joins("CROSS JOIN LATERAL jsonb_array_elements("+jsonArray("l.origins")+") AS o(origin)").
+		Joins("CROSS JOIN LATERAL jsonb_array_elements("+jsonArray("o.origin->'actors'")+") AS a(actor)").
+		Where("lower(a.actor->>'actor-uuid') IN ?", ids)
+}
+
 func GetEvidenceSearchByFilterQuery(latestQuery *gorm.DB, db *gorm.DB, filters ...labelfilter.Filter) (*gorm.DB, error) {
 	//sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
 	finalWhere := db.Session(&gorm.Session{})
@@ -81,7 +333,7 @@ func GetEvidenceSearchByFilterQuery(latestQuery *gorm.DB, db *gorm.DB, filters .
 
 func getScopeClause(db *gorm.DB, scope labelfilter.Scope) (*gorm.DB, error) {
 	if scope.IsCondition() {
-		return getConditionClause(db, *scope.Condition), nil
+		return getConditionClause(db, *scope.Condition)
 	} else if scope.IsQuery() {
 		return getQueryClause(db, *scope.Query)
 	}
@@ -89,44 +341,206 @@ func getScopeClause(db *gorm.DB, scope labelfilter.Scope) (*gorm.DB, error) {
 }
 
 func getQueryClause(db *gorm.DB, query labelfilter.Query) (*gorm.DB, error) {
-	var err error
-	if strings.ToLower(query.Operator) == "and" {
-		sub := db.Session(&gorm.Session{})
-		for _, scope := range query.Scopes {
-			sc := db.Session(&gorm.Session{})
-			sc, err = getScopeClause(sc, scope)
-			if err != nil {
-				return nil, err
-			}
-			sub = sub.Where(sc)
+	operator := strings.ToLower(query.Operator)
+	switch operator {
+	case labelfilter.QueryOperatorAnd, labelfilter.QueryOperatorOr, labelfilter.QueryOperatorNot:
+	default:
+		return db, errors.New("unrecognised query operator in label filter")
+	}
+	if operator == labelfilter.QueryOperatorNot && len(query.Scopes) != 1 {
+		return db, errors.New("label filter NOT query requires exactly one scope")
+	}
+
+	sub := db.Session(&gorm.Session{})
+	for _, scope := range query.Scopes {
+		sc, err := getScopeClause(db.Session(&gorm.Session{}), scope)
+		if err != nil {
+			return nil, err
 		}
-		return db.Where(sub), nil
-	} else if strings.ToLower(query.Operator) == "or" {
-		sub := db.Session(&gorm.Session{})
-		for _, scope := range query.Scopes {
-			sc := db.Session(&gorm.Session{})
-			sc, err = getScopeClause(sc, scope)
-			if err != nil {
-				return nil, err
-			}
+		switch operator {
+		case labelfilter.QueryOperatorAnd:
+			sub = sub.Where(sc)
+		case labelfilter.QueryOperatorOr:
 			sub = sub.Or(sc)
+		case labelfilter.QueryOperatorNot:
+			sub = sub.Not(sc)
 		}
-		return db.Where(sub), nil
 	}
-	return db, errors.New("unrecognised query operator in label filter")
+	return db.Where(sub), nil
 }
 
-func getConditionClause(db *gorm.DB, condition labelfilter.Condition) *gorm.DB {
+func getConditionClause(db *gorm.DB, condition labelfilter.Condition) (*gorm.DB, error) {
+	if err := condition.Validate(); err != nil {
+		return nil, err
+	}
+
 	sub := db.Session(&gorm.Session{})
+	if condition.IsTimeField() {
+		return getTimeConditionClause(sub, condition)
+	}
+
+	condition, err := resolveConditionIdentifiers(condition)
+	if err != nil {
+		return nil, err
+	}
+	values, err := getConditionValuesQuery(sub, condition)
+	if err != nil {
+		return nil, err
+	}
 	labelQuery := sub.
 		Select("1").
-		Table("evidence_labels el").
-		Where("el.evidence_id = l.id").
-		Where("lower(el.labels_name) = lower(?)", condition.Label).
-		Where("lower(el.labels_value) = lower(?)", condition.Value)
+		Table("(?) AS v", values)
 
-	if condition.Operator == "!=" {
-		return sub.Not("EXISTS(?)", labelQuery)
+	// Negated operators match evidence which has no value matching the positive form of the condition,
+	// including evidence which does not have the label or field at all.
+	negate := false
+	switch operator := condition.NormalizedOperator(); operator {
+	case labelfilter.OperatorNotEquals:
+		negate = true
+		fallthrough
+	case labelfilter.OperatorEquals:
+		labelQuery = labelQuery.Where("lower(v.field_value) = lower(?)", condition.Value)
+	case labelfilter.OperatorNotIn:
+		negate = true
+		fallthrough
+	case labelfilter.OperatorIn:
+		values := make([]string, 0, len(condition.Values))
+		for _, value := range condition.Values {
+			values = append(values, strings.ToLower(value))
+		}
+		labelQuery = labelQuery.Where("lower(v.field_value) IN ?", values)
+	case labelfilter.OperatorNotExists:
+		negate = true
+	case labelfilter.OperatorExists:
+	case labelfilter.OperatorPrefix:
+		labelQuery = labelQuery.Where("v.field_value ILIKE ?", escapeLike(condition.Value)+"%")
+	case labelfilter.OperatorSuffix:
+		labelQuery = labelQuery.Where("v.field_value ILIKE ?", "%"+escapeLike(condition.Value))
+	case labelfilter.OperatorLike:
+		labelQuery = labelQuery.Where("v.field_value ILIKE ?", condition.Value)
+	case labelfilter.OperatorNotRegex:
+		negate = true
+		fallthrough
+	case labelfilter.OperatorRegex:
+		labelQuery = labelQuery.Where("v.field_value ~ ?", condition.Value)
+	case labelfilter.OperatorGreaterThan, labelfilter.OperatorGreaterThanOrEqual, labelfilter.OperatorLessThan, labelfilter.OperatorLessThanOrEqual:
+		// The cast is guarded, so that values which are not numbers do not fail the whole query.
+		labelQuery = labelQuery.Where(
+			fmt.Sprintf("CASE WHEN v.field_value ~ ? THEN v.field_value::numeric END %s CAST(? AS numeric)", operator),
+			labelfilter.NumberPattern, condition.Value,
+		)
+	case labelfilter.OperatorSemverEquals, labelfilter.OperatorSemverGreaterThan, labelfilter.OperatorSemverGreaterThanOrEqual, labelfilter.OperatorSemverLessThan, labelfilter.OperatorSemverLessThanOrEqual:
+		version, err := labelfilter.ParseSemver(condition.Value)
+		if err != nil {
+			return nil, err
+		}
+		// Values which are not versions never match, and missing minor or patch versions are treated as zero.
+		labelQuery = labelQuery.Where(
+			fmt.Sprintf("CASE WHEN v.field_value ~ ? THEN %s END %s ARRAY[?, ?, ?]::numeric[]", semverArray, strings.TrimPrefix(operator, "semver")),
+			labelfilter.SemverPattern, labelfilter.SemverPattern, labelfilter.SemverPattern, labelfilter.SemverPattern,
+			version[0], version[1], version[2],
+		)
+	default:
+		return nil, fmt.Errorf("unsupported label filter operator %q", condition.Operator)
+	}
+
+	if negate {
+		return sub.Not("EXISTS(?)", labelQuery), nil
+	}
+	return sub.Where("EXISTS(?)", labelQuery), nil
+}
+
+// semverArray extracts the major, minor and patch versions of a value as a numeric array, so that versions
+// compare component by component. It takes labelfilter.SemverPattern as an argument for each of the three matches.
+const semverArray = "ARRAY[(regexp_match(v.field_value, ?))[1]::numeric, coalesce((regexp_match(v.field_value, ?))[2], '0')::numeric, coalesce((regexp_match(v.field_value, ?))[3], '0')::numeric]"
+
+// getConditionValuesQuery selects the values of the field of the condition for evidence aliased as l, as field_value.
+func getConditionValuesQuery(db *gorm.DB, condition labelfilter.Condition) (*gorm.DB, error) {
+	switch field := condition.NormalizedField(); field {
+	case labelfilter.FieldLabel:
+		return db.Raw("SELECT el.labels_value AS field_value FROM evidence_labels el WHERE el.evidence_id = l.id AND lower(el.labels_name) = lower(?)", condition.Label), nil
+	case labelfilter.FieldStatus:
+		return db.Raw("SELECT l.status->>'state' AS field_value WHERE l.status->>'state' IS NOT NULL"), nil
+	case labelfilter.FieldTitle:
+		return db.Raw("SELECT l.title AS field_value"), nil
+	case labelfilter.FieldVerification:
+		return db.Raw("SELECT l.verification AS field_value WHERE l.verification <> ''"), nil
+	case labelfilter.FieldSubject:
+		return db.Raw("SELECT s.subject_uuid::text AS field_value FROM evidence_subjects es JOIN select_subject_by_ids s ON s.assessment_subject_id = es.assessment_subject_id WHERE es.evidence_id = l.id"), nil
+	case labelfilter.FieldComponent:
+		return db.Raw("SELECT ec.system_component_id::text AS field_value FROM evidence_components ec WHERE ec.evidence_id = l.id"), nil
+	case labelfilter.FieldInventoryItem:
+		return db.Raw("SELECT ei.inventory_item_id::text AS field_value FROM evidence_inventory_items ei WHERE ei.evidence_id = l.id"), nil
+	case labelfilter.FieldOrigin:
+		return db.Raw("SELECT a.actor->>'actor-uuid' AS field_value FROM jsonb_array_elements(" + jsonArray("l.origins") + ") AS o(origin), jsonb_array_elements(" + jsonArray("o.origin->'actors'") + ") AS a(actor)"), nil
+	case labelfilter.FieldProp:
+		return db.Raw("SELECT p.prop->>'value' AS field_value FROM jsonb_array_elements("+jsonArray("l.props")+") AS p(prop) WHERE lower(p.prop->>'name') = lower(?)", condition.Label), nil
+	default:
+		return nil, fmt.Errorf("unsupported label filter field %q", condition.Field)
+	}
+}
+
+// ResolveFilterIdentifiers resolves the subject, component and inventory item identifiers used in a filter, as
+// searching does, so that the filter can be matched against evidence in memory.
+func ResolveFilterIdentifiers(filter labelfilter.Filter) (labelfilter.Filter, error) {
+	return filter.MapConditions(resolveConditionIdentifiers)
+}
+
+// resolveConditionIdentifiers replaces identifiers in equality conditions on subjects, components and inventory
+// items with the UUIDs they are stored under, so that evidence can be found using the identifiers agents send.
+// Values which are already UUIDs are kept as they are.
+func resolveConditionIdentifiers(condition labelfilter.Condition) (labelfilter.Condition, error) {
+	switch condition.NormalizedField() {
+	case labelfilter.FieldSubject, labelfilter.FieldComponent, labelfilter.FieldInventoryItem:
+	default:
+		return condition, nil
+	}
+	resolve := func(value string) (string, error) {
+		if _, err := uuid.Parse(value); err == nil {
+			return value, nil
+		}
+		id, err := internal.SeededUUID(map[string]string{"identifier": value})
+		return id.String(), err
+	}
+
+	var err error
+	switch condition.NormalizedOperator() {
+	case labelfilter.OperatorEquals, labelfilter.OperatorNotEquals:
+		condition.Value, err = resolve(condition.Value)
+	case labelfilter.OperatorIn, labelfilter.OperatorNotIn:
+		values := make([]string, 0, len(condition.Values))
+		for _, value := range condition.Values {
+			id, err := resolve(value)
+			if err != nil {
+				return condition, err
+			}
+			values = append(values, id)
+		}
+		condition.Values = values
 	}
-	return sub.Where("EXISTS(?)", labelQuery)
+	return condition, err
+}
+
+// jsonArray guards a JSON column which may hold null or be missing, so that it can be expanded as an array.
+func jsonArray(column string) string {
+	return fmt.Sprintf("CASE WHEN jsonb_typeof(%s) = 'array' THEN %s ELSE '[]'::jsonb END", column, column)
+}
+
+// getTimeConditionClause compares when evidence aliased as l was collected, resolving relative times against now.
+// The end of evidence which has been seen again since it was collected is when it was last seen.
+func getTimeConditionClause(db *gorm.DB, condition labelfilter.Condition) (*gorm.DB, error) {
+	at, err := labelfilter.ResolveTime(condition.Value, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	column := map[string]string{
+		labelfilter.FieldStart: "l.start",
+		labelfilter.FieldEnd:   `coalesce(l.last_seen_at, l."end")`,
+	}[condition.NormalizedField()]
+	return db.Where(fmt.Sprintf("%s %s ?", column, condition.NormalizedOperator()), at), nil
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern, so that value is matched literally.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
 }
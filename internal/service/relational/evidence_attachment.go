@@ -0,0 +1,58 @@
+package relational
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	oscalTypes_1_1_3 "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// EvidenceAttachment is a raw artifact collected alongside evidence, such as policy output, a screenshot or a
+// configuration dump. Its content is kept in a blob store under BlobKey.
+type EvidenceAttachment struct {
+	UUIDModel
+
+	EvidenceID  uuid.UUID                 `gorm:"uniqueIndex:evidence_attachment_name_idx,priority:1;not null" json:"-"`
+	Name        string                    `gorm:"uniqueIndex:evidence_attachment_name_idx,priority:2;not null" json:"name"`
+	Description string                    `json:"description,omitempty"`
+	MediaType   string                    `json:"media-type"`
+	Size        int64                     `json:"size"`
+	Hashes      datatypes.JSONSlice[Hash] `json:"hashes"`
+	BlobKey     string                    `gorm:"not null" json:"-"`
+}
+
+// Href is where the attachment of the evidence can be downloaded from the API.
+func (a *EvidenceAttachment) Href(evidenceID uuid.UUID) string {
+	return "/api/evidence/" + evidenceID.String() + "/attachments/" + url.PathEscape(a.Name)
+}
+
+// MarshalOscal references the attachment as relevant evidence, recording its media type, size and hashes as props.
+func (a *EvidenceAttachment) MarshalOscal(evidenceID uuid.UUID) oscalTypes_1_1_3.RelevantEvidence {
+	description := a.Description
+	if description == "" {
+		description = a.Name
+	}
+	props := []oscalTypes_1_1_3.Property{
+		{Name: "media-type", Value: a.MediaType},
+		{Name: "size", Value: strconv.FormatInt(a.Size, 10)},
+	}
+	for _, hash := range a.Hashes {
+		props = append(props, oscalTypes_1_1_3.Property{Name: "hash", Class: string(hash.Algorithm), Value: hash.Value})
+	}
+	return oscalTypes_1_1_3.RelevantEvidence{
+		Href:        a.Href(evidenceID),
+		Description: description,
+		Props:       &props,
+	}
+}
+
+// Blob holds the content of attachments kept in the database blob store, keyed by its SHA-256 hash.
+type Blob struct {
+	Key     string `gorm:"primaryKey"`
+	Content []byte `gorm:"not null"`
+	// StoredAt is when the content was last stored, so that content stored again is not swept before it is referenced.
+	StoredAt time.Time `gorm:"not null;default:now()"`
+}
@@ -0,0 +1,165 @@
+package relational
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EvidenceDiff describes what changed between two pieces of evidence of the same stream.
+type EvidenceDiff struct {
+	UUID uuid.UUID           `json:"uuid"`
+	From EvidenceDiffVersion `json:"from"`
+	To   EvidenceDiffVersion `json:"to"`
+
+	// Status is only set when the status changed.
+	Status         *EvidenceValueChange `json:"status,omitempty"`
+	Labels         EvidenceListDiff     `json:"labels"`
+	Props          EvidenceListDiff     `json:"props"`
+	Links          EvidenceListDiff     `json:"links"`
+	Subjects       EvidenceListDiff     `json:"subjects"`
+	Components     EvidenceListDiff     `json:"components"`
+	InventoryItems EvidenceListDiff     `json:"inventory-items"`
+	ActivitySteps  EvidenceListDiff     `json:"activity-steps"`
+}
+
+// EvidenceDiffVersion identifies one side of an EvidenceDiff.
+type EvidenceDiffVersion struct {
+	ID    *uuid.UUID `json:"id"`
+	End   time.Time  `json:"end"`
+	State string     `json:"state"`
+}
+
+// EvidenceValueChange holds a value before and after a change.
+type EvidenceValueChange struct {
+	From any `json:"from"`
+	To   any `json:"to"`
+}
+
+// EvidenceDiffChange is an item present on both sides of a diff with different contents, identified by Key.
+type EvidenceDiffChange struct {
+	Key  string `json:"key"`
+	From any    `json:"from"`
+	To   any    `json:"to"`
+}
+
+// EvidenceListDiff lists the items added, removed and changed between two lists.
+type EvidenceListDiff struct {
+	Added   []any                `json:"added"`
+	Removed []any                `json:"removed"`
+	Changed []EvidenceDiffChange `json:"changed"`
+}
+
+// evidenceDiffSubject is how subjects are compared, leaving out the identifiers which are generated for every
+// piece of evidence.
+type evidenceDiffSubject struct {
+	Type         string      `json:"type"`
+	SubjectUUIDs []uuid.UUID `json:"subject-uuids"`
+	Description  *string     `json:"description,omitempty"`
+	Remarks      *string     `json:"remarks,omitempty"`
+}
+
+// DiffEvidence compares evidence from with the later evidence to. Associations must be loaded on both for them to
+// be compared: labels, components, inventory items, subjects with their included subjects, and activities with
+// their steps.
+func DiffEvidence(from *Evidence, to *Evidence) EvidenceDiff {
+	diff := EvidenceDiff{
+		UUID: to.UUID,
+		From: EvidenceDiffVersion{ID: from.ID, End: from.End, State: from.Status.Data().State},
+		To:   EvidenceDiffVersion{ID: to.ID, End: to.End, State: to.Status.Data().State},
+	}
+	if !jsonEqual(from.Status, to.Status) {
+		diff.Status = &EvidenceValueChange{From: from.Status.Data(), To: to.Status.Data()}
+	}
+
+	diff.Labels = diffEvidenceList(from.Labels, to.Labels, func(label Labels) (string, any) {
+		return label.Name, label
+	})
+	diff.Props = diffEvidenceList(from.Props, to.Props, func(prop Prop) (string, any) {
+		if prop.Ns != "" {
+			return prop.Ns + ":" + prop.Name, prop
+		}
+		return prop.Name, prop
+	})
+	diff.Links = diffEvidenceList(from.Links, to.Links, func(link Link) (string, any) {
+		return link.Href + " " + link.Rel, link
+	})
+	diff.Subjects = diffEvidenceList(from.Subjects, to.Subjects, func(subject AssessmentSubject) (string, any) {
+		view := evidenceDiffSubject{Type: subject.Type, Description: subject.Description, Remarks: subject.Remarks}
+		ids := []string{}
+		for _, include := range subject.IncludeSubjects {
+			view.SubjectUUIDs = append(view.SubjectUUIDs, include.SubjectUUID)
+			ids = append(ids, include.SubjectUUID.String())
+		}
+		slices.Sort(ids)
+		return subject.Type + "/" + strings.Join(ids, ","), view
+	})
+	diff.Components = diffEvidenceList(from.Components, to.Components, func(component SystemComponent) (string, any) {
+		return uuidKey(component.ID), component
+	})
+	diff.InventoryItems = diffEvidenceList(from.InventoryItems, to.InventoryItems, func(item InventoryItem) (string, any) {
+		return uuidKey(item.ID), item
+	})
+	diff.ActivitySteps = diffEvidenceList(evidenceSteps(from), evidenceSteps(to), func(step Step) (string, any) {
+		return step.ActivityID.String() + "/" + uuidKey(step.ID), step
+	})
+	return diff
+}
+
+func evidenceSteps(evidence *Evidence) []Step {
+	steps := []Step{}
+	for _, activity := range evidence.Activities {
+		steps = append(steps, activity.Steps...)
+	}
+	return steps
+}
+
+func uuidKey(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+// diffEvidenceList compares two lists of items, matching items by the key returned by view, and comparing the
+// value it returns. Repeated keys are matched in the order they appear.
+func diffEvidenceList[T any](from []T, to []T, view func(T) (string, any)) EvidenceListDiff {
+	diff := EvidenceListDiff{Added: []any{}, Removed: []any{}, Changed: []EvidenceDiffChange{}}
+
+	keyed := func(items []T) ([]string, map[string]any) {
+		keys := []string{}
+		values := map[string]any{}
+		occurrences := map[string]int{}
+		for _, item := range items {
+			key, value := view(item)
+			occurrences[key]++
+			if n := occurrences[key]; n > 1 {
+				key += "#" + strconv.Itoa(n)
+			}
+			keys = append(keys, key)
+			values[key] = value
+		}
+		return keys, values
+	}
+	fromKeys, fromValues := keyed(from)
+	toKeys, toValues := keyed(to)
+
+	for _, key := range fromKeys {
+		if _, ok := toValues[key]; !ok {
+			diff.Removed = append(diff.Removed, fromValues[key])
+		}
+	}
+	for _, key := range toKeys {
+		previous, ok := fromValues[key]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, toValues[key])
+		case !jsonEqual(previous, toValues[key]):
+			diff.Changed = append(diff.Changed, EvidenceDiffChange{Key: key, From: previous, To: toValues[key]})
+		}
+	}
+	return diff
+}
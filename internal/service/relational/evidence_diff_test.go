@@ -0,0 +1,99 @@
+package relational
+
+import (
+	"testing"
+
+	oscalTypes_1_1_3 "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+func TestDiffEvidence(t *testing.T) {
+	stream := uuid.New()
+	component, added := uuid.New(), uuid.New()
+	subject := uuid.New()
+	activity := uuid.New()
+	stepA, stepB := uuid.New(), uuid.New()
+
+	from := &Evidence{
+		UUID:   stream,
+		Status: datatypes.NewJSONType(oscalTypes_1_1_3.ObjectiveStatus{State: "satisfied"}),
+		Labels: []Labels{{Name: "provider", Value: "aws"}, {Name: "env", Value: "prod"}},
+		Props: datatypes.NewJSONSlice([]Prop{
+			{Name: "port", Value: "22"},
+			{Name: "port", Value: "2222"},
+		}),
+		Links:      datatypes.NewJSONSlice([]Link{{Href: "https://example.com/runbook", Rel: "help"}}),
+		Components: []SystemComponent{{UUIDModel: UUIDModel{ID: &component}, Title: "SSH"}},
+		Subjects: []AssessmentSubject{
+			{UUIDModel: UUIDModel{ID: randomID()}, Type: "inventory-item", IncludeSubjects: []SelectSubjectById{{SubjectUUID: subject}}},
+		},
+		Activities: []Activity{{UUIDModel: UUIDModel{ID: &activity}, Steps: []Step{
+			{UUIDModel: UUIDModel{ID: &stepA}, ActivityID: activity, Description: "Read config"},
+		}}},
+	}
+	to := &Evidence{
+		UUID:   stream,
+		Status: datatypes.NewJSONType(oscalTypes_1_1_3.ObjectiveStatus{State: "not-satisfied", Reason: "fail"}),
+		Labels: []Labels{{Name: "provider", Value: "aws"}, {Name: "env", Value: "staging"}, {Name: "team", Value: "core"}},
+		Props: datatypes.NewJSONSlice([]Prop{
+			{Name: "port", Value: "22"},
+		}),
+		Components: []SystemComponent{{UUIDModel: UUIDModel{ID: &component}, Title: "SSH"}, {UUIDModel: UUIDModel{ID: &added}, Title: "Firewall"}},
+		Subjects: []AssessmentSubject{
+			// Subjects are recreated for every piece of evidence, so only their contents are compared.
+			{UUIDModel: UUIDModel{ID: randomID()}, Type: "inventory-item", IncludeSubjects: []SelectSubjectById{{SubjectUUID: subject}}},
+		},
+		Activities: []Activity{{UUIDModel: UUIDModel{ID: &activity}, Steps: []Step{
+			{UUIDModel: UUIDModel{ID: &stepA}, ActivityID: activity, Description: "Read configuration"},
+			{UUIDModel: UUIDModel{ID: &stepB}, ActivityID: activity, Description: "Evaluate policy"},
+		}}},
+	}
+
+	diff := DiffEvidence(from, to)
+	assert.Equal(t, stream, diff.UUID)
+	assert.Equal(t, "satisfied", diff.From.State)
+	assert.Equal(t, "not-satisfied", diff.To.State)
+
+	require.NotNil(t, diff.Status)
+	assert.Equal(t, "not-satisfied", diff.Status.To.(oscalTypes_1_1_3.ObjectiveStatus).State)
+
+	assert.Equal(t, []any{Labels{Name: "team", Value: "core"}}, diff.Labels.Added)
+	assert.Empty(t, diff.Labels.Removed)
+	assert.Equal(t, []EvidenceDiffChange{{Key: "env", From: Labels{Name: "env", Value: "prod"}, To: Labels{Name: "env", Value: "staging"}}}, diff.Labels.Changed)
+
+	assert.Equal(t, []any{Prop{Name: "port", Value: "2222"}}, diff.Props.Removed)
+	assert.Empty(t, diff.Props.Added)
+	assert.Empty(t, diff.Props.Changed)
+
+	assert.Len(t, diff.Links.Removed, 1)
+
+	require.Len(t, diff.Components.Added, 1)
+	assert.Equal(t, "Firewall", diff.Components.Added[0].(SystemComponent).Title)
+	assert.Empty(t, diff.Components.Removed)
+
+	assert.Empty(t, diff.Subjects.Added)
+	assert.Empty(t, diff.Subjects.Removed)
+	assert.Empty(t, diff.Subjects.Changed)
+
+	assert.Len(t, diff.ActivitySteps.Added, 1)
+	require.Len(t, diff.ActivitySteps.Changed, 1)
+	assert.Equal(t, "Read configuration", diff.ActivitySteps.Changed[0].To.(Step).Description)
+
+	t.Run("Identical", func(t *testing.T) {
+		diff := DiffEvidence(from, from)
+		assert.Nil(t, diff.Status)
+		for _, list := range []EvidenceListDiff{diff.Labels, diff.Props, diff.Links, diff.Subjects, diff.Components, diff.InventoryItems, diff.ActivitySteps} {
+			assert.Empty(t, list.Added)
+			assert.Empty(t, list.Removed)
+			assert.Empty(t, list.Changed)
+		}
+	})
+}
+
+func randomID() *uuid.UUID {
+	id := uuid.New()
+	return &id
+}
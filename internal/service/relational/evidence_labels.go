@@ -0,0 +1,48 @@
+package relational
+
+import (
+	"gorm.io/gorm"
+)
+
+// EvidenceLabelName summarises a label name across a set of evidence, counting its distinct values and the
+// evidence carrying it.
+type EvidenceLabelName struct {
+	Name       string `json:"name"`
+	ValueCount int64  `json:"values"`
+	Streams    int64  `json:"streams"`
+	// HighCardinality is set when the label has more distinct values than is useful to filter on, such as a
+	// hostname label with a value for every stream.
+	HighCardinality bool `json:"high-cardinality,omitempty" gorm:"-"`
+}
+
+// EvidenceLabelValue is a value of a label, with the number of evidence carrying it.
+type EvidenceLabelValue struct {
+	Value   string `json:"value"`
+	Streams int64  `json:"streams"`
+}
+
+// GetEvidenceLabelNamesQuery selects the label names used by the evidence selected by evidence, aliased as l, such
+// as the query returned by GetEvidenceSearchByFilterQuery. Names and values are grouped regardless of case, as filters
+// match them, and names are reported in lower case. Names are scanned into EvidenceLabelName.
+func GetEvidenceLabelNamesQuery(db *gorm.DB, evidence *gorm.DB) *gorm.DB {
+	return db.Table("evidence_labels el").
+		Select("lower(el.labels_name) AS name, count(DISTINCT lower(el.labels_value)) AS value_count, count(DISTINCT el.evidence_id) AS streams").
+		Where("el.evidence_id IN (?)", evidence.Select("l.id")).
+		Group("lower(el.labels_name)")
+}
+
+// GetEvidenceLabelValuesQuery selects the values of the label name used by the evidence selected by evidence, as
+// GetEvidenceLabelNamesQuery does. Names are matched and values grouped regardless of case, as filters match them, and
+// values are reported in lower case. Values may be limited to those starting with prefix. Values are scanned into
+// EvidenceLabelValue.
+func GetEvidenceLabelValuesQuery(db *gorm.DB, evidence *gorm.DB, name string, prefix string) *gorm.DB {
+	query := db.Table("evidence_labels el").
+		Select("lower(el.labels_value) AS value, count(DISTINCT el.evidence_id) AS streams").
+		Where("el.evidence_id IN (?)", evidence.Select("l.id")).
+		Where("lower(el.labels_name) = lower(?)", name).
+		Group("lower(el.labels_value)")
+	if prefix != "" {
+		query = query.Where("el.labels_value ILIKE ?", escapeLike(prefix)+"%")
+	}
+	return query
+}
@@ -0,0 +1,106 @@
+package relational
+
+import (
+	"testing"
+	"time"
+
+	"github.com/compliance-framework/api/internal/converters/labelfilter"
+	oscalTypes_1_1_3 "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+)
+
+func TestEvidence_SameObservation(t *testing.T) {
+	component := uuid.New()
+	subject := uuid.New()
+	newEvidence := func() *Evidence {
+		return &Evidence{
+			Title: "Evidence",
+			Labels: []Labels{
+				{Name: "provider", Value: "aws"},
+				{Name: "service", Value: "EC2"},
+			},
+			Components: []SystemComponent{
+				{UUIDModel: UUIDModel{ID: &component}},
+			},
+			Subjects: []AssessmentSubject{
+				{Type: "inventory-item", IncludeSubjects: []SelectSubjectById{{SubjectUUID: subject}}},
+			},
+			Props:  datatypes.NewJSONSlice([]Prop{{Name: "region", Value: "eu-west-1"}}),
+			Status: datatypes.NewJSONType(oscalTypes_1_1_3.ObjectiveStatus{State: "satisfied"}),
+		}
+	}
+
+	t.Run("Identical", func(t *testing.T) {
+		assert.True(t, newEvidence().SameObservation(newEvidence()))
+	})
+
+	t.Run("Ordering and unrelated fields are ignored", func(t *testing.T) {
+		other := newEvidence()
+		other.Title = "Another title"
+		other.Labels[0], other.Labels[1] = other.Labels[1], other.Labels[0]
+		assert.True(t, newEvidence().SameObservation(other))
+	})
+
+	t.Run("Empty props", func(t *testing.T) {
+		a, b := newEvidence(), newEvidence()
+		a.Props = nil
+		b.Props = datatypes.JSONSlice[Prop]{}
+		assert.True(t, a.SameObservation(b))
+	})
+
+	changes := map[string]func(e *Evidence){
+		"Status": func(e *Evidence) {
+			e.Status = datatypes.NewJSONType(oscalTypes_1_1_3.ObjectiveStatus{State: "not-satisfied"})
+		},
+		"Label value": func(e *Evidence) { e.Labels[0].Value = "gcp" },
+		"Extra label": func(e *Evidence) { e.Labels = append(e.Labels, Labels{Name: "region", Value: "eu"}) },
+		"Component": func(e *Evidence) {
+			id := uuid.New()
+			e.Components[0].ID = &id
+		},
+		"Subject":      func(e *Evidence) { e.Subjects[0].IncludeSubjects[0].SubjectUUID = uuid.New() },
+		"Subject type": func(e *Evidence) { e.Subjects[0].Type = "component" },
+		"Props":        func(e *Evidence) { e.Props[0].Value = "us-east-1" },
+		"Attachment":   func(e *Evidence) { e.Attachments = []EvidenceAttachment{{Name: "config.txt", BlobKey: "abc"}} },
+		"Verification": func(e *Evidence) { e.Verification = EvidenceVerificationVerified },
+		"Signing key": func(e *Evidence) {
+			id := uuid.New()
+			e.SigningKeyID = &id
+		},
+	}
+	for name, change := range changes {
+		t.Run("Changed "+name, func(t *testing.T) {
+			other := newEvidence()
+			change(other)
+			assert.False(t, newEvidence().SameObservation(other))
+		})
+	}
+}
+
+func TestEvidence_IsStale(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Hour)
+	lastSeen := now.Add(-2 * time.Hour)
+
+	assert.False(t, (&Evidence{End: now.Add(-48 * time.Hour)}).IsStale(now, 0), "without a window, only expiry is considered")
+	assert.True(t, (&Evidence{End: now, Expires: &past}).IsStale(now, 0))
+	assert.False(t, (&Evidence{End: now, Expires: &future}).IsStale(now, 0))
+	assert.True(t, (&Evidence{End: now.Add(-48 * time.Hour), Expires: &future}).IsStale(now, 24*time.Hour))
+	assert.False(t, (&Evidence{End: now.Add(-48 * time.Hour), LastSeenAt: &lastSeen}).IsStale(now, 24*time.Hour))
+	assert.True(t, (&Evidence{End: now.Add(-48 * time.Hour), LastSeenAt: &lastSeen}).IsStale(now, time.Hour))
+}
+
+func TestEvidence_FilterTime(t *testing.T) {
+	start := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	evidence := &Evidence{Start: start, End: start.Add(time.Minute)}
+	assert.Equal(t, start, evidence.FilterTime(labelfilter.FieldStart))
+	assert.Equal(t, start.Add(time.Minute), evidence.FilterTime(labelfilter.FieldEnd))
+
+	lastSeen := start.Add(time.Hour)
+	evidence.LastSeenAt = &lastSeen
+	assert.Equal(t, start, evidence.FilterTime(labelfilter.FieldStart))
+	assert.Equal(t, lastSeen, evidence.FilterTime(labelfilter.FieldEnd), "evidence seen again ends when it was last seen")
+}
@@ -0,0 +1,115 @@
+package relational
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EvidenceStatusSatisfied is the state of evidence which passed its check. Any other state is treated as a failure
+// when measuring how long streams take to recover.
+const EvidenceStatusSatisfied = "satisfied"
+
+// EvidenceTransition records a change in the state of an evidence stream, from the state of its previous latest
+// evidence to the state of newly created evidence. The first evidence of a stream is recorded as a transition from
+// an empty state, so that streams which start out failing are measured from their first evidence.
+//
+// Evidence IDs are kept as plain references rather than foreign keys, so that transitions outlive evidence removed
+// by retention.
+type EvidenceTransition struct {
+	UUIDModel
+
+	// UUID is the evidence stream which changed state.
+	UUID               uuid.UUID  `gorm:"index:evidence_transition_stream_idx,priority:1;not null" json:"uuid"`
+	PreviousState      string     `json:"previous-state"`
+	State              string     `json:"state"`
+	Timestamp          time.Time  `gorm:"index:evidence_transition_stream_idx,priority:2;index" json:"timestamp"`
+	PreviousEvidenceID *uuid.UUID `json:"previous-evidence-id,omitempty"`
+	EvidenceID         uuid.UUID  `gorm:"index;not null" json:"evidence-id"`
+}
+
+// NewEvidenceTransition returns the transition made by evidence succeeding previous as the latest evidence of its
+// stream, or nil when the state is unchanged or the evidence does not succeed previous. previous is nil for the
+// first evidence of a stream.
+func NewEvidenceTransition(evidence *Evidence, previous *Evidence) *EvidenceTransition {
+	transition := &EvidenceTransition{
+		UUID:       evidence.UUID,
+		State:      evidence.Status.Data().State,
+		Timestamp:  evidence.End,
+		EvidenceID: *evidence.ID,
+	}
+	if previous == nil {
+		return transition
+	}
+	if !evidence.End.After(previous.End) {
+		return nil
+	}
+	transition.PreviousState = previous.Status.Data().State
+	transition.PreviousEvidenceID = previous.ID
+	if transition.PreviousState == transition.State {
+		return nil
+	}
+	return transition
+}
+
+// IsInitial reports whether the transition records the first state of a stream.
+func (t *EvidenceTransition) IsInitial() bool {
+	return t.PreviousEvidenceID == nil
+}
+
+// EvidenceRecovery summarises how often an evidence stream failed, and how long it took to recover.
+type EvidenceRecovery struct {
+	UUID       uuid.UUID `json:"uuid"`
+	Failures   int       `json:"failures"`
+	Recoveries int       `json:"recoveries"`
+	// MeanTimeToRecover is the mean time, in seconds, from a stream failing to it being satisfied again.
+	MeanTimeToRecover float64 `json:"mean-time-to-recover"`
+	// FailingSince is when the stream last failed, if it has not yet recovered.
+	FailingSince *time.Time `json:"failing-since,omitempty"`
+}
+
+// GetEvidenceRecoveriesQuery computes recovery statistics for each stream from the transitions selected by
+// transitions, which is a query of EvidenceTransition. Failures and recoveries are counted when they happen between
+// from and to, which are ignored when zero. Recoveries are timed from the failure preceding them, even when it
+// happened before from. Statistics are scanned into EvidenceRecovery, ordered by stream.
+func GetEvidenceRecoveriesQuery(db *gorm.DB, transitions *gorm.DB, from time.Time, to time.Time) *gorm.DB {
+	failing := func(column string) string {
+		return fmt.Sprintf("(coalesce(%s, '') NOT IN ('', '%s'))", column, EvidenceStatusSatisfied)
+	}
+	bounds := map[string]any{"from": nil, "to": nil}
+	if !from.IsZero() {
+		bounds["from"] = from
+	}
+	if !to.IsZero() {
+		bounds["to"] = to
+		transitions = transitions.Where(`evidence_transitions."timestamp" <= ?`, to)
+	}
+
+	marked := transitions.Select(fmt.Sprintf(`evidence_transitions.uuid, evidence_transitions."timestamp",
+		%s AND NOT %s AS fails,
+		NOT %s AND %s AS recovers`,
+		failing("evidence_transitions.state"), failing("evidence_transitions.previous_state"),
+		failing("evidence_transitions.state"), failing("evidence_transitions.previous_state"),
+	))
+	// Each transition is paired with the latest failure and recovery of its stream before it, so that recoveries
+	// are only timed from failures they have not already recovered from.
+	earlier := `OVER (PARTITION BY uuid ORDER BY "timestamp" ROWS BETWEEN UNBOUNDED PRECEDING AND 1 PRECEDING)`
+	windowed := db.Table("(?) AS marked", marked).Select(fmt.Sprintf(`marked.*,
+		(CAST(@from AS timestamptz) IS NULL OR "timestamp" >= @from) AND (CAST(@to AS timestamptz) IS NULL OR "timestamp" <= @to) AS in_range,
+		max("timestamp") FILTER (WHERE fails) %s AS failed_at,
+		max("timestamp") FILTER (WHERE recovers) %s AS recovered_at`, earlier, earlier), bounds)
+	timed := db.Table("(?) AS windowed", windowed).Select(`*,
+		recovers AND failed_at IS NOT NULL AND (recovered_at IS NULL OR failed_at > recovered_at) AS timed`)
+
+	return db.Table("(?) AS recoveries", timed).
+		Select(`uuid,
+			count(*) FILTER (WHERE fails AND in_range) AS failures,
+			count(*) FILTER (WHERE timed AND in_range) AS recoveries,
+			coalesce(CAST(extract(epoch FROM avg("timestamp" - failed_at) FILTER (WHERE timed AND in_range)) AS double precision), 0) AS mean_time_to_recover,
+			CASE WHEN max("timestamp") FILTER (WHERE fails) > coalesce(max("timestamp") FILTER (WHERE recovers), '-infinity')
+				THEN max("timestamp") FILTER (WHERE fails) END AS failing_since`).
+		Group("uuid").
+		Order("uuid")
+}
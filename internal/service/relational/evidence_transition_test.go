@@ -0,0 +1,47 @@
+package relational
+
+import (
+	"testing"
+	"time"
+
+	oscalTypes_1_1_3 "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+func TestNewEvidenceTransition(t *testing.T) {
+	stream := uuid.New()
+	start := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	evidence := func(state string, end time.Time) *Evidence {
+		id := uuid.New()
+		return &Evidence{
+			UUIDModel: UUIDModel{ID: &id},
+			UUID:      stream,
+			End:       end,
+			Status:    datatypes.NewJSONType(oscalTypes_1_1_3.ObjectiveStatus{State: state}),
+		}
+	}
+
+	first := evidence("not-satisfied", start)
+	initial := NewEvidenceTransition(first, nil)
+	require.NotNil(t, initial)
+	assert.True(t, initial.IsInitial())
+	assert.Equal(t, "", initial.PreviousState)
+	assert.Equal(t, "not-satisfied", initial.State)
+	assert.Equal(t, stream, initial.UUID)
+
+	second := evidence("satisfied", start.Add(time.Hour))
+	flip := NewEvidenceTransition(second, first)
+	require.NotNil(t, flip)
+	assert.False(t, flip.IsInitial())
+	assert.Equal(t, "not-satisfied", flip.PreviousState)
+	assert.Equal(t, "satisfied", flip.State)
+	assert.Equal(t, *first.ID, *flip.PreviousEvidenceID)
+	assert.Equal(t, *second.ID, flip.EvidenceID)
+	assert.Equal(t, second.End, flip.Timestamp)
+
+	assert.Nil(t, NewEvidenceTransition(evidence("satisfied", start.Add(2*time.Hour)), second), "unchanged state")
+	assert.Nil(t, NewEvidenceTransition(evidence("not-satisfied", start.Add(-time.Hour)), second), "backfilled evidence")
+}
@@ -0,0 +1,166 @@
+package relational
+
+import (
+	"slices"
+)
+
+// Permission allows access to a group of API routes. Each route declares the permission it needs.
+type Permission string
+
+const (
+	// PermissionOSCALRead allows reading every OSCAL document: catalogs, profiles, component definitions, system
+	// security plans, POA&Ms, assessment plans and assessment results.
+	PermissionOSCALRead Permission = "oscal:read"
+	// PermissionCatalogWrite allows changing catalogs, profiles and component definitions, and resolving profiles.
+	PermissionCatalogWrite Permission = "catalog:write"
+	// PermissionSSPWrite allows changing system security plans.
+	PermissionSSPWrite Permission = "ssp:write"
+	// PermissionPOAMWrite allows changing plans of action and milestones.
+	PermissionPOAMWrite Permission = "poam:write"
+	// PermissionAssessmentWrite allows changing assessment plans, their activities, and assessment results.
+	PermissionAssessmentWrite Permission = "assessment:write"
+	// PermissionEvidenceRead allows reading evidence, filters, agents, heartbeats and alerts.
+	PermissionEvidenceRead Permission = "evidence:read"
+	// PermissionEvidenceWrite allows sending evidence and heartbeats.
+	PermissionEvidenceWrite Permission = "evidence:write"
+	// PermissionFilterWrite allows changing filters, and the controls they are attached to.
+	PermissionFilterWrite Permission = "filter:write"
+	// PermissionAlertWrite allows changing alert rules.
+	PermissionAlertWrite Permission = "alert:write"
+	// PermissionWebhookManage allows managing webhook subscriptions, which is not split into read and write as
+	// subscriptions may carry credentials of the systems they post to.
+	PermissionWebhookManage Permission = "webhook:manage"
+	// PermissionGrantManage allows granting users and roles access to systems, which limits them to those systems.
+	PermissionGrantManage Permission = "grant:manage"
+	// PermissionSystemAll allows access to every system, along with the documents importing it, regardless of system
+	// grants. Users without it only have access to the systems granted to them or their roles, so removing it from a
+	// role limits its users to their grants.
+	PermissionSystemAll Permission = "system:all"
+	// PermissionUserManage allows listing users, unlocking those locked after too many failed logins, and reading the
+	// audit log.
+	PermissionUserManage Permission = "user:manage"
+)
+
+var Permissions = []Permission{
+	PermissionOSCALRead,
+	PermissionCatalogWrite,
+	PermissionSSPWrite,
+	PermissionPOAMWrite,
+	PermissionAssessmentWrite,
+	PermissionEvidenceRead,
+	PermissionEvidenceWrite,
+	PermissionFilterWrite,
+	PermissionAlertWrite,
+	PermissionWebhookManage,
+	PermissionGrantManage,
+	PermissionSystemAll,
+	PermissionUserManage,
+}
+
+// Roles created by the migrator. Their permissions may be changed afterwards, and further roles may be added.
+const (
+	RoleAdmin             = "admin"
+	RoleComplianceOfficer = "compliance-officer"
+	RoleAssessor          = "assessor"
+	RoleReadOnly          = "read-only"
+	RoleAgent             = "agent"
+)
+
+// DefaultAccessRoles returns the roles created by the migrator, with their permissions.
+func DefaultAccessRoles() []AccessRole {
+	return []AccessRole{
+		NewAccessRole(RoleAdmin, "Full access to the API", Permissions...),
+		NewAccessRole(RoleComplianceOfficer, "Maintains catalogs, system security plans, POA&Ms, filters and alerts",
+			PermissionOSCALRead,
+			PermissionCatalogWrite,
+			PermissionSSPWrite,
+			PermissionPOAMWrite,
+			PermissionEvidenceRead,
+			PermissionFilterWrite,
+			PermissionAlertWrite,
+			PermissionSystemAll,
+		),
+		NewAccessRole(RoleAssessor, "Records assessments, their results and the POA&M items they raise",
+			PermissionOSCALRead,
+			PermissionAssessmentWrite,
+			PermissionPOAMWrite,
+			PermissionEvidenceRead,
+			PermissionSystemAll,
+		),
+		NewAccessRole(RoleReadOnly, "Reads OSCAL documents and evidence",
+			PermissionOSCALRead,
+			PermissionEvidenceRead,
+			PermissionSystemAll,
+		),
+		NewAccessRole(RoleAgent, "Sends and reads evidence, for agents authenticating as users",
+			PermissionEvidenceRead,
+			PermissionEvidenceWrite,
+		),
+	}
+}
+
+// AccessRole is a named set of permissions granted to users. The roles of a user, and their permissions, are
+// embedded in the tokens issued to the user, so changes apply from the next time the user logs in.
+type AccessRole struct {
+	Name        string                 `json:"name" gorm:"primaryKey"`
+	Description string                 `json:"description"`
+	Permissions []AccessRolePermission `json:"-" gorm:"foreignKey:RoleName;constraint:OnDelete:CASCADE"`
+}
+
+func (AccessRole) TableName() string {
+	return "ccf_roles"
+}
+
+// NewAccessRole creates a role granting the given permissions.
+func NewAccessRole(name string, description string, permissions ...Permission) AccessRole {
+	role := AccessRole{Name: name, Description: description}
+	for _, permission := range permissions {
+		role.Permissions = append(role.Permissions, AccessRolePermission{RoleName: name, Permission: permission})
+	}
+	return role
+}
+
+// AccessRolePermission grants a permission to a role.
+type AccessRolePermission struct {
+	RoleName   string     `gorm:"primaryKey"`
+	Permission Permission `gorm:"primaryKey"`
+}
+
+func (AccessRolePermission) TableName() string {
+	return "ccf_role_permissions"
+}
+
+// Permissions returns the permissions granted by any of the roles of the user, which must be loaded along with
+// their permissions. Each permission is returned once, in the order of Permissions, followed by any others.
+func (u *User) Permissions() []Permission {
+	granted := []Permission{}
+	for _, role := range u.Roles {
+		for _, permission := range role.Permissions {
+			if !slices.Contains(granted, permission.Permission) {
+				granted = append(granted, permission.Permission)
+			}
+		}
+	}
+	slices.SortStableFunc(granted, func(a, b Permission) int {
+		return permissionIndex(a) - permissionIndex(b)
+	})
+	return granted
+}
+
+func permissionIndex(permission Permission) int {
+	if i := slices.Index(Permissions, permission); i >= 0 {
+		return i
+	}
+	return len(Permissions)
+}
+
+// Permissions returns the permissions granted to agents authenticating with a credential of the scope.
+func (s AgentCredentialScope) Permissions() []Permission {
+	switch s {
+	case AgentCredentialScopeIngest:
+		return []Permission{PermissionEvidenceWrite}
+	case AgentCredentialScopeRead:
+		return []Permission{PermissionEvidenceRead}
+	}
+	return nil
+}
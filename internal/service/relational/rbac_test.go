@@ -0,0 +1,42 @@
+package relational
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessRoles(t *testing.T) {
+	t.Run("Default roles only grant known permissions", func(t *testing.T) {
+		for _, role := range DefaultAccessRoles() {
+			assert.NotEmpty(t, role.Permissions, role.Name)
+			for _, permission := range role.Permissions {
+				assert.Equal(t, role.Name, permission.RoleName)
+				assert.Contains(t, Permissions, permission.Permission, role.Name)
+			}
+		}
+	})
+
+	t.Run("Admins are granted every permission", func(t *testing.T) {
+		user := &User{Roles: []AccessRole{NewAccessRole(RoleAdmin, "", Permissions...)}}
+		assert.Equal(t, Permissions, user.Permissions())
+	})
+
+	t.Run("Users are granted the permissions of all of their roles once", func(t *testing.T) {
+		user := &User{Roles: []AccessRole{
+			NewAccessRole("poam", "", PermissionPOAMWrite, PermissionOSCALRead),
+			NewAccessRole("ssp", "", PermissionSSPWrite, PermissionOSCALRead),
+		}}
+		assert.Equal(t, []Permission{PermissionOSCALRead, PermissionSSPWrite, PermissionPOAMWrite}, user.Permissions())
+	})
+
+	t.Run("Users without roles are granted nothing", func(t *testing.T) {
+		assert.Empty(t, (&User{}).Permissions())
+	})
+
+	t.Run("Agent credentials are granted the permissions of their scope", func(t *testing.T) {
+		assert.Equal(t, []Permission{PermissionEvidenceWrite}, AgentCredentialScopeIngest.Permissions())
+		assert.Equal(t, []Permission{PermissionEvidenceRead}, AgentCredentialScopeRead.Permissions())
+		assert.Empty(t, AgentCredentialScope("admin").Permissions())
+	})
+}
@@ -0,0 +1,67 @@
+package relational
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session is a login of a user, kept alive by refresh tokens which are rotated on every use.
+// Only hashes of the current refresh token and of the one it replaced are stored. The previous token being used
+// again means it was stolen, and revokes the session.
+type Session struct {
+	UUIDModel
+	CreatedAt time.Time `json:"createdAt"`
+
+	UserID uuid.UUID `json:"userId" gorm:"type:uuid;not null;index"`
+	User   *User     `json:"-" gorm:"constraint:OnDelete:CASCADE"`
+
+	RefreshTokenHash         string  `json:"-" gorm:"uniqueIndex;not null"`
+	PreviousRefreshTokenHash *string `json:"-" gorm:"index"`
+
+	// ExpiresAt is when the current refresh token expires. It is extended every time the token is rotated.
+	ExpiresAt  time.Time  `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+}
+
+func (Session) TableName() string {
+	return "ccf_sessions"
+}
+
+// IsActive reports whether the session was neither revoked nor expired at the given time.
+func (s *Session) IsActive(at time.Time) bool {
+	return s.RevokedAt == nil && s.ExpiresAt.After(at)
+}
+
+// RevokedToken is an entry of the revocation list checked for every access token. Entries are either the ID of a
+// token, or the ID of a session revoking every token issued for it, and are kept until those tokens have expired.
+type RevokedToken struct {
+	ID        string    `gorm:"primaryKey"`
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt" gorm:"index;not null"`
+}
+
+func (RevokedToken) TableName() string {
+	return "ccf_revoked_tokens"
+}
+
+// NewRefreshToken generates a refresh token, and returns it along with the hash stored for it.
+func NewRefreshToken() (string, string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(secret)
+	return token, HashRefreshToken(token), nil
+}
+
+// HashRefreshToken returns the hash stored for a refresh token, which sessions are looked up by.
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
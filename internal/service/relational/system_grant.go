@@ -0,0 +1,40 @@
+package relational
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SystemGrant gives a user, or every user with a role, access to a system security plan.
+// Users only have access to the system security plans granted to them, directly or through their roles, and to the
+// assessment plans, assessment results and POA&Ms importing them. Users without grants have access to no system,
+// unless they have the system:all permission.
+type SystemGrant struct {
+	UUIDModel
+	CreatedAt time.Time `json:"createdAt"`
+
+	SystemSecurityPlanID uuid.UUID           `json:"systemSecurityPlanId" gorm:"type:uuid;not null;index"`
+	SystemSecurityPlan   *SystemSecurityPlan `json:"-" gorm:"constraint:OnDelete:CASCADE"`
+	// Exactly one of UserID and RoleName is set.
+	UserID   *uuid.UUID  `json:"userId,omitempty" gorm:"type:uuid;index"`
+	User     *User       `json:"-" gorm:"constraint:OnDelete:CASCADE"`
+	RoleName *string     `json:"roleName,omitempty" gorm:"index"`
+	Role     *AccessRole `json:"-" gorm:"foreignKey:RoleName;constraint:OnDelete:CASCADE"`
+}
+
+func (SystemGrant) TableName() string {
+	return "ccf_system_grants"
+}
+
+// Validate checks that the grant is given to either a user or a role.
+func (g *SystemGrant) Validate() error {
+	if g.SystemSecurityPlanID == uuid.Nil {
+		return errors.New("system security plan is required")
+	}
+	if (g.UserID == nil) == (g.RoleName == nil || *g.RoleName == "") {
+		return errors.New("exactly one of a user or a role is required")
+	}
+	return nil
+}
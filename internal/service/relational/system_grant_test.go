@@ -0,0 +1,23 @@
+package relational
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSystemGrant(t *testing.T) {
+	ssp := uuid.New()
+	user := uuid.New()
+	role := RoleAssessor
+	empty := ""
+
+	assert.NoError(t, (&SystemGrant{SystemSecurityPlanID: ssp, UserID: &user}).Validate())
+	assert.NoError(t, (&SystemGrant{SystemSecurityPlanID: ssp, RoleName: &role}).Validate())
+
+	assert.Error(t, (&SystemGrant{UserID: &user}).Validate(), "no system")
+	assert.Error(t, (&SystemGrant{SystemSecurityPlanID: ssp}).Validate(), "no user or role")
+	assert.Error(t, (&SystemGrant{SystemSecurityPlanID: ssp, RoleName: &empty}).Validate(), "empty role")
+	assert.Error(t, (&SystemGrant{SystemSecurityPlanID: ssp, UserID: &user, RoleName: &role}).Validate(), "user and role")
+}
@@ -0,0 +1,127 @@
+package relational
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// WebhookEventType identifies the kind of event a webhook delivery carries.
+type WebhookEventType string
+
+const (
+	// WebhookEventEvidenceTransitioned is sent when new evidence changes the state of its stream.
+	WebhookEventEvidenceTransitioned WebhookEventType = "evidence.transitioned"
+	// WebhookEventPoamItemCreated is sent when an item is added to a plan of action and milestones.
+	WebhookEventPoamItemCreated WebhookEventType = "poam-item.created"
+	// WebhookEventFindingCreated is sent when a finding is added to a result of assessment results.
+	WebhookEventFindingCreated WebhookEventType = "finding.created"
+	// WebhookEventProfileResolved is sent when a profile is resolved into a catalog.
+	WebhookEventProfileResolved WebhookEventType = "profile.resolved"
+)
+
+var WebhookEventTypes = []WebhookEventType{
+	WebhookEventEvidenceTransitioned,
+	WebhookEventPoamItemCreated,
+	WebhookEventFindingCreated,
+	WebhookEventProfileResolved,
+}
+
+// WebhookSubscription posts the events of the types it subscribes to to a URL. Deliveries are signed with its
+// secret, which is only returned when the subscription is created.
+type WebhookSubscription struct {
+	UUIDModel
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	Name       string                                `json:"name" gorm:"not null"`
+	URL        string                                `json:"url" gorm:"not null"`
+	Secret     string                                `json:"-" gorm:"not null"`
+	EventTypes datatypes.JSONSlice[WebhookEventType] `json:"eventTypes"`
+	// Enabled subscriptions receive new events. Deliveries to disabled subscriptions are held until they are enabled.
+	Enabled bool `json:"enabled" gorm:"not null"`
+}
+
+func (WebhookSubscription) TableName() string {
+	return "ccf_webhook_subscriptions"
+}
+
+// NewWebhookSecret generates a random secret for signing webhook deliveries.
+func NewWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+// Validate checks the subscription posts to an HTTP URL, and subscribes only to known event types.
+func (s *WebhookSubscription) Validate() error {
+	target, err := url.Parse(s.URL)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+	if (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return fmt.Errorf("invalid url %q: must be an absolute http or https URL", s.URL)
+	}
+	if len(s.EventTypes) == 0 {
+		return fmt.Errorf("at least one event type is required")
+	}
+	for _, eventType := range s.EventTypes {
+		if !slices.Contains(WebhookEventTypes, eventType) {
+			types := make([]string, 0, len(WebhookEventTypes))
+			for _, known := range WebhookEventTypes {
+				types = append(types, string(known))
+			}
+			return fmt.Errorf("unknown event type %q, expected one of: %s", eventType, strings.Join(types, ", "))
+		}
+	}
+	return nil
+}
+
+// WebhookDeliveryStatus is the progress of a webhook delivery.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending deliveries are waiting to be sent, or to be retried.
+	WebhookDeliveryPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliveryDelivered deliveries were accepted by the subscriber.
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	// WebhookDeliveryFailed deliveries were given up on after too many attempts.
+	WebhookDeliveryFailed WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery is an event to be posted to a subscription. Deliveries are written in the same transaction as the
+// change they describe, and sent from this outbox in the background, so that events are neither lost nor sent for
+// changes which were rolled back. Once sent, they remain as the delivery log of the subscription.
+type WebhookDelivery struct {
+	UUIDModel
+	CreatedAt time.Time `json:"createdAt" gorm:"index:ccf_webhook_deliveries_subscription_idx,priority:2"`
+
+	SubscriptionID uuid.UUID            `json:"subscriptionId" gorm:"not null;index:ccf_webhook_deliveries_subscription_idx,priority:1"`
+	Subscription   *WebhookSubscription `json:"-"`
+	// EventID is shared by the deliveries of an event to each subscription, so that subscribers can detect repeats.
+	EventID   uuid.UUID        `json:"eventId" gorm:"not null"`
+	EventType WebhookEventType `json:"eventType" gorm:"not null"`
+	Payload   datatypes.JSON   `json:"payload" swaggertype:"object"`
+
+	Status        WebhookDeliveryStatus `json:"status" gorm:"not null;index:ccf_webhook_deliveries_pending_idx,priority:1"`
+	Attempts      int                   `json:"attempts" gorm:"not null"`
+	NextAttemptAt time.Time             `json:"nextAttemptAt" gorm:"index:ccf_webhook_deliveries_pending_idx,priority:2"`
+	LastAttemptAt *time.Time            `json:"lastAttemptAt,omitempty"`
+	// ResponseStatus is the HTTP status of the response to the last attempt, if one was received.
+	ResponseStatus int        `json:"responseStatus,omitempty"`
+	LastError      string     `json:"lastError,omitempty"`
+	DeliveredAt    *time.Time `json:"deliveredAt,omitempty"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "ccf_webhook_deliveries"
+}
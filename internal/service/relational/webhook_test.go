@@ -0,0 +1,43 @@
+package relational
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+func TestWebhookSubscription(t *testing.T) {
+	events := datatypes.NewJSONSlice([]WebhookEventType{WebhookEventEvidenceTransitioned, WebhookEventProfileResolved})
+
+	t.Run("Valid subscriptions", func(t *testing.T) {
+		for _, subscription := range []WebhookSubscription{
+			{URL: "https://hooks.example.com/ccf", EventTypes: events},
+			{URL: "http://localhost:8080/events?token=abc", EventTypes: events},
+		} {
+			assert.NoError(t, subscription.Validate(), subscription.URL)
+		}
+	})
+
+	t.Run("Invalid subscriptions", func(t *testing.T) {
+		for _, subscription := range []WebhookSubscription{
+			{URL: "", EventTypes: events},
+			{URL: "hooks.example.com/ccf", EventTypes: events},
+			{URL: "ftp://hooks.example.com/ccf", EventTypes: events},
+			{URL: "https://hooks.example.com/ccf"},
+			{URL: "https://hooks.example.com/ccf", EventTypes: datatypes.NewJSONSlice([]WebhookEventType{"evidence.deleted"})},
+		} {
+			assert.Error(t, subscription.Validate(), subscription.URL)
+		}
+	})
+
+	t.Run("Secrets are random", func(t *testing.T) {
+		first, err := NewWebhookSecret()
+		require.NoError(t, err)
+		second, err := NewWebhookSecret()
+		require.NoError(t, err)
+		assert.Len(t, first, 64)
+		assert.NotEqual(t, first, second)
+	})
+}
@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/compliance-framework/api/internal/authn"
+	"github.com/compliance-framework/api/internal/config"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrInvalidRefreshToken is returned for refresh tokens which are unknown, expired, or whose session was revoked.
+var ErrInvalidRefreshToken = errors.New("invalid, expired or revoked refresh token")
+
+// SessionTokens are the tokens issued when a session is created or refreshed.
+type SessionTokens struct {
+	Session      *relational.Session
+	AccessToken  string
+	RefreshToken string
+}
+
+// SessionManager issues the tokens of user sessions, rotates their refresh tokens, and revokes them.
+type SessionManager struct {
+	db              *gorm.DB
+	privateKey      *rsa.PrivateKey
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
+	lockoutDuration time.Duration
+}
+
+func NewSessionManager(db *gorm.DB, config *config.Config) *SessionManager {
+	return &SessionManager{
+		db:              db,
+		privateKey:      config.JWTPrivateKey,
+		accessTokenTTL:  config.AccessTokenTTL,
+		refreshTokenTTL: config.RefreshTokenTTL,
+		lockoutDuration: config.LoginLockoutDuration,
+	}
+}
+
+// AccessTokenTTL is how long the access tokens issued by the manager are valid for.
+func (m *SessionManager) AccessTokenTTL() time.Duration {
+	return m.accessTokenTTL
+}
+
+// RefreshTokenTTL is how long the refresh tokens issued by the manager are valid for.
+func (m *SessionManager) RefreshTokenTTL() time.Duration {
+	return m.refreshTokenTTL
+}
+
+// Create starts a session for the user, whose roles and their permissions must be loaded.
+func (m *SessionManager) Create(user *relational.User) (*SessionTokens, error) {
+	refreshToken, hash, err := relational.NewRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	session := &relational.Session{
+		UserID:           *user.ID,
+		RefreshTokenHash: hash,
+		ExpiresAt:        time.Now().Add(m.refreshTokenTTL),
+	}
+	if err := m.db.Create(session).Error; err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	accessToken, err := authn.GenerateJWTToken(user, session.ID.String(), m.accessTokenTTL, m.privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &SessionTokens{Session: session, AccessToken: *accessToken, RefreshToken: refreshToken}, nil
+}
+
+// Refresh exchanges a refresh token for a new access token and a new refresh token, which replaces it.
+// The session is revoked when the refresh token it replaced is used again, as only a stolen copy of it would be, and
+// when its user has been deactivated or locked.
+func (m *SessionManager) Refresh(refreshToken string) (*SessionTokens, error) {
+	hash := relational.HashRefreshToken(refreshToken)
+	newRefreshToken, newHash, err := relational.NewRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	var session relational.Session
+	var revoke bool
+	err = m.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Preload("User.Roles.Permissions").
+			First(&session, "refresh_token_hash = ?", hash).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Only a stolen copy of a refresh token would be used after it has been replaced.
+			if err := tx.First(&session, "previous_refresh_token_hash = ?", hash).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return ErrInvalidRefreshToken
+				}
+				return err
+			}
+			revoke = true
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if !session.IsActive(now) {
+			return ErrInvalidRefreshToken
+		}
+		if session.User == nil || !session.User.IsActive ||
+			(session.User.IsLocked && !session.User.LockExpired(now, m.lockoutDuration)) {
+			revoke = true
+			return nil
+		}
+
+		previousHash := session.RefreshTokenHash
+		session.PreviousRefreshTokenHash = &previousHash
+		session.RefreshTokenHash = newHash
+		session.ExpiresAt = now.Add(m.refreshTokenTTL)
+		session.LastUsedAt = &now
+		return tx.Omit("User").Save(&session).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	if revoke {
+		if err := m.Revoke(*session.ID); err != nil {
+			return nil, err
+		}
+		return nil, ErrInvalidRefreshToken
+	}
+
+	accessToken, err := authn.GenerateJWTToken(session.User, session.ID.String(), m.accessTokenTTL, m.privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &SessionTokens{Session: &session, AccessToken: *accessToken, RefreshToken: newRefreshToken}, nil
+}
+
+// FindByRefreshToken returns the session of a refresh token, whether or not it is still active.
+func (m *SessionManager) FindByRefreshToken(refreshToken string) (*relational.Session, error) {
+	var session relational.Session
+	if err := m.db.First(&session, "refresh_token_hash = ?", relational.HashRefreshToken(refreshToken)).Error; err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// Revoke revokes the session, and every access token issued for it.
+func (m *SessionManager) Revoke(sessionID uuid.UUID) error {
+	return m.revoke("id = ?", sessionID)
+}
+
+// RevokeUserSessions revokes every session of the user, such as when they are deactivated or their password is
+// changed.
+func (m *SessionManager) RevokeUserSessions(userID uuid.UUID) error {
+	return m.revoke("user_id = ?", userID)
+}
+
+// revoke revokes the active sessions matching the condition. Their IDs are added to the revocation list until every
+// access token issued for them has expired.
+func (m *SessionManager) revoke(condition string, args ...any) error {
+	now := time.Now()
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		var ids []uuid.UUID
+		if err := tx.Model(&relational.Session{}).
+			Where(condition, args...).
+			Where("revoked_at IS NULL").
+			Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		if err := tx.Model(&relational.Session{}).Where("id IN ?", ids).Update("revoked_at", now).Error; err != nil {
+			return err
+		}
+		revoked := make([]relational.RevokedToken, 0, len(ids))
+		for _, id := range ids {
+			revoked = append(revoked, relational.RevokedToken{ID: id.String(), ExpiresAt: now.Add(m.accessTokenTTL)})
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&revoked).Error
+	})
+}
+
+// RevokeToken adds a single access token to the revocation list until it expires.
+func (m *SessionManager) RevokeToken(claims *authn.UserClaims) error {
+	if claims.ID == "" || claims.ExpiresAt == nil {
+		return errors.New("the token cannot be revoked")
+	}
+	return m.db.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&relational.RevokedToken{ID: claims.ID, ExpiresAt: claims.ExpiresAt.Time}).Error
+}
+
+// IsTokenRevoked reports whether the token, or the session it was issued for, is on the revocation list.
+func IsTokenRevoked(db *gorm.DB, claims *authn.UserClaims) (bool, error) {
+	ids := []string{}
+	for _, id := range []string{claims.ID, claims.SessionID} {
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return false, nil
+	}
+
+	var count int64
+	if err := db.Model(&relational.RevokedToken{}).Where("id IN ?", ids).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// SessionPruner deletes expired sessions, and the entries of the revocation list for tokens which have expired.
+type SessionPruner struct {
+	db    *gorm.DB
+	sugar *zap.SugaredLogger
+}
+
+func NewSessionPruner(db *gorm.DB, sugar *zap.SugaredLogger) *SessionPruner {
+	return &SessionPruner{
+		db:    db,
+		sugar: sugar,
+	}
+}
+
+// Prune deletes what has expired by now, and returns the number of sessions and revocation list entries deleted.
+func (p *SessionPruner) Prune(ctx context.Context, now time.Time) (int64, int64, error) {
+	db := p.db.WithContext(ctx)
+
+	sessions := db.Where("expires_at < ?", now).Delete(&relational.Session{})
+	if sessions.Error != nil {
+		return 0, 0, fmt.Errorf("failed to delete expired sessions: %w", sessions.Error)
+	}
+	revoked := db.Where("expires_at < ?", now).Delete(&relational.RevokedToken{})
+	if revoked.Error != nil {
+		return sessions.RowsAffected, 0, fmt.Errorf("failed to delete expired revocations: %w", revoked.Error)
+	}
+	return sessions.RowsAffected, revoked.RowsAffected, nil
+}
+
+// Run prunes sessions every interval until the context is cancelled.
+func (p *SessionPruner) Run(ctx context.Context, interval time.Duration) {
+	p.sugar.Infow("Starting session pruner", "interval", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		sessions, revoked, err := p.Prune(ctx, time.Now())
+		if err != nil && !errors.Is(err, context.Canceled) {
+			p.sugar.Errorw("Failed to prune sessions", "error", err)
+		}
+		if sessions > 0 || revoked > 0 {
+			p.sugar.Infow("Pruned sessions", "sessions", sessions, "revocations", revoked)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
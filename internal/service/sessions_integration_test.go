@@ -0,0 +1,121 @@
+//go:build integration
+
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/compliance-framework/api/internal/authn"
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/compliance-framework/api/internal/tests"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+)
+
+func TestSessions(t *testing.T) {
+	suite.Run(t, new(SessionsIntegrationSuite))
+}
+
+type SessionsIntegrationSuite struct {
+	tests.IntegrationTestSuite
+}
+
+func (suite *SessionsIntegrationSuite) user() *relational.User {
+	var user relational.User
+	suite.Require().NoError(suite.DB.Preload("Roles.Permissions").First(&user, "email = ?", "test@example.com").Error)
+	return &user
+}
+
+func (suite *SessionsIntegrationSuite) claims(tokens *service.SessionTokens) *authn.UserClaims {
+	claims, err := authn.VerifyJWTToken(tokens.AccessToken, suite.Config.JWTPublicKey)
+	suite.Require().NoError(err)
+	return claims
+}
+
+func (suite *SessionsIntegrationSuite) TestRevokeUserSessions() {
+	suite.Require().NoError(suite.Migrator.Refresh())
+	sessions := service.NewSessionManager(suite.DB, suite.Config)
+	user := suite.user()
+
+	first, err := sessions.Create(user)
+	suite.Require().NoError(err)
+	second, err := sessions.Create(user)
+	suite.Require().NoError(err)
+
+	for _, tokens := range []*service.SessionTokens{first, second} {
+		revoked, err := service.IsTokenRevoked(suite.DB, suite.claims(tokens))
+		suite.Require().NoError(err)
+		suite.False(revoked)
+	}
+
+	suite.Require().NoError(sessions.RevokeUserSessions(*user.ID))
+
+	for _, tokens := range []*service.SessionTokens{first, second} {
+		revoked, err := service.IsTokenRevoked(suite.DB, suite.claims(tokens))
+		suite.Require().NoError(err)
+		suite.True(revoked)
+
+		_, err = sessions.Refresh(tokens.RefreshToken)
+		suite.ErrorIs(err, service.ErrInvalidRefreshToken)
+	}
+
+	// Revoking again is a no-op.
+	suite.NoError(sessions.RevokeUserSessions(*user.ID))
+}
+
+func (suite *SessionsIntegrationSuite) TestRefreshLockedUser() {
+	suite.Require().NoError(suite.Migrator.Refresh())
+	sessions := service.NewSessionManager(suite.DB, suite.Config)
+	user := suite.user()
+
+	tokens, err := sessions.Create(user)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.DB.Model(user).Updates(map[string]any{"is_locked": true, "locked_at": time.Now()}).Error)
+
+	_, err = sessions.Refresh(tokens.RefreshToken)
+	suite.ErrorIs(err, service.ErrInvalidRefreshToken)
+
+	revoked, err := service.IsTokenRevoked(suite.DB, suite.claims(tokens))
+	suite.Require().NoError(err)
+	suite.True(revoked, "Expected the sessions of locked users to be revoked")
+}
+
+func (suite *SessionsIntegrationSuite) TestRevokeToken() {
+	suite.Require().NoError(suite.Migrator.Refresh())
+	sessions := service.NewSessionManager(suite.DB, suite.Config)
+
+	token, err := authn.GenerateJWTToken(suite.user(), "", suite.Config.AccessTokenTTL, suite.Config.JWTPrivateKey)
+	suite.Require().NoError(err)
+	claims, err := authn.VerifyJWTToken(*token, suite.Config.JWTPublicKey)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(sessions.RevokeToken(claims))
+
+	revoked, err := service.IsTokenRevoked(suite.DB, claims)
+	suite.Require().NoError(err)
+	suite.True(revoked)
+}
+
+func (suite *SessionsIntegrationSuite) TestPrune() {
+	suite.Require().NoError(suite.Migrator.Refresh())
+	sessions := service.NewSessionManager(suite.DB, suite.Config)
+
+	tokens, err := sessions.Create(suite.user())
+	suite.Require().NoError(err)
+	suite.Require().NoError(sessions.Revoke(*tokens.Session.ID))
+
+	pruner := service.NewSessionPruner(suite.DB, zap.NewNop().Sugar())
+
+	deleted, revocations, err := pruner.Prune(context.Background(), time.Now())
+	suite.Require().NoError(err)
+	suite.Zero(deleted)
+	suite.Zero(revocations)
+
+	deleted, revocations, err = pruner.Prune(context.Background(), time.Now().Add(suite.Config.RefreshTokenTTL+time.Minute))
+	suite.Require().NoError(err)
+	suite.EqualValues(1, deleted)
+	suite.EqualValues(1, revocations)
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SystemScope limits a user to the system security plans they have been granted, and to the assessment plans,
+// assessment results and POA&Ms importing them, and the activities of those assessment plans. A nil scope has access
+// to every system, which is only given to users with the system:all permission. An empty scope has access to none.
+//
+// Documents are related through the href of their import-ssp and import-ap, which is expected to contain the UUID of
+// the imported document, as in "#<uuid>" or "https://example.com/system-security-plans/<uuid>".
+type SystemScope struct {
+	SystemSecurityPlanIDs []uuid.UUID
+}
+
+// LoadSystemScope loads the scope of the user with the email, given the names of its roles. The scope is empty when
+// neither the user nor its roles have been granted access to any system.
+func LoadSystemScope(db *gorm.DB, email string, roles []string) (*SystemScope, error) {
+	ids := []uuid.UUID{}
+	err := db.Model(&relational.SystemGrant{}).
+		Joins("LEFT JOIN ccf_users ON ccf_users.id = ccf_system_grants.user_id AND ccf_users.deleted_at IS NULL").
+		Where("ccf_users.email = ? OR ccf_system_grants.role_name IN ?", email, roles).
+		Distinct().
+		Pluck("ccf_system_grants.system_security_plan_id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return &SystemScope{SystemSecurityPlanIDs: ids}, nil
+}
+
+// none limits a query to no rows, for scopes without any system.
+func none(db *gorm.DB) *gorm.DB {
+	return db.Where("FALSE")
+}
+
+// hrefPatterns matches hrefs to the granted system security plans.
+func (s *SystemScope) hrefPatterns() []string {
+	patterns := make([]string, len(s.SystemSecurityPlanIDs))
+	for i, id := range s.SystemSecurityPlanIDs {
+		patterns[i] = fmt.Sprintf("%%%s%%", id)
+	}
+	return patterns
+}
+
+// SystemSecurityPlans limits a query of system security plans to those in the scope.
+func (s *SystemScope) SystemSecurityPlans(db *gorm.DB) *gorm.DB {
+	if s == nil {
+		return db
+	}
+	if len(s.SystemSecurityPlanIDs) == 0 {
+		return none(db)
+	}
+	return db.Where("system_security_plans.id IN ?", s.SystemSecurityPlanIDs)
+}
+
+// AssessmentPlans limits a query of assessment plans to those importing a system security plan in the scope.
+func (s *SystemScope) AssessmentPlans(db *gorm.DB) *gorm.DB {
+	if s == nil {
+		return db
+	}
+	if len(s.SystemSecurityPlanIDs) == 0 {
+		return none(db)
+	}
+	return db.Where("lower(assessment_plans.import_ssp->>'href') LIKE ANY (ARRAY[?])", s.hrefPatterns())
+}
+
+// AssessmentResults limits a query of assessment results to those importing an assessment plan in the scope.
+func (s *SystemScope) AssessmentResults(db *gorm.DB) *gorm.DB {
+	if s == nil {
+		return db
+	}
+	if len(s.SystemSecurityPlanIDs) == 0 {
+		return none(db)
+	}
+	return db.Where(`EXISTS (
+		SELECT 1 FROM assessment_plans ap
+		WHERE lower(assessment_results.import_ap->>'href') LIKE '%' || ap.id::text || '%'
+			AND lower(ap.import_ssp->>'href') LIKE ANY (ARRAY[?])
+	)`, s.hrefPatterns())
+}
+
+// PlansOfActionAndMilestones limits a query of POA&Ms to those importing a system security plan in the scope.
+func (s *SystemScope) PlansOfActionAndMilestones(db *gorm.DB) *gorm.DB {
+	if s == nil {
+		return db
+	}
+	if len(s.SystemSecurityPlanIDs) == 0 {
+		return none(db)
+	}
+	return db.Where("lower(plan_of_action_and_milestones.import_ssp->>'href') LIKE ANY (ARRAY[?])", s.hrefPatterns())
+}
+
+// Activities limits a query of activities to those associated with a task, or defined locally, in an assessment plan
+// in the scope. Activities which are not part of any assessment plan are outside of every scope.
+func (s *SystemScope) Activities(db *gorm.DB) *gorm.DB {
+	if s == nil {
+		return db
+	}
+	if len(s.SystemSecurityPlanIDs) == 0 {
+		return none(db)
+	}
+	plans := s.AssessmentPlans(db.Session(&gorm.Session{NewDB: true}).Model(&relational.AssessmentPlan{}).Select("assessment_plans.id"))
+	return db.Where(`activities.id IN (
+		SELECT aa.activity_id FROM associated_activities aa
+		JOIN tasks t ON t.id = aa.task_id
+		WHERE t.parent_type = 'assessment_plans' AND t.parent_id IN (?)
+		UNION
+		SELECT lda.activity_id FROM local_definition_activities lda
+		JOIN local_definitions ld ON ld.id = lda.local_definitions_id
+		WHERE ld.parent_type = 'assessment_plans' AND ld.parent_id IN (?)
+	)`, plans, plans)
+}
@@ -0,0 +1,265 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Headers set on webhook deliveries.
+const (
+	WebhookEventHeader     = "X-CCF-Event"
+	WebhookDeliveryHeader  = "X-CCF-Delivery"
+	WebhookTimestampHeader = "X-CCF-Timestamp"
+	WebhookSignatureHeader = "X-CCF-Signature"
+)
+
+const (
+	// webhookTimeout bounds how long a subscriber may take to accept a delivery.
+	webhookTimeout = 10 * time.Second
+	// webhookBatchSize is the most deliveries a dispatcher claims at once.
+	webhookBatchSize = 20
+	// webhookLease is how long a dispatcher holds the deliveries it claimed, so that others skip them. It is long
+	// enough to send every delivery in a batch, even if each of them times out.
+	webhookLease = webhookBatchSize*webhookTimeout + time.Minute
+	// Failed deliveries are retried after webhookRetryBackoff, doubling with each attempt up to webhookMaxBackoff.
+	webhookRetryBackoff = 30 * time.Second
+	webhookMaxBackoff   = 6 * time.Hour
+)
+
+// WebhookEvent is the body of a webhook delivery.
+type WebhookEvent struct {
+	ID   uuid.UUID                   `json:"id"`
+	Type relational.WebhookEventType `json:"type"`
+	Time time.Time                   `json:"time"`
+	Data any                         `json:"data"`
+}
+
+// EnqueueWebhookEvent adds a delivery of an event to the outbox of every enabled subscription to its type. It should
+// be called in the transaction making the change the event describes.
+func EnqueueWebhookEvent(db *gorm.DB, eventType relational.WebhookEventType, data any) error {
+	types, err := json.Marshal([]relational.WebhookEventType{eventType})
+	if err != nil {
+		return err
+	}
+	subscriptions := []relational.WebhookSubscription{}
+	if err := db.
+		Where("enabled AND event_types @> CAST(? AS jsonb)", string(types)).
+		Find(&subscriptions).Error; err != nil {
+		return fmt.Errorf("failed to find webhook subscriptions: %w", err)
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	event := WebhookEvent{ID: uuid.New(), Type: eventType, Time: now, Data: data}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	deliveries := make([]relational.WebhookDelivery, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		deliveries = append(deliveries, relational.WebhookDelivery{
+			SubscriptionID: *subscription.ID,
+			EventID:        event.ID,
+			EventType:      eventType,
+			Payload:        payload,
+			Status:         relational.WebhookDeliveryPending,
+			NextAttemptAt:  now,
+		})
+	}
+	if err := db.Create(&deliveries).Error; err != nil {
+		return fmt.Errorf("failed to enqueue webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// SignWebhook returns the signature of a delivery: the hex encoded HMAC-SHA256, keyed by the secret of the
+// subscription, of the timestamp header, a period, and the body. Subscribers should compute the same signature and
+// compare it with the signature header, and may reject deliveries with old timestamps to prevent replays.
+func SignWebhook(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookBackoff is how long to wait before retrying a delivery which has failed the given number of attempts.
+func WebhookBackoff(attempts int) time.Duration {
+	backoff := webhookRetryBackoff
+	for i := 1; i < attempts && backoff < webhookMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, webhookMaxBackoff)
+}
+
+// WebhookDispatcher sends pending deliveries from the outbox to their subscriptions.
+type WebhookDispatcher struct {
+	db          *gorm.DB
+	sugar       *zap.SugaredLogger
+	client      *http.Client
+	maxAttempts int
+}
+
+func NewWebhookDispatcher(db *gorm.DB, sugar *zap.SugaredLogger, maxAttempts int) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		db:          db,
+		sugar:       sugar,
+		client:      &http.Client{Timeout: webhookTimeout},
+		maxAttempts: maxAttempts,
+	}
+}
+
+// claim leases the pending deliveries which are due to enabled subscriptions, along with their subscriptions.
+// Deliveries leased by another dispatcher are skipped.
+func (d *WebhookDispatcher) claim(ctx context.Context, now time.Time) ([]relational.WebhookDelivery, error) {
+	deliveries := []relational.WebhookDelivery{}
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		enabled := tx.Model(&relational.WebhookSubscription{}).Select("id").Where("enabled")
+		if err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", relational.WebhookDeliveryPending, now).
+			Where("subscription_id IN (?)", enabled).
+			Order("next_attempt_at").
+			Limit(webhookBatchSize).
+			Find(&deliveries).Error; err != nil {
+			return err
+		}
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, 0, len(deliveries))
+		subscriptionIDs := make([]uuid.UUID, 0, len(deliveries))
+		for _, delivery := range deliveries {
+			ids = append(ids, *delivery.ID)
+			subscriptionIDs = append(subscriptionIDs, delivery.SubscriptionID)
+		}
+		if err := tx.Model(&relational.WebhookDelivery{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", now.Add(webhookLease)).Error; err != nil {
+			return err
+		}
+
+		subscriptions := []relational.WebhookSubscription{}
+		if err := tx.Where("id IN ?", subscriptionIDs).Find(&subscriptions).Error; err != nil {
+			return err
+		}
+		for i := range deliveries {
+			for j := range subscriptions {
+				if *subscriptions[j].ID == deliveries[i].SubscriptionID {
+					deliveries[i].Subscription = &subscriptions[j]
+				}
+			}
+		}
+		return nil
+	})
+	return deliveries, err
+}
+
+// Dispatch sends the deliveries which are due, and records the outcome of each attempt. Failed deliveries are
+// scheduled to be retried, or marked as failed once they reach the maximum number of attempts.
+func (d *WebhookDispatcher) Dispatch(ctx context.Context, now time.Time) (delivered int, failed int, err error) {
+	deliveries, err := d.claim(ctx, now)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+
+	errs := []error{}
+	for _, delivery := range deliveries {
+		responseStatus, sendErr := d.send(ctx, delivery, now)
+		if errors.Is(sendErr, context.Canceled) {
+			// Leave the delivery to be sent again once its lease expires.
+			return delivered, failed, sendErr
+		}
+
+		updates := map[string]any{
+			"attempts":        delivery.Attempts + 1,
+			"last_attempt_at": now,
+			"response_status": responseStatus,
+			"last_error":      "",
+		}
+		if sendErr == nil {
+			delivered++
+			updates["status"] = relational.WebhookDeliveryDelivered
+			updates["delivered_at"] = now
+		} else {
+			updates["last_error"] = sendErr.Error()
+			if delivery.Attempts+1 >= d.maxAttempts {
+				failed++
+				updates["status"] = relational.WebhookDeliveryFailed
+				d.sugar.Warnw("Giving up on webhook delivery", "delivery", delivery.ID, "subscription", delivery.SubscriptionID, "attempts", delivery.Attempts+1, "error", sendErr)
+			} else {
+				updates["next_attempt_at"] = now.Add(WebhookBackoff(delivery.Attempts + 1))
+			}
+		}
+		if err := d.db.WithContext(ctx).Model(&relational.WebhookDelivery{}).Where("id = ?", delivery.ID).Updates(updates).Error; err != nil {
+			errs = append(errs, fmt.Errorf("failed to record webhook delivery %s: %w", delivery.ID, err))
+		}
+	}
+	return delivered, failed, errors.Join(errs...)
+}
+
+// send posts a delivery to its subscription, returning the status of the response if one was received.
+func (d *WebhookDispatcher) send(ctx context.Context, delivery relational.WebhookDelivery, now time.Time) (int, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(WebhookEventHeader, string(delivery.EventType))
+	request.Header.Set(WebhookDeliveryHeader, delivery.ID.String())
+	request.Header.Set(WebhookTimestampHeader, timestamp)
+	request.Header.Set(WebhookSignatureHeader, SignWebhook(delivery.Subscription.Secret, timestamp, delivery.Payload))
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return response.StatusCode, fmt.Errorf("subscriber responded with status %d", response.StatusCode)
+	}
+	return response.StatusCode, nil
+}
+
+// Run sends pending deliveries immediately, and then at every interval until the context is cancelled.
+func (d *WebhookDispatcher) Run(ctx context.Context, interval time.Duration) {
+	d.sugar.Infow("Starting webhook dispatcher", "interval", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		started := time.Now()
+		delivered, failed, err := d.Dispatch(ctx, started)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			d.sugar.Errorw("Failed to dispatch webhooks", "error", err)
+		}
+		if delivered > 0 || failed > 0 {
+			d.sugar.Infow("Dispatched webhooks", "delivered", delivered, "failed", failed, "duration", time.Since(started))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,191 @@
+//go:build integration
+
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/compliance-framework/api/internal/service"
+	"github.com/compliance-framework/api/internal/service/relational"
+	"github.com/compliance-framework/api/internal/tests"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"gorm.io/datatypes"
+)
+
+func TestWebhooks(t *testing.T) {
+	suite.Run(t, new(WebhooksIntegrationSuite))
+}
+
+type WebhooksIntegrationSuite struct {
+	tests.IntegrationTestSuite
+}
+
+// webhookReceiver is a subscriber which records the requests it receives, and responds with its status.
+type webhookReceiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	status   int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func newWebhookReceiver() *webhookReceiver {
+	receiver := &webhookReceiver{status: http.StatusOK}
+	receiver.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		receiver.mu.Lock()
+		defer receiver.mu.Unlock()
+		receiver.requests = append(receiver.requests, r)
+		receiver.bodies = append(receiver.bodies, body)
+		w.WriteHeader(receiver.status)
+	}))
+	return receiver
+}
+
+func (r *webhookReceiver) respond(status int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = status
+}
+
+func (r *webhookReceiver) received() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.requests)
+}
+
+func (suite *WebhooksIntegrationSuite) createSubscription(url string, enabled bool, eventTypes ...relational.WebhookEventType) relational.WebhookSubscription {
+	subscription := relational.WebhookSubscription{
+		Name:       "Subscriber",
+		URL:        url,
+		Secret:     "secret",
+		EventTypes: datatypes.NewJSONSlice(eventTypes),
+		Enabled:    enabled,
+	}
+	suite.Require().NoError(suite.DB.Create(&subscription).Error)
+	return subscription
+}
+
+func (suite *WebhooksIntegrationSuite) deliveries(subscription relational.WebhookSubscription) []relational.WebhookDelivery {
+	deliveries := []relational.WebhookDelivery{}
+	suite.Require().NoError(suite.DB.Where("subscription_id = ?", subscription.ID).Order("created_at").Find(&deliveries).Error)
+	return deliveries
+}
+
+func (suite *WebhooksIntegrationSuite) TestDeliveries() {
+	suite.Require().NoError(suite.Migrator.Refresh())
+
+	receiver := newWebhookReceiver()
+	defer receiver.Close()
+
+	subscribed := suite.createSubscription(receiver.URL, true, relational.WebhookEventProfileResolved, relational.WebhookEventFindingCreated)
+	other := suite.createSubscription(receiver.URL, true, relational.WebhookEventPoamItemCreated)
+	disabled := suite.createSubscription(receiver.URL, false, relational.WebhookEventProfileResolved)
+
+	logger, _ := zap.NewDevelopment()
+	dispatcher := service.NewWebhookDispatcher(suite.DB, logger.Sugar(), 3)
+	// Deliveries are due from when they are enqueued, so dispatch as if shortly afterwards.
+	now := time.Now().Add(time.Minute)
+
+	suite.Require().NoError(service.EnqueueWebhookEvent(suite.DB, relational.WebhookEventProfileResolved, map[string]string{"title": "Baseline"}))
+
+	suite.Run("Events are enqueued for enabled subscriptions to their type", func() {
+		deliveries := suite.deliveries(subscribed)
+		suite.Require().Len(deliveries, 1)
+		suite.Equal(relational.WebhookDeliveryPending, deliveries[0].Status)
+		suite.Equal(relational.WebhookEventProfileResolved, deliveries[0].EventType)
+
+		suite.Empty(suite.deliveries(other))
+		suite.Empty(suite.deliveries(disabled))
+	})
+
+	suite.Run("Deliveries are posted with a signature", func() {
+		delivered, failed, err := dispatcher.Dispatch(context.Background(), now)
+		suite.Require().NoError(err)
+		suite.Equal(1, delivered)
+		suite.Zero(failed)
+
+		suite.Require().Equal(1, receiver.received())
+		request, body := receiver.requests[0], receiver.bodies[0]
+		suite.Equal(string(relational.WebhookEventProfileResolved), request.Header.Get(service.WebhookEventHeader))
+		suite.Equal(service.SignWebhook("secret", request.Header.Get(service.WebhookTimestampHeader), body), request.Header.Get(service.WebhookSignatureHeader))
+
+		event := service.WebhookEvent{}
+		suite.Require().NoError(json.Unmarshal(body, &event))
+		suite.Equal(relational.WebhookEventProfileResolved, event.Type)
+		suite.Equal(map[string]any{"title": "Baseline"}, event.Data)
+
+		deliveries := suite.deliveries(subscribed)
+		suite.Equal(relational.WebhookDeliveryDelivered, deliveries[0].Status)
+		suite.Equal(1, deliveries[0].Attempts)
+		suite.Equal(http.StatusOK, deliveries[0].ResponseStatus)
+		suite.NotNil(deliveries[0].DeliveredAt)
+		suite.Equal(event.ID, deliveries[0].EventID)
+	})
+
+	suite.Run("Failed deliveries are retried with backoff until they are given up on", func() {
+		receiver.respond(http.StatusServiceUnavailable)
+		suite.Require().NoError(service.EnqueueWebhookEvent(suite.DB, relational.WebhookEventFindingCreated, map[string]string{}))
+
+		delivered, failed, err := dispatcher.Dispatch(context.Background(), now)
+		suite.Require().NoError(err)
+		suite.Zero(delivered)
+		suite.Zero(failed)
+
+		delivery := suite.deliveries(subscribed)[1]
+		suite.Equal(relational.WebhookDeliveryPending, delivery.Status)
+		suite.Equal(1, delivery.Attempts)
+		suite.Equal(http.StatusServiceUnavailable, delivery.ResponseStatus)
+		suite.NotEmpty(delivery.LastError)
+		suite.WithinDuration(now.Add(service.WebhookBackoff(1)), delivery.NextAttemptAt, time.Millisecond)
+
+		// Nothing is due until the backoff has passed.
+		delivered, failed, err = dispatcher.Dispatch(context.Background(), now.Add(time.Second))
+		suite.Require().NoError(err)
+		suite.Zero(delivered + failed)
+
+		later := now.Add(service.WebhookBackoff(1))
+		_, _, err = dispatcher.Dispatch(context.Background(), later)
+		suite.Require().NoError(err)
+		delivery = suite.deliveries(subscribed)[1]
+		suite.Equal(2, delivery.Attempts)
+		suite.WithinDuration(later.Add(service.WebhookBackoff(2)), delivery.NextAttemptAt, time.Millisecond)
+
+		_, failed, err = dispatcher.Dispatch(context.Background(), later.Add(service.WebhookBackoff(2)))
+		suite.Require().NoError(err)
+		suite.Equal(1, failed)
+		delivery = suite.deliveries(subscribed)[1]
+		suite.Equal(relational.WebhookDeliveryFailed, delivery.Status)
+		suite.Equal(3, delivery.Attempts)
+	})
+
+	suite.Run("Deliveries to disabled subscriptions are held", func() {
+		receiver.respond(http.StatusOK)
+		suite.Require().NoError(service.EnqueueWebhookEvent(suite.DB, relational.WebhookEventPoamItemCreated, map[string]string{}))
+		suite.Require().NoError(suite.DB.Model(&other).Update("enabled", false).Error)
+
+		delivered, _, err := dispatcher.Dispatch(context.Background(), now.Add(time.Hour))
+		suite.Require().NoError(err)
+		suite.Zero(delivered)
+
+		suite.Require().NoError(suite.DB.Model(&other).Update("enabled", true).Error)
+		delivered, _, err = dispatcher.Dispatch(context.Background(), now.Add(time.Hour))
+		suite.Require().NoError(err)
+		suite.Equal(1, delivered)
+	})
+
+	suite.Run("Events are not enqueued when their transaction rolls back", func() {
+		tx := suite.DB.Begin()
+		suite.Require().NoError(service.EnqueueWebhookEvent(tx, relational.WebhookEventProfileResolved, map[string]string{}))
+		suite.Require().NoError(tx.Rollback().Error)
+		suite.Len(suite.deliveries(subscribed), 2)
+	})
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignWebhook(t *testing.T) {
+	assert.Equal(t,
+		"sha256=3dd1b9aef568d75f6790a84bd2e5dfa1f44409eef3cbdbd3f10b837376100c11",
+		SignWebhook("secret", "1700000000", []byte(`{"id":1}`)),
+	)
+	assert.NotEqual(t,
+		SignWebhook("secret", "1700000000", []byte(`{"id":1}`)),
+		SignWebhook("secret", "1700000001", []byte(`{"id":1}`)),
+	)
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, WebhookBackoff(1))
+	assert.Equal(t, time.Minute, WebhookBackoff(2))
+	assert.Equal(t, 4*time.Minute, WebhookBackoff(4))
+	assert.Equal(t, 6*time.Hour, WebhookBackoff(12))
+	assert.Equal(t, 6*time.Hour, WebhookBackoff(100))
+}
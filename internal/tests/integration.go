@@ -4,6 +4,9 @@ package tests
 
 import (
 	"context"
+	"slices"
+	"time"
+
 	"github.com/compliance-framework/api/internal/authn"
 	"github.com/compliance-framework/api/internal/config"
 	"github.com/compliance-framework/api/internal/service/relational"
@@ -32,6 +35,11 @@ func (suite *IntegrationTestSuite) SetupSuite() {
 
 	cfg.JWTPrivateKey = privKey
 	cfg.JWTPublicKey = pubKey
+	cfg.AccessTokenTTL = 15 * time.Minute
+	cfg.RefreshTokenTTL = 24 * time.Hour
+	cfg.EvidenceBatchMaxItems = 1000
+	cfg.EvidenceLabelCardinalityLimit = 1000
+	cfg.AgentOfflineAfter = 5 * time.Minute
 	suite.Config = cfg
 
 	postgresContainer, err := postgresContainers.Run(ctx,
@@ -80,12 +88,23 @@ func (suite *IntegrationTestSuite) TearDownSuite() {
 	}
 }
 
+// GetAuthToken returns a token for an admin.
 func (suite *IntegrationTestSuite) GetAuthToken() (*string, error) {
+	return suite.GetAuthTokenWithRoles(relational.RoleAdmin)
+}
+
+// GetAuthTokenWithRoles returns a token for a user with the given default roles.
+func (suite *IntegrationTestSuite) GetAuthTokenWithRoles(roles ...string) (*string, error) {
 	dummyUser := relational.User{
 		Email:     "dummy@example.com",
 		FirstName: "Dummy",
 		LastName:  "User",
 	}
+	for _, role := range relational.DefaultAccessRoles() {
+		if slices.Contains(roles, role.Name) {
+			dummyUser.Roles = append(dummyUser.Roles, role)
+		}
+	}
 
-	return authn.GenerateJWTToken(&dummyUser, suite.Config.JWTPrivateKey)
+	return authn.GenerateJWTToken(&dummyUser, "", suite.Config.AccessTokenTTL, suite.Config.JWTPrivateKey)
 }
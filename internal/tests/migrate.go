@@ -37,7 +37,7 @@ func (t *TestMigrator) Refresh() error {
 }
 
 func (t *TestMigrator) Up() error {
-	return t.db.AutoMigrate(
+	err := t.db.AutoMigrate(
 		&relational.ResponsiblePartyParties{},
 		&relational.Location{},
 		&relational.Party{},
@@ -133,15 +133,38 @@ func (t *TestMigrator) Up() error {
 		&relational.AssessmentLog{},
 		&relational.AssessmentLogEntry{},
 		&relational.Attestation{},
+		&relational.AccessRole{},
+		&relational.AccessRolePermission{},
 		&relational.User{},
+		&relational.AgentCredential{},
+		&relational.AgentSigningKey{},
+		&relational.Agent{},
+		&relational.AlertRule{},
+		&relational.Alert{},
+		&relational.WebhookSubscription{},
+		&relational.WebhookDelivery{},
+		&relational.SystemGrant{},
+		&relational.Session{},
+		&relational.RevokedToken{},
+		&relational.AuditEvent{},
 
 		&service.Heartbeat{},
+		&service.HeartbeatRollup{},
 		&relational.Evidence{},
+		&relational.EvidenceSighting{},
+		&relational.EvidenceTransition{},
+		&relational.EvidenceAttachment{},
+		&relational.Blob{},
 		&relational.Labels{},
 		&relational.SelectSubjectById{},
 		&relational.Filter{},
 		&relational.Step{},
 	)
+	if err != nil {
+		return err
+	}
+
+	return service.SeedAccessRoles(t.db)
 }
 
 func (t *TestMigrator) Down() error {
@@ -271,10 +294,29 @@ func (t *TestMigrator) Down() error {
 		"poam_findings",
 		"poam_risks",
 
+		"ccf_user_roles",
 		&relational.User{},
+		&relational.AccessRolePermission{},
+		&relational.AccessRole{},
+		&relational.AgentCredential{},
+		&relational.AgentSigningKey{},
+		&relational.Agent{},
+		&relational.Alert{},
+		&relational.AlertRule{},
+		&relational.AuditEvent{},
+		&relational.RevokedToken{},
+		&relational.Session{},
+		&relational.SystemGrant{},
+		&relational.WebhookDelivery{},
+		&relational.WebhookSubscription{},
 
 		&service.Heartbeat{},
+		&service.HeartbeatRollup{},
 		&relational.Evidence{},
+		&relational.EvidenceSighting{},
+		&relational.EvidenceTransition{},
+		&relational.EvidenceAttachment{},
+		&relational.Blob{},
 		"evidence_activities",
 		"evidence_components",
 		"evidence_inventory_items",
@@ -292,5 +334,6 @@ func (t *TestMigrator) CreateUser() error {
 		LastName:  "User",
 	}
 	user.SetPassword("Pa55w0rd")
-	return t.db.Create(user).Error
+	user.Roles = []relational.AccessRole{{Name: relational.RoleAdmin}}
+	return t.db.Omit("Roles.*").Create(user).Error
 }
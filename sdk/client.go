@@ -2,14 +2,26 @@ package sdk
 
 import (
 	"context"
+	"crypto/ed25519"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
+
+	"github.com/google/uuid"
 )
 
 type Config struct {
 	BaseURL string
+
+	// APIKey is the agent credential used to authenticate with the API.
+	// Keys can be issued using `api agents credentials create`.
+	APIKey string
+
+	// SigningKey signs the evidence sent to the API when set. Its public key must be registered for the agent using
+	// `api agents keys add`, which returns the SigningKeyID to sign with.
+	SigningKey   ed25519.PrivateKey
+	SigningKeyID uuid.UUID
 }
 
 type Client struct {
@@ -39,5 +51,12 @@ func (c *Client) NewRequest(ctx context.Context, method string, path string, rea
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json")
+	setAuthorization(req, c.config)
 	return c.httpClient.Do(req)
 }
+
+func setAuthorization(req *http.Request, config *Config) {
+	if config.APIKey != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", config.APIKey))
+	}
+}
@@ -5,33 +5,96 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/compliance-framework/api/internal/provenance"
 	"github.com/compliance-framework/api/sdk/types"
 	"net/http"
+	"strings"
 )
 
+// evidenceBatchSize is the maximum number of evidence records sent to the API in a single batch request.
+const evidenceBatchSize = 500
+
 type evidenceClient struct {
 	httpClient *http.Client
 	config     *Config
 }
 
+// Create sends evidence to the API using the batch endpoint, splitting it into requests of at most evidenceBatchSize records.
+// An error is returned if any of the records could not be created.
 func (r *evidenceClient) Create(ctx context.Context, evidence ...types.Evidence) error {
-	for _, evid := range evidence {
-		reqBody, _ := json.Marshal(evid)
-		req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/evidence", r.config.BaseURL), bytes.NewReader(reqBody))
-		if err != nil {
+	for start := 0; start < len(evidence); start += evidenceBatchSize {
+		end := min(start+evidenceBatchSize, len(evidence))
+		if err := r.createBatch(ctx, evidence[start:end]); err != nil {
 			return err
 		}
-		req.Header.Set("Content-Type", "application/json")
-		response, err := r.httpClient.Do(req)
+	}
+
+	return nil
+}
+
+// sign signs each piece of evidence with the configured signing key, returning the evidence unchanged without one.
+func (r *evidenceClient) sign(evidence []types.Evidence) ([]types.Evidence, error) {
+	if r.config.SigningKey == nil {
+		return evidence, nil
+	}
+	signed := make([]types.Evidence, 0, len(evidence))
+	for _, item := range evidence {
+		item.Signature = nil
+		document, err := json.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+		signature, err := provenance.Sign(document, r.config.SigningKeyID, r.config.SigningKey)
 		if err != nil {
-			return err
+			return nil, fmt.Errorf("failed to sign evidence %s: %w", item.UUID, err)
 		}
-		defer response.Body.Close()
+		item.Signature = &types.Signature{KeyID: signature.KeyID, Algorithm: signature.Algorithm, Value: signature.Value}
+		signed = append(signed, item)
+	}
+	return signed, nil
+}
+
+func (r *evidenceClient) createBatch(ctx context.Context, evidence []types.Evidence) error {
+	evidence, err := r.sign(evidence)
+	if err != nil {
+		return err
+	}
+	reqBody, err := json.Marshal(evidence)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/evidence/batch", r.config.BaseURL), bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	setAuthorization(req, r.config)
+	response, err := r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
 
-		if response.StatusCode != http.StatusCreated {
-			return fmt.Errorf("unexpected api response status code: %d", response.StatusCode)
+	if response.StatusCode != http.StatusCreated && response.StatusCode != http.StatusMultiStatus {
+		return fmt.Errorf("unexpected api response status code: %d", response.StatusCode)
+	}
+
+	results := struct {
+		Data []types.EvidenceBatchResult `json:"data"`
+	}{}
+	if err := json.NewDecoder(response.Body).Decode(&results); err != nil {
+		return err
+	}
+
+	failures := []string{}
+	for _, result := range results.Data {
+		if result.Status != types.EvidenceBatchStatusCreated && result.Status != types.EvidenceBatchStatusUnchanged {
+			failures = append(failures, fmt.Sprintf("%s: %s %v", result.UUID, result.Status, result.Errors))
 		}
 	}
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to create %d of %d evidence records: %s", len(failures), len(evidence), strings.Join(failures, "; "))
+	}
 
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/compliance-framework/api/internal"
+	"github.com/compliance-framework/api/internal/service/relational"
 	"github.com/compliance-framework/api/sdk/types"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
@@ -142,4 +143,42 @@ func (suite *EvidenceSDKIntegrationSuite) TestCreate() {
 		err := client.Evidence.Create(context.TODO(), evidence)
 		suite.NoError(err)
 	})
+
+	suite.Run("Many evidence records are created through the batch endpoint", func() {
+		client := suite.GetSDKTestClient()
+		stream := uuid.New()
+		evidence := []types.Evidence{}
+		for i := range 3 {
+			evidence = append(evidence, types.Evidence{
+				UUID:  stream,
+				Title: fmt.Sprintf("Evidence %d", i),
+				Start: time.Now().Add(-time.Duration(i+1) * time.Hour),
+				End:   time.Now().Add(-time.Duration(i+1) * time.Hour).Add(time.Minute),
+				Status: types.ObjectiveStatus{
+					State: "satisfied",
+				},
+			})
+		}
+		err := client.Evidence.Create(context.TODO(), evidence...)
+		suite.NoError(err)
+
+		var count int64
+		suite.NoError(suite.DB.Model(&relational.Evidence{}).Where("uuid = ?", stream).Count(&count).Error)
+		suite.Equal(int64(3), count)
+	})
+
+	suite.Run("Conflicting evidence is reported as an error", func() {
+		client := suite.GetSDKTestClient()
+		evidence := types.Evidence{
+			UUID:  uuid.New(),
+			Title: "Duplicate",
+			Start: time.Now().Add(-time.Hour),
+			End:   time.Now().Add(-time.Hour).Add(time.Minute),
+			Status: types.ObjectiveStatus{
+				State: "satisfied",
+			},
+		}
+		err := client.Evidence.Create(context.TODO(), evidence, evidence)
+		suite.ErrorContains(err, "conflict")
+	})
 }
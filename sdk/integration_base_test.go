@@ -37,8 +37,13 @@ type IntegrationBaseTestSuite struct {
 }
 
 func (suite *IntegrationBaseTestSuite) GetSDKTestClient() *sdk.Client {
+	credential, key, err := relational.NewAgentCredential("sdk-test", relational.AgentCredentialScopeIngest, nil)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.DB.Create(credential).Error)
+
 	config := &sdk.Config{
 		BaseURL: "http://" + suite.Server.E().ListenerAddr().String(),
+		APIKey:  key,
 	}
 	return sdk.NewClient(http.DefaultClient, config)
 }
@@ -53,6 +58,8 @@ func (suite *IntegrationBaseTestSuite) SetupSuite() {
 
 	cfg.JWTPrivateKey = privKey
 	cfg.JWTPublicKey = pubKey
+	cfg.AccessTokenTTL = 15 * time.Minute
+	cfg.RefreshTokenTTL = 24 * time.Hour
 	suite.Config = cfg
 
 	postgresContainer, err := postgresContainers.Run(ctx,
@@ -122,9 +129,10 @@ func (suite *IntegrationBaseTestSuite) GetAuthToken() (*string, error) {
 		Email:     "dummy@example.com",
 		FirstName: "Dummy",
 		LastName:  "User",
+		Roles:     []relational.AccessRole{relational.NewAccessRole(relational.RoleAdmin, "", relational.Permissions...)},
 	}
 
-	return authn.GenerateJWTToken(&dummyUser, suite.Config.JWTPrivateKey)
+	return authn.GenerateJWTToken(&dummyUser, "", suite.Config.AccessTokenTTL, suite.Config.JWTPrivateKey)
 }
 
 func waitForServerStart(e *echo.Echo, errChan <-chan error, isTLS bool) error {
@@ -156,4 +156,37 @@ type Evidence struct {
 	Subjects []Subject `json:"subjects,omitempty"`
 	// Did we satisfy what was being tested for, or did we fail ?
 	Status ObjectiveStatus `json:"status"`
+
+	// Signature proves the evidence was sent by the agent in its origins. It is set by the client when it is
+	// configured with a signing key.
+	Signature *Signature `json:"signature,omitempty"`
+}
+
+// Signature is an ed25519 signature of the canonical JSON of evidence, made with a key registered for the agent
+// using `api agents keys add`.
+type Signature struct {
+	KeyID     uuid.UUID `json:"key-id"`
+	Algorithm string    `json:"algorithm"`
+	Value     string    `json:"value"`
+}
+
+type EvidenceBatchStatus string
+
+const (
+	EvidenceBatchStatusCreated EvidenceBatchStatus = "created"
+	// EvidenceBatchStatusUnchanged indicates the evidence repeated the previous evidence of its stream,
+	// and was recorded as a new sighting of it.
+	EvidenceBatchStatusUnchanged EvidenceBatchStatus = "unchanged"
+	EvidenceBatchStatusInvalid   EvidenceBatchStatus = "invalid"
+	EvidenceBatchStatusConflict  EvidenceBatchStatus = "conflict"
+	EvidenceBatchStatusFailed    EvidenceBatchStatus = "failed"
+)
+
+// EvidenceBatchResult is the outcome reported by the API for a single item of a batch evidence request.
+type EvidenceBatchResult struct {
+	Index  int                 `json:"index"`
+	UUID   uuid.UUID           `json:"uuid"`
+	ID     *uuid.UUID          `json:"id,omitempty"`
+	Status EvidenceBatchStatus `json:"status"`
+	Errors map[string]any      `json:"errors,omitempty"`
 }